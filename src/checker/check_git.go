@@ -27,6 +27,7 @@ import (
 
 	"github.com/murex/tcr/checker/model"
 	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/variant"
 	"github.com/murex/tcr/vcs/git"
 )
 
@@ -39,6 +40,7 @@ func init() {
 		checkGitRepository,
 		checkGitRemote,
 		checkGitAutoPush,
+		checkGitIsolatedRuns,
 	}
 }
 
@@ -128,3 +130,18 @@ func checkGitAutoPush(p params.Params) (cp []model.CheckPoint) {
 	}
 	return cp
 }
+
+func checkGitIsolatedRuns(p params.Params) (cp []model.CheckPoint) {
+	if !p.IsolatedRuns {
+		cp = append(cp, model.OkCheckPoint("isolated runs are turned off: build and tests run in the working tree"))
+		return cp
+	}
+	switch variantName := strings.ToLower(p.Variant); variantName {
+	case variant.Relaxed.Name(), variant.BTCR.Name():
+		cp = append(cp, model.OkCheckPoint("isolated runs are turned on: build and tests run in a temporary git worktree"))
+	default:
+		cp = append(cp, model.WarningCheckPoint("isolated runs are not supported with ", variantName,
+			" variant: build and tests will run in the working tree"))
+	}
+	return cp
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/murex/tcr/checker/model"
 	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/variant"
 	"github.com/murex/tcr/vcs"
 	"github.com/murex/tcr/vcs/fake"
 	"github.com/murex/tcr/vcs/git"
@@ -230,3 +231,36 @@ func Test_check_git_auto_push(t *testing.T) {
 		})
 	}
 }
+
+func Test_check_git_isolated_runs(t *testing.T) {
+	tests := []struct {
+		desc     string
+		value    bool
+		variant  variant.Variant
+		expected []model.CheckPoint
+	}{
+		{"disabled", false, variant.Relaxed, []model.CheckPoint{
+			model.OkCheckPoint("isolated runs are turned off: build and tests run in the working tree"),
+		},
+		},
+		{"enabled with relaxed variant", true, variant.Relaxed, []model.CheckPoint{
+			model.OkCheckPoint("isolated runs are turned on: build and tests run in a temporary git worktree"),
+		},
+		},
+		{"enabled with btcr variant", true, variant.BTCR, []model.CheckPoint{
+			model.OkCheckPoint("isolated runs are turned on: build and tests run in a temporary git worktree"),
+		},
+		},
+		{"enabled with introspective variant", true, variant.Introspective, []model.CheckPoint{
+			model.WarningCheckPoint("isolated runs are not supported with introspective variant: " +
+				"build and tests will run in the working tree"),
+		},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := *params.AParamSet(params.WithIsolatedRuns(test.value), params.WithVariant(test.variant.Name()))
+			assert.Equal(t, test.expected, checkGitIsolatedRuns(p))
+		})
+	}
+}
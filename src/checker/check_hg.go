@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package checker
+
+import (
+	"strings"
+
+	"github.com/murex/tcr/checker/model"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/vcs/hg"
+)
+
+var checkHgRunners []checkPointRunner
+
+func init() {
+	checkHgRunners = []checkPointRunner{
+		checkHgCommand,
+		checkHgConfig,
+		checkHgRepository,
+		checkHgRemote,
+		checkHgAutoPush,
+	}
+}
+
+func checkHgEnvironment(p params.Params) (cg *model.CheckGroup) {
+	cg = model.NewCheckGroup("mercurial environment")
+	// hg environment is checked only when hg is the selected VCS
+	if strings.ToLower(p.VCS) == hg.Name {
+		for _, runner := range checkHgRunners {
+			cg.Add(runner(p)...)
+		}
+	}
+	return cg
+}
+
+func checkHgCommand(_ params.Params) (cp []model.CheckPoint) {
+	if !hg.IsHgCommandAvailable() {
+		cp = append(cp, model.ErrorCheckPoint("hg command was not found on path"))
+		return cp
+	}
+	cp = append(cp, model.OkCheckPoint("hg command path is ", hg.GetHgCommandPath()))
+	cp = append(cp, model.OkCheckPoint("hg version is ", hg.GetHgCommandVersion()))
+	return cp
+}
+
+func checkHgConfig(_ params.Params) (cp []model.CheckPoint) {
+	if hg.GetHgUserName() == "not set" {
+		cp = append(cp, model.WarningCheckPoint("hg username is not set"))
+		return cp
+	}
+	cp = append(cp, model.OkCheckPoint("hg username is ", hg.GetHgUserName()))
+	return cp
+}
+
+func checkHgRepository(_ params.Params) (cp []model.CheckPoint) {
+	if checkEnv.sourceTreeErr != nil {
+		cp = append(cp, model.ErrorCheckPoint("cannot retrieve hg repository information from base directory name"))
+		return cp
+	}
+	if checkEnv.vcsErr != nil {
+		cp = append(cp, model.ErrorCheckPoint(checkEnv.vcsErr))
+		return cp
+	}
+	if checkEnv.vcs == nil {
+		cp = append(cp, model.ErrorCheckPoint("hg repository not properly initialized"))
+		return cp
+	}
+
+	cp = append(cp, model.OkCheckPoint("hg repository root is ", checkEnv.vcs.GetRootDir()))
+	cp = append(cp, model.OkCheckPoint("hg working branch is ", checkEnv.vcs.GetWorkingBranch()))
+	if checkEnv.vcs.IsOnRootBranch() {
+		cp = append(cp, model.WarningCheckPoint("running TCR from hg default branch is not recommended"))
+	}
+	return cp
+}
+
+func checkHgRemote(_ params.Params) (cp []model.CheckPoint) {
+	if checkEnv.vcs == nil || checkEnv.vcsErr != nil {
+		// If hg is not properly initialized, no point in trying to go further
+		return []model.CheckPoint{}
+	}
+
+	if !checkEnv.vcs.IsRemoteEnabled() {
+		cp = append(cp, model.OkCheckPoint("hg remote is disabled: all operations will be done locally"))
+		return cp
+	}
+
+	cp = append(cp, model.OkCheckPoint("hg remote path is ", checkEnv.vcs.GetRemoteName()))
+	if checkEnv.vcs.CheckRemoteAccess() {
+		cp = append(cp, model.OkCheckPoint("hg remote access seems to be working"))
+	} else {
+		cp = append(cp, model.ErrorCheckPoint("hg remote access does not seem to be working"))
+	}
+	return cp
+}
+
+func checkHgAutoPush(p params.Params) (cp []model.CheckPoint) {
+	if p.AutoPush {
+		cp = append(cp, model.OkCheckPoint("hg auto-push is turned on: every commit will be pushed to remote"))
+	} else {
+		cp = append(cp, model.OkCheckPoint("hg auto-push is turned off: commits will only be applied locally"))
+	}
+	return cp
+}
@@ -0,0 +1,232 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package checker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/murex/tcr/checker/model"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/vcs"
+	"github.com/murex/tcr/vcs/fake"
+	"github.com/murex/tcr/vcs/hg"
+	"github.com/murex/tcr/vcs/shell"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_check_hg_environment(t *testing.T) {
+	assertCheckGroupRunner(t,
+		checkHgEnvironment,
+		&checkHgRunners,
+		*params.AParamSet(params.WithVCS(hg.Name)),
+		"mercurial environment")
+}
+
+func Test_check_hg_command(t *testing.T) {
+	tests := []struct {
+		desc     string
+		isInPath bool
+		fullPath string
+		version  string
+		expected []model.CheckPoint
+	}{
+		{
+			"hg command not found", false, "", "",
+			[]model.CheckPoint{
+				model.ErrorCheckPoint("hg command was not found on path"),
+			},
+		},
+		{
+			"hg command found", true, "some-path", "some-version",
+			[]model.CheckPoint{
+				model.OkCheckPoint("hg command path is some-path"),
+				model.OkCheckPoint("hg version is some-version"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Cleanup(hg.RestoreHgCommand)
+			shell.NewCommandFunc = func(name string, params ...string) shell.Command {
+				stub := hg.NewHgCommandStub()
+				stub.IsInPathFunc = func() bool {
+					return test.isInPath
+				}
+				stub.GetFullPathFunc = func() string {
+					return test.fullPath
+				}
+				stub.RunFunc = func(params ...string) (out []byte, err error) {
+					return []byte("Mercurial Distributed SCM (version " + test.version + ")"), nil
+				}
+				return stub
+			}
+			p := *params.AParamSet()
+			initTestCheckEnv(p)
+			assert.Equal(t, test.expected, checkHgCommand(p))
+		})
+	}
+}
+
+func Test_check_hg_config(t *testing.T) {
+	tests := []struct {
+		desc     string
+		username string
+		expected []model.CheckPoint
+	}{
+		{
+			"hg username not set", "not set",
+			[]model.CheckPoint{
+				model.WarningCheckPoint("hg username is not set"),
+			},
+		},
+		{
+			"hg username set", "jane-doe",
+			[]model.CheckPoint{
+				model.OkCheckPoint("hg username is jane-doe"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Cleanup(hg.RestoreHgCommand)
+			shell.NewCommandFunc = func(name string, params ...string) shell.Command {
+				stub := hg.NewHgCommandStub()
+				stub.RunFunc = func(params ...string) (out []byte, err error) {
+					return []byte(test.username), nil
+				}
+				return stub
+			}
+			p := *params.AParamSet()
+			initTestCheckEnv(p)
+			assert.Equal(t, test.expected, checkHgConfig(p))
+		})
+	}
+}
+
+func Test_check_hg_repository(t *testing.T) {
+	tests := []struct {
+		desc            string
+		sourceTreeError error
+		vcsError        error
+		expected        []model.CheckPoint
+	}{
+		{
+			"hg source tree init failed",
+			errors.New("hg source tree init failed"), nil,
+			[]model.CheckPoint{
+				model.ErrorCheckPoint("cannot retrieve hg repository information from base directory name"),
+			},
+		},
+		{
+			"hg error",
+			nil, errors.New("some hg error"),
+			[]model.CheckPoint{
+				model.ErrorCheckPoint("some hg error"),
+			},
+		},
+		{
+			"default branch warning",
+			nil, nil,
+			[]model.CheckPoint{
+				model.OkCheckPoint("hg repository root is vcs-fake-root-dir"),
+				model.OkCheckPoint("hg working branch is vcs-fake-working-branch"),
+				model.WarningCheckPoint("running TCR from hg default branch is not recommended"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := *params.AParamSet()
+			initTestCheckEnv(p)
+			checkEnv.sourceTreeErr = test.sourceTreeError
+			checkEnv.vcsErr = test.vcsError
+			assert.Equal(t, test.expected, checkHgRepository(p))
+		})
+	}
+}
+
+func Test_check_hg_remote(t *testing.T) {
+	tests := []struct {
+		desc     string
+		vcs      vcs.Interface
+		expected []model.CheckPoint
+	}{
+		{
+			"VCS not initialized", nil, []model.CheckPoint{},
+		},
+		{
+			"hg remote disabled",
+			fake.NewVCSFake(fake.Settings{RemoteEnabled: false}),
+			[]model.CheckPoint{
+				model.OkCheckPoint("hg remote is disabled: all operations will be done locally"),
+			},
+		},
+		{
+			"hg remote access not working",
+			fake.NewVCSFake(fake.Settings{RemoteEnabled: true, RemoteAccessWorking: false}),
+			[]model.CheckPoint{
+				model.OkCheckPoint("hg remote path is vcs-fake-remote-name"),
+				model.ErrorCheckPoint("hg remote access does not seem to be working"),
+			},
+		},
+		{
+			"hg remote access working",
+			fake.NewVCSFake(fake.Settings{RemoteEnabled: true, RemoteAccessWorking: true}),
+			[]model.CheckPoint{
+				model.OkCheckPoint("hg remote path is vcs-fake-remote-name"),
+				model.OkCheckPoint("hg remote access seems to be working"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			checkEnv.vcs = test.vcs
+			checkEnv.vcsErr = nil
+			assert.Equal(t, test.expected, checkHgRemote(*params.AParamSet()))
+		})
+	}
+}
+
+func Test_check_hg_auto_push(t *testing.T) {
+	tests := []struct {
+		desc     string
+		value    bool
+		expected []model.CheckPoint
+	}{
+		{"enabled", true, []model.CheckPoint{
+			model.OkCheckPoint("hg auto-push is turned on: every commit will be pushed to remote"),
+		},
+		},
+		{"disabled", false, []model.CheckPoint{
+			model.OkCheckPoint("hg auto-push is turned off: commits will only be applied locally"),
+		},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := *params.AParamSet(params.WithAutoPush(test.value))
+			assert.Equal(t, test.expected, checkHgAutoPush(p))
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package checker
+
+import (
+	"strings"
+
+	"github.com/murex/tcr/checker/model"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/variant"
+	"github.com/murex/tcr/vcs/jj"
+)
+
+var checkJjRunners []checkPointRunner
+
+func init() {
+	checkJjRunners = []checkPointRunner{
+		checkJjCommand,
+		checkJjConfig,
+		checkJjWorkspace,
+		checkJjRemote,
+		checkJjAutoPush,
+		checkJjVariant,
+	}
+}
+
+func checkJjEnvironment(p params.Params) (cg *model.CheckGroup) {
+	cg = model.NewCheckGroup("jujutsu environment")
+	// jj environment is checked only when jj is the selected VCS
+	if strings.ToLower(p.VCS) == jj.Name {
+		for _, runner := range checkJjRunners {
+			cg.Add(runner(p)...)
+		}
+	}
+	return cg
+}
+
+func checkJjCommand(_ params.Params) (cp []model.CheckPoint) {
+	if !jj.IsJjCommandAvailable() {
+		cp = append(cp, model.ErrorCheckPoint("jj command was not found on path"))
+		return cp
+	}
+	cp = append(cp, model.OkCheckPoint("jj command path is ", jj.GetJjCommandPath()))
+	cp = append(cp, model.OkCheckPoint("jj version is ", jj.GetJjCommandVersion()))
+	return cp
+}
+
+func checkJjConfig(_ params.Params) (cp []model.CheckPoint) {
+	if jj.GetJjUserName() == "not set" {
+		cp = append(cp, model.WarningCheckPoint("jj username is not set"))
+		return cp
+	}
+	cp = append(cp, model.OkCheckPoint("jj username is ", jj.GetJjUserName()))
+	return cp
+}
+
+func checkJjWorkspace(_ params.Params) (cp []model.CheckPoint) {
+	if checkEnv.sourceTreeErr != nil {
+		cp = append(cp, model.ErrorCheckPoint("cannot retrieve jj workspace information from base directory name"))
+		return cp
+	}
+	if checkEnv.vcsErr != nil {
+		cp = append(cp, model.ErrorCheckPoint(checkEnv.vcsErr))
+		return cp
+	}
+	if checkEnv.vcs == nil {
+		cp = append(cp, model.ErrorCheckPoint("jj workspace not properly initialized"))
+		return cp
+	}
+
+	cp = append(cp, model.OkCheckPoint("jj workspace root is ", checkEnv.vcs.GetRootDir()))
+	if checkEnv.vcs.GetWorkingBranch() == "" {
+		cp = append(cp, model.WarningCheckPoint("no jj bookmark found in working copy ancestors: ",
+			"commits will not be pushed"))
+		return cp
+	}
+	cp = append(cp, model.OkCheckPoint("jj working bookmark is ", checkEnv.vcs.GetWorkingBranch()))
+	if checkEnv.vcs.IsOnRootBranch() {
+		cp = append(cp, model.WarningCheckPoint("running TCR from a root bookmark is not recommended"))
+	}
+	return cp
+}
+
+func checkJjRemote(_ params.Params) (cp []model.CheckPoint) {
+	if checkEnv.vcs == nil || checkEnv.vcsErr != nil {
+		// If jj is not properly initialized, no point in trying to go further
+		return []model.CheckPoint{}
+	}
+
+	if !checkEnv.vcs.IsRemoteEnabled() {
+		cp = append(cp, model.OkCheckPoint("jj remote is disabled: all operations will be done locally"))
+		return cp
+	}
+
+	cp = append(cp, model.OkCheckPoint("jj remote name is ", checkEnv.vcs.GetRemoteName()))
+	if checkEnv.vcs.CheckRemoteAccess() {
+		cp = append(cp, model.OkCheckPoint("jj remote access seems to be working"))
+	} else {
+		cp = append(cp, model.ErrorCheckPoint("jj remote access does not seem to be working"))
+	}
+	return cp
+}
+
+func checkJjAutoPush(p params.Params) (cp []model.CheckPoint) {
+	if p.AutoPush {
+		cp = append(cp, model.OkCheckPoint("jj auto-push is turned on: every commit will be pushed to remote"))
+	} else {
+		cp = append(cp, model.OkCheckPoint("jj auto-push is turned off: commits will only be applied locally"))
+	}
+	return cp
+}
+
+func checkJjVariant(p params.Params) (cp []model.CheckPoint) {
+	if strings.ToLower(p.Variant) == variant.Shelve.Name() {
+		cp = append(cp, model.ErrorCheckPoint("shelve variant is not supported with jj"))
+	}
+	return cp
+}
@@ -0,0 +1,276 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package checker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/murex/tcr/checker/model"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/vcs"
+	"github.com/murex/tcr/vcs/fake"
+	"github.com/murex/tcr/vcs/jj"
+	"github.com/murex/tcr/vcs/shell"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_check_jj_environment(t *testing.T) {
+	assertCheckGroupRunner(t,
+		checkJjEnvironment,
+		&checkJjRunners,
+		*params.AParamSet(params.WithVCS(jj.Name)),
+		"jujutsu environment")
+}
+
+func Test_check_jj_command(t *testing.T) {
+	tests := []struct {
+		desc     string
+		isInPath bool
+		fullPath string
+		version  string
+		expected []model.CheckPoint
+	}{
+		{
+			"jj command not found", false, "", "",
+			[]model.CheckPoint{
+				model.ErrorCheckPoint("jj command was not found on path"),
+			},
+		},
+		{
+			"jj command found", true, "some-path", "some-version",
+			[]model.CheckPoint{
+				model.OkCheckPoint("jj command path is some-path"),
+				model.OkCheckPoint("jj version is some-version"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Cleanup(jj.RestoreJjCommand)
+			shell.NewCommandFunc = func(name string, params ...string) shell.Command {
+				stub := jj.NewJjCommandStub()
+				stub.IsInPathFunc = func() bool {
+					return test.isInPath
+				}
+				stub.GetFullPathFunc = func() string {
+					return test.fullPath
+				}
+				stub.RunFunc = func(params ...string) (out []byte, err error) {
+					return []byte("jj " + test.version), nil
+				}
+				return stub
+			}
+			p := *params.AParamSet()
+			initTestCheckEnv(p)
+			assert.Equal(t, test.expected, checkJjCommand(p))
+		})
+	}
+}
+
+func Test_check_jj_config(t *testing.T) {
+	tests := []struct {
+		desc     string
+		username string
+		expected []model.CheckPoint
+	}{
+		{
+			"jj username not set", "not set",
+			[]model.CheckPoint{
+				model.WarningCheckPoint("jj username is not set"),
+			},
+		},
+		{
+			"jj username set", "jane-doe",
+			[]model.CheckPoint{
+				model.OkCheckPoint("jj username is jane-doe"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Cleanup(jj.RestoreJjCommand)
+			shell.NewCommandFunc = func(name string, params ...string) shell.Command {
+				stub := jj.NewJjCommandStub()
+				stub.RunFunc = func(params ...string) (out []byte, err error) {
+					return []byte(test.username), nil
+				}
+				return stub
+			}
+			p := *params.AParamSet()
+			initTestCheckEnv(p)
+			assert.Equal(t, test.expected, checkJjConfig(p))
+		})
+	}
+}
+
+// noBookmarkVCSFake is a VCS fake with no working branch
+type noBookmarkVCSFake struct {
+	*fake.VCSFake
+}
+
+func (noBookmarkVCSFake) GetWorkingBranch() string {
+	return ""
+}
+
+func Test_check_jj_workspace(t *testing.T) {
+	tests := []struct {
+		desc            string
+		sourceTreeError error
+		vcsError        error
+		vcs             vcs.Interface
+		expected        []model.CheckPoint
+	}{
+		{
+			"jj source tree init failed",
+			errors.New("jj source tree init failed"), nil, nil,
+			[]model.CheckPoint{
+				model.ErrorCheckPoint("cannot retrieve jj workspace information from base directory name"),
+			},
+		},
+		{
+			"jj error",
+			nil, errors.New("some jj error"), nil,
+			[]model.CheckPoint{
+				model.ErrorCheckPoint("some jj error"),
+			},
+		},
+		{
+			"jj not initialized",
+			nil, nil, nil,
+			[]model.CheckPoint{
+				model.ErrorCheckPoint("jj workspace not properly initialized"),
+			},
+		},
+		{
+			"no working bookmark",
+			nil, nil, noBookmarkVCSFake{fake.NewVCSFake(fake.Settings{})},
+			[]model.CheckPoint{
+				model.OkCheckPoint("jj workspace root is vcs-fake-root-dir"),
+				model.WarningCheckPoint("no jj bookmark found in working copy ancestors: commits will not be pushed"),
+			},
+		},
+		{
+			"root bookmark warning",
+			nil, nil, fake.NewVCSFake(fake.Settings{}),
+			[]model.CheckPoint{
+				model.OkCheckPoint("jj workspace root is vcs-fake-root-dir"),
+				model.OkCheckPoint("jj working bookmark is vcs-fake-working-branch"),
+				model.WarningCheckPoint("running TCR from a root bookmark is not recommended"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := *params.AParamSet()
+			initTestCheckEnv(p)
+			checkEnv.sourceTreeErr = test.sourceTreeError
+			checkEnv.vcsErr = test.vcsError
+			checkEnv.vcs = test.vcs
+			assert.Equal(t, test.expected, checkJjWorkspace(p))
+		})
+	}
+}
+
+func Test_check_jj_remote(t *testing.T) {
+	tests := []struct {
+		desc     string
+		vcs      vcs.Interface
+		expected []model.CheckPoint
+	}{
+		{
+			"VCS not initialized", nil, []model.CheckPoint{},
+		},
+		{
+			"jj remote disabled",
+			fake.NewVCSFake(fake.Settings{RemoteEnabled: false}),
+			[]model.CheckPoint{
+				model.OkCheckPoint("jj remote is disabled: all operations will be done locally"),
+			},
+		},
+		{
+			"jj remote access not working",
+			fake.NewVCSFake(fake.Settings{RemoteEnabled: true, RemoteAccessWorking: false}),
+			[]model.CheckPoint{
+				model.OkCheckPoint("jj remote name is vcs-fake-remote-name"),
+				model.ErrorCheckPoint("jj remote access does not seem to be working"),
+			},
+		},
+		{
+			"jj remote access working",
+			fake.NewVCSFake(fake.Settings{RemoteEnabled: true, RemoteAccessWorking: true}),
+			[]model.CheckPoint{
+				model.OkCheckPoint("jj remote name is vcs-fake-remote-name"),
+				model.OkCheckPoint("jj remote access seems to be working"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			checkEnv.vcs = test.vcs
+			checkEnv.vcsErr = nil
+			assert.Equal(t, test.expected, checkJjRemote(*params.AParamSet()))
+		})
+	}
+}
+
+func Test_check_jj_auto_push(t *testing.T) {
+	tests := []struct {
+		desc     string
+		value    bool
+		expected []model.CheckPoint
+	}{
+		{"enabled", true, []model.CheckPoint{
+			model.OkCheckPoint("jj auto-push is turned on: every commit will be pushed to remote"),
+		},
+		},
+		{"disabled", false, []model.CheckPoint{
+			model.OkCheckPoint("jj auto-push is turned off: commits will only be applied locally"),
+		},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := *params.AParamSet(params.WithAutoPush(test.value))
+			assert.Equal(t, test.expected, checkJjAutoPush(p))
+		})
+	}
+}
+
+func Test_check_jj_variant(t *testing.T) {
+	tests := []struct {
+		variant  string
+		expected []model.CheckPoint
+	}{
+		{"relaxed", nil},
+		{"shelve", []model.CheckPoint{
+			model.ErrorCheckPoint("shelve variant is not supported with jj"),
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.variant, func(t *testing.T) {
+			p := *params.AParamSet(params.WithVariant(test.variant))
+			assert.Equal(t, test.expected, checkJjVariant(p))
+		})
+	}
+}
@@ -45,8 +45,11 @@ func init() {
 
 func checkLanguage(p params.Params) (cg *model.CheckGroup) {
 	cg = model.NewCheckGroup("language")
-	for _, runner := range checkLanguageRunners {
-		cg.Add(runner(p)...)
+	// session-wide language is checked only when the session declares no module
+	if len(p.Modules) == 0 {
+		for _, runner := range checkLanguageRunners {
+			cg.Add(runner(p)...)
+		}
 	}
 	return cg
 }
@@ -73,22 +76,32 @@ func checkLanguageDetection(p params.Params) (cp []model.CheckPoint) {
 	cp = append(cp, model.OkCheckPoint("language parameter is not set explicitly"))
 
 	if checkEnv.sourceTree == nil || !checkEnv.sourceTree.IsValid() {
-		cp = append(cp, model.ErrorCheckPoint("cannot retrieve language from base directory name"))
+		cp = append(cp, model.ErrorCheckPoint("cannot detect language without a valid base directory"))
 		return cp
 	}
 
-	cp = append(cp, model.OkCheckPoint("using base directory name for language detection"))
+	cp = append(cp, model.OkCheckPoint("using base directory contents for language detection"))
 	cp = append(cp, model.OkCheckPoint("base directory is ", checkEnv.sourceTree.GetBaseDir()))
+	cp = append(cp, checkpointsForDetections("language",
+		language.DetectLanguages(checkEnv.sourceTree.GetBaseDir()))...)
 
 	if checkEnv.langErr != nil {
 		cp = append(cp, model.ErrorCheckPoint(checkEnv.langErr))
 		return cp
 	}
 
-	cp = append(cp, model.OkCheckPoint("language retrieved from base directory name: ", checkEnv.lang.GetName()))
+	cp = append(cp, model.OkCheckPoint("language detected: ", checkEnv.lang.GetName()))
 	return cp
 }
 
+// checkpointsForDetections returns a checkpoint for each language or toolchain candidate
+func checkpointsForDetections(kind string, detections language.Detections) []model.CheckPoint {
+	return model.CheckpointsForList(
+		kind+" candidates found in base directory:",
+		"no "+kind+" marker found in base directory",
+		detections.Names()...)
+}
+
 func checkLanguageSrcDirectories(_ params.Params) (cp []model.CheckPoint) {
 	if checkEnv.lang == nil {
 		return cp
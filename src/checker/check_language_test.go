@@ -24,6 +24,8 @@ package checker
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/murex/tcr/checker/model"
@@ -74,6 +76,8 @@ func Test_check_language_parameter(t *testing.T) {
 }
 
 func Test_check_language_detection(t *testing.T) {
+	goDir := t.TempDir()
+	_ = os.WriteFile(filepath.Join(goDir, "go.mod"), []byte("module some-module"), 0600)
 	tests := []struct {
 		desc         string
 		langParam    string
@@ -90,7 +94,7 @@ func Test_check_language_detection(t *testing.T) {
 			nil,
 			[]model.CheckPoint{
 				model.OkCheckPoint("language parameter is not set explicitly"),
-				model.ErrorCheckPoint("cannot retrieve language from base directory name"),
+				model.ErrorCheckPoint("cannot detect language without a valid base directory"),
 			},
 		},
 		{
@@ -99,8 +103,9 @@ func Test_check_language_detection(t *testing.T) {
 			filesystem.NewFakeSourceTree("/some-path/wrong-language"),
 			[]model.CheckPoint{
 				model.OkCheckPoint("language parameter is not set explicitly"),
-				model.OkCheckPoint("using base directory name for language detection"),
+				model.OkCheckPoint("using base directory contents for language detection"),
 				model.OkCheckPoint("base directory is /some-path/wrong-language"),
+				model.WarningCheckPoint("no language marker found in base directory"),
 				model.ErrorCheckPoint("wrong language"),
 			},
 		},
@@ -110,9 +115,24 @@ func Test_check_language_detection(t *testing.T) {
 			filesystem.NewFakeSourceTree("/some-path/java"),
 			[]model.CheckPoint{
 				model.OkCheckPoint("language parameter is not set explicitly"),
-				model.OkCheckPoint("using base directory name for language detection"),
+				model.OkCheckPoint("using base directory contents for language detection"),
 				model.OkCheckPoint("base directory is /some-path/java"),
-				model.OkCheckPoint("language retrieved from base directory name: java"),
+				model.OkCheckPoint("language candidates found in base directory:"),
+				model.OkCheckPoint("- java (confidence: 50%)"),
+				model.OkCheckPoint("language detected: java"),
+			},
+		},
+		{
+			"language detected from base directory contents",
+			"", language.ALanguage(language.WithName("go")), nil,
+			filesystem.NewFakeSourceTree(goDir),
+			[]model.CheckPoint{
+				model.OkCheckPoint("language parameter is not set explicitly"),
+				model.OkCheckPoint("using base directory contents for language detection"),
+				model.OkCheckPoint("base directory is ", goDir),
+				model.OkCheckPoint("language candidates found in base directory:"),
+				model.OkCheckPoint("- go (confidence: 90%)"),
+				model.OkCheckPoint("language detected: go"),
 			},
 		},
 	}
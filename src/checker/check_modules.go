@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package checker
+
+import (
+	"github.com/murex/tcr/checker/model"
+	"github.com/murex/tcr/language"
+	"github.com/murex/tcr/params"
+)
+
+var checkModulesRunners []checkPointRunner
+
+func init() {
+	checkModulesRunners = []checkPointRunner{
+		checkModuleDeclarations,
+	}
+}
+
+func checkModules(p params.Params) (cg *model.CheckGroup) {
+	cg = model.NewCheckGroup("modules")
+	// modules are checked only when the session declares some
+	if len(p.Modules) > 0 {
+		for _, runner := range checkModulesRunners {
+			cg.Add(runner(p)...)
+		}
+	}
+	return cg
+}
+
+func checkModuleDeclarations(p params.Params) (cp []model.CheckPoint) {
+	if checkEnv.sourceTree == nil || !checkEnv.sourceTree.IsValid() {
+		cp = append(cp, model.ErrorCheckPoint("cannot check modules without a valid base directory"))
+		return cp
+	}
+	for _, declaration := range p.Modules {
+		m, err := language.GetModule(declaration, checkEnv.sourceTree.GetBaseDir())
+		if err != nil {
+			cp = append(cp, model.ErrorCheckPoint(err))
+			continue
+		}
+		cp = append(cp, model.OkCheckPoint("module ", m.Name, " uses ", m.Language.GetName(),
+			" language with ", m.Toolchain.GetName(), " toolchain"))
+	}
+	return cp
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package checker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/murex/tcr/checker/model"
+	"github.com/murex/tcr/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_check_modules(t *testing.T) {
+	assertCheckGroupRunner(t,
+		checkModules,
+		&checkModulesRunners,
+		*params.AParamSet(params.WithModules("backend")),
+		"modules")
+}
+
+func Test_language_and_toolchain_are_not_checked_when_modules_are_declared(t *testing.T) {
+	p := *params.AParamSet(params.WithModules("backend"))
+	assert.Equal(t, model.NewCheckGroup("language"), checkLanguage(p))
+	assert.Equal(t, model.NewCheckGroup("toolchain"), checkToolchain(p))
+}
+
+func Test_check_module_declarations(t *testing.T) {
+	baseDir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(baseDir, "backend"), 0750); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		desc     string
+		baseDir  string
+		modules  []string
+		expected []model.CheckPoint
+	}{
+		{
+			"invalid base directory", filepath.Join(baseDir, "missing"), []string{"backend:java"},
+			[]model.CheckPoint{
+				model.ErrorCheckPoint("cannot check modules without a valid base directory"),
+			},
+		},
+		{
+			"valid module", baseDir, []string{"backend:java:maven"},
+			[]model.CheckPoint{
+				model.OkCheckPoint("module backend uses java language with maven toolchain"),
+			},
+		},
+		{
+			"module directory not found", baseDir, []string{"frontend:typescript"},
+			[]model.CheckPoint{
+				model.ErrorCheckPoint("module directory not found: ", filepath.Join(baseDir, "frontend")),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := *params.AParamSet(params.WithBaseDir(test.baseDir), params.WithModules(test.modules...))
+			initTestCheckEnv(p)
+			assert.Equal(t, test.expected, checkModuleDeclarations(p))
+		})
+	}
+}
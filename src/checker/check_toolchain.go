@@ -26,8 +26,10 @@ import (
 	"runtime"
 
 	"github.com/murex/tcr/checker/model"
+	"github.com/murex/tcr/language"
 	"github.com/murex/tcr/params"
 	"github.com/murex/tcr/toolchain/command"
+	"github.com/murex/tcr/variant"
 )
 
 var checkToolchainRunners []checkPointRunner
@@ -40,14 +42,21 @@ func init() {
 		checkToolchainPlatform,
 		checkToolchainBuildCommand,
 		checkToolchainTestCommand,
+		checkToolchainLintCommand,
+		checkToolchainFormatCommand,
 		checkToolchainTestResultDir,
+		checkToolchainCoverageReport,
+		checkToolchainTestSelection,
 	}
 }
 
 func checkToolchain(p params.Params) (cg *model.CheckGroup) {
 	cg = model.NewCheckGroup("toolchain")
-	for _, runner := range checkToolchainRunners {
-		cg.Add(runner(p)...)
+	// session-wide toolchain is checked only when the session declares no module
+	if len(p.Modules) == 0 {
+		for _, runner := range checkToolchainRunners {
+			cg.Add(runner(p)...)
+		}
 	}
 	return cg
 }
@@ -95,13 +104,26 @@ func checkToolchainDetection(p params.Params) (cp []model.CheckPoint) {
 		return cp
 	}
 
-	cp = append(cp, model.OkCheckPoint("using language's default toolchain"))
+	var detections language.Detections
+	if checkEnv.sourceTree != nil && checkEnv.sourceTree.IsValid() {
+		detections = language.DetectToolchains(checkEnv.lang.GetToolchains(), checkEnv.sourceTree.GetBaseDir())
+	}
+	if len(detections) > 0 {
+		cp = append(cp, model.OkCheckPoint("using base directory contents for toolchain detection"))
+		cp = append(cp, checkpointsForDetections("toolchain", detections)...)
+	} else {
+		cp = append(cp, model.OkCheckPoint("using language's default toolchain"))
+	}
 
 	if checkEnv.tchnErr != nil {
 		cp = append(cp, model.ErrorCheckPoint(checkEnv.tchnErr))
 		return cp
 	}
 
+	if len(detections) > 0 {
+		cp = append(cp, model.OkCheckPoint("toolchain detected: ", checkEnv.tchn.GetName()))
+		return cp
+	}
 	cp = append(cp, model.OkCheckPoint("default toolchain for ",
 		checkEnv.lang.GetName(), " language is ",
 		checkEnv.lang.GetToolchains().Default))
@@ -131,6 +153,57 @@ func checkToolchainTestCommand(_ params.Params) (cp []model.CheckPoint) {
 	return checkCommandLine("test", checkEnv.tchn.TestCommandPath(), checkEnv.tchn.TestCommandLine())
 }
 
+func checkToolchainLintCommand(p params.Params) (cp []model.CheckPoint) {
+	if checkEnv.tchn == nil {
+		return cp
+	}
+	if policy, err := variant.SelectLintPolicy(p.LintPolicy); err != nil || !policy.IsOn() {
+		cp = append(cp, model.OkCheckPoint("lint stage is turned off"))
+		return cp
+	}
+	if !checkEnv.tchn.HasLintCommand() {
+		cp = append(cp, model.WarningCheckPoint(checkEnv.tchn.GetName(),
+			" toolchain has no lint command: lint policy is ignored"))
+		return cp
+	}
+	return checkCommandLine("lint", checkEnv.tchn.LintCommandPath(), checkEnv.tchn.LintCommandLine())
+}
+
+func checkToolchainFormatCommand(p params.Params) (cp []model.CheckPoint) {
+	if checkEnv.tchn == nil {
+		return cp
+	}
+	if !p.AutoFormat {
+		cp = append(cp, model.OkCheckPoint("auto-format is turned off"))
+		return cp
+	}
+	if !checkEnv.tchn.HasFormatCommand() {
+		cp = append(cp, model.WarningCheckPoint(checkEnv.tchn.GetName(),
+			" toolchain has no format command: code will not be formatted"))
+		return cp
+	}
+	return checkCommandLine("format", checkEnv.tchn.FormatCommandPath(), checkEnv.tchn.FormatCommandLine())
+}
+
+func checkToolchainCoverageReport(p params.Params) (cp []model.CheckPoint) {
+	if checkEnv.tchn == nil {
+		return cp
+	}
+	if !p.CoverageGate {
+		cp = append(cp, model.OkCheckPoint("coverage gate is turned off"))
+		return cp
+	}
+	report := checkEnv.tchn.GetCoverageReport()
+	if !report.IsSet() {
+		cp = append(cp, model.WarningCheckPoint(checkEnv.tchn.GetName(),
+			" toolchain has no coverage report: coverage gate is ignored"))
+		return cp
+	}
+	cp = append(cp, model.OkCheckPoint("coverage report format: ", report.Format))
+	cp = append(cp, model.OkCheckPoint("coverage report path: ", checkEnv.tchn.GetCoverageReportPath()))
+	return cp
+}
+
 func checkCommandLine(name string, cmdPath string, cmdLine string) (cp []model.CheckPoint) {
 	cp = append(cp, model.OkCheckPoint(name, " command line: ", cmdLine))
 
@@ -161,3 +234,21 @@ func checkToolchainTestResultDir(_ params.Params) (cp []model.CheckPoint) {
 		"test result directory absolute path is ", checkEnv.tchn.GetTestResultPath()))
 	return cp
 }
+
+func checkToolchainTestSelection(p params.Params) (cp []model.CheckPoint) {
+	if checkEnv.tchn == nil {
+		return cp
+	}
+	if !p.TestSelection {
+		cp = append(cp, model.OkCheckPoint("test selection is turned off: all tests are run on every cycle"))
+		return cp
+	}
+	if !checkEnv.tchn.SupportsTestSelection() {
+		cp = append(cp, model.WarningCheckPoint("test selection is not supported by ",
+			checkEnv.tchn.GetName(), " toolchain: all tests are run on every cycle"))
+		return cp
+	}
+	cp = append(cp, model.OkCheckPoint("test selection is turned on: all tests are run every ",
+		p.FullTestRunPeriod, " cycle(s)"))
+	return cp
+}
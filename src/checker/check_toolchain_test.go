@@ -24,11 +24,15 @@ package checker
 
 import (
 	"errors"
+	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"testing"
 
 	"github.com/murex/tcr/checker/model"
+	"github.com/murex/tcr/coverage"
+	"github.com/murex/tcr/filesystem"
 	"github.com/murex/tcr/language"
 	"github.com/murex/tcr/params"
 	"github.com/murex/tcr/toolchain"
@@ -116,6 +120,15 @@ func Test_check_toolchain_language_compatibility(t *testing.T) {
 }
 
 func Test_check_toolchain_detection(t *testing.T) {
+	gradleDir := t.TempDir()
+	_ = os.WriteFile(filepath.Join(gradleDir, "gradlew"), []byte{}, 0600)
+	_ = os.WriteFile(filepath.Join(gradleDir, "build.gradle"), []byte{}, 0600)
+	java := language.ALanguage(
+		language.WithName("java"),
+		language.WithDefaultToolchain("gradle"),
+		language.WithCompatibleToolchain("gradle"),
+		language.WithCompatibleToolchain("gradle-wrapper"),
+	)
 	tests := []struct {
 		desc         string
 		tchnParam    string
@@ -123,14 +136,15 @@ func Test_check_toolchain_detection(t *testing.T) {
 		tchnErr      error
 		lang         language.LangInterface
 		langErr      error
+		sourceTree   filesystem.SourceTree
 		expected     []model.CheckPoint
 	}{
 		{"toolchain param is set",
-			"some-toolchain", nil, nil, nil, nil, nil},
+			"some-toolchain", nil, nil, nil, nil, nil, nil},
 		{
 			"unknown language",
 			"", nil, nil,
-			nil, errors.New("unknown language"),
+			nil, errors.New("unknown language"), nil,
 			[]model.CheckPoint{
 				model.OkCheckPoint("toolchain parameter is not set explicitly"),
 				model.WarningCheckPoint("language is unknown"),
@@ -144,7 +158,7 @@ func Test_check_toolchain_detection(t *testing.T) {
 				language.WithName("java"),
 				language.WithDefaultToolchain("gradle"),
 				language.WithCompatibleToolchain("gradle"),
-			), nil,
+			), nil, nil,
 			[]model.CheckPoint{
 				model.OkCheckPoint("toolchain parameter is not set explicitly"),
 				model.OkCheckPoint("using language's default toolchain"),
@@ -158,13 +172,26 @@ func Test_check_toolchain_detection(t *testing.T) {
 				language.WithName("java"),
 				language.WithDefaultToolchain("gradle"),
 				language.WithCompatibleToolchain("gradle"),
-			), nil,
+			), nil, filesystem.NewFakeSourceTree(t.TempDir()),
 			[]model.CheckPoint{
 				model.OkCheckPoint("toolchain parameter is not set explicitly"),
 				model.OkCheckPoint("using language's default toolchain"),
 				model.OkCheckPoint("default toolchain for java language is gradle"),
 			},
 		},
+		{
+			"toolchain detected from base directory contents",
+			"", toolchain.AToolchain(toolchain.WithName("gradle-wrapper")), nil,
+			java, nil, filesystem.NewFakeSourceTree(gradleDir),
+			[]model.CheckPoint{
+				model.OkCheckPoint("toolchain parameter is not set explicitly"),
+				model.OkCheckPoint("using base directory contents for toolchain detection"),
+				model.OkCheckPoint("toolchain candidates found in base directory:"),
+				model.OkCheckPoint("- gradle-wrapper (confidence: 90%)"),
+				model.OkCheckPoint("- gradle (confidence: 60%)"),
+				model.OkCheckPoint("toolchain detected: gradle-wrapper"),
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -173,6 +200,7 @@ func Test_check_toolchain_detection(t *testing.T) {
 			checkEnv.tchnErr = test.tchnErr
 			checkEnv.lang = test.lang
 			checkEnv.langErr = test.langErr
+			checkEnv.sourceTree = test.sourceTree
 			assert.Equal(t, test.expected, checkToolchainDetection(p))
 		})
 	}
@@ -279,6 +307,140 @@ func Test_check_toolchain_test_command(t *testing.T) {
 	}
 }
 
+func Test_check_toolchain_lint_command(t *testing.T) {
+	lintCmd := command.ACommand(command.WithPath("go"), command.WithArgs([]string{"vet"}))
+	goPath, _ := exec.LookPath("go")
+	tests := []struct {
+		desc     string
+		tchn     toolchain.TchnInterface
+		policy   string
+		expected []model.CheckPoint
+	}{
+		{"with no toolchain", nil, "block", nil},
+		{
+			"with lint stage turned off",
+			toolchain.AToolchain(toolchain.WithLintCommand(lintCmd)),
+			"off",
+			[]model.CheckPoint{
+				model.OkCheckPoint("lint stage is turned off"),
+			},
+		},
+		{
+			"with toolchain having no lint command",
+			toolchain.AToolchain(),
+			"block",
+			[]model.CheckPoint{
+				model.WarningCheckPoint("default-toolchain toolchain has no lint command: lint policy is ignored"),
+			},
+		},
+		{
+			"with toolchain having a lint command",
+			toolchain.AToolchain(toolchain.WithLintCommand(lintCmd)),
+			"block",
+			[]model.CheckPoint{
+				model.OkCheckPoint("lint command line: go vet"),
+				model.OkCheckPoint("lint command path: ", goPath),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			checkEnv.tchn = test.tchn
+			p := *params.AParamSet(params.WithLintPolicy(test.policy))
+			assert.Equal(t, test.expected, checkToolchainLintCommand(p))
+		})
+	}
+}
+
+func Test_check_toolchain_format_command(t *testing.T) {
+	formatCmd := command.ACommand(command.WithPath("go"), command.WithArgs([]string{"fmt"}))
+	goPath, _ := exec.LookPath("go")
+	tests := []struct {
+		desc       string
+		tchn       toolchain.TchnInterface
+		autoFormat bool
+		expected   []model.CheckPoint
+	}{
+		{"with no toolchain", nil, true, nil},
+		{
+			"with auto-format turned off",
+			toolchain.AToolchain(toolchain.WithFormatCommand(formatCmd)),
+			false,
+			[]model.CheckPoint{
+				model.OkCheckPoint("auto-format is turned off"),
+			},
+		},
+		{
+			"with toolchain having no format command",
+			toolchain.AToolchain(),
+			true,
+			[]model.CheckPoint{
+				model.WarningCheckPoint("default-toolchain toolchain has no format command: code will not be formatted"),
+			},
+		},
+		{
+			"with toolchain having a format command",
+			toolchain.AToolchain(toolchain.WithFormatCommand(formatCmd)),
+			true,
+			[]model.CheckPoint{
+				model.OkCheckPoint("format command line: go fmt"),
+				model.OkCheckPoint("format command path: ", goPath),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			checkEnv.tchn = test.tchn
+			p := *params.AParamSet(params.WithAutoFormat(test.autoFormat))
+			assert.Equal(t, test.expected, checkToolchainFormatCommand(p))
+		})
+	}
+}
+
+func Test_check_toolchain_coverage_report(t *testing.T) {
+	tchn := toolchain.AToolchain(toolchain.WithCoverageReport(coverage.GoCover, "coverage.out"))
+	tests := []struct {
+		desc     string
+		tchn     toolchain.TchnInterface
+		gate     bool
+		expected []model.CheckPoint
+	}{
+		{"with no toolchain", nil, true, nil},
+		{
+			"with coverage gate turned off",
+			tchn,
+			false,
+			[]model.CheckPoint{
+				model.OkCheckPoint("coverage gate is turned off"),
+			},
+		},
+		{
+			"with toolchain having no coverage report",
+			toolchain.AToolchain(),
+			true,
+			[]model.CheckPoint{
+				model.WarningCheckPoint("default-toolchain toolchain has no coverage report: coverage gate is ignored"),
+			},
+		},
+		{
+			"with toolchain having a coverage report",
+			tchn,
+			true,
+			[]model.CheckPoint{
+				model.OkCheckPoint("coverage report format: ", coverage.GoCover),
+				model.OkCheckPoint("coverage report path: ", tchn.GetCoverageReportPath()),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			checkEnv.tchn = test.tchn
+			p := *params.AParamSet(params.WithCoverageGate(test.gate))
+			assert.Equal(t, test.expected, checkToolchainCoverageReport(p))
+		})
+	}
+}
+
 func Test_check_toolchain_test_result_dir(t *testing.T) {
 	workdir, _ := filepath.Abs("/")
 	tests := []struct {
@@ -313,3 +475,47 @@ func Test_check_toolchain_test_result_dir(t *testing.T) {
 		})
 	}
 }
+
+func Test_check_toolchain_test_selection(t *testing.T) {
+	selectionCmd := command.ACommand(command.WithArgs([]string{toolchain.AffectedTestsArg}))
+	tests := []struct {
+		desc          string
+		tchn          toolchain.TchnInterface
+		testSelection bool
+		expected      []model.CheckPoint
+	}{
+		{"with no toolchain", nil, true, nil},
+		{
+			"with test selection turned off",
+			toolchain.AToolchain(),
+			false,
+			[]model.CheckPoint{
+				model.OkCheckPoint("test selection is turned off: all tests are run on every cycle"),
+			},
+		},
+		{
+			"with toolchain not supporting test selection",
+			toolchain.AToolchain(),
+			true,
+			[]model.CheckPoint{
+				model.WarningCheckPoint("test selection is not supported by default-toolchain toolchain: " +
+					"all tests are run on every cycle"),
+			},
+		},
+		{
+			"with toolchain supporting test selection",
+			toolchain.AToolchain(toolchain.WithNoTestCommand(), toolchain.WithTestCommand(selectionCmd)),
+			true,
+			[]model.CheckPoint{
+				model.OkCheckPoint("test selection is turned on: all tests are run every 5 cycle(s)"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			checkEnv.tchn = test.tchn
+			p := *params.AParamSet(params.WithTestSelection(test.testSelection), params.WithFullTestRunPeriod(5))
+			assert.Equal(t, test.expected, checkToolchainTestSelection(p))
+		})
+	}
+}
@@ -35,6 +35,7 @@ var checkVariantRunners []checkPointRunner
 func init() {
 	checkVariantRunners = []checkPointRunner{
 		checkVariantSelection,
+		checkLintPolicy,
 	}
 }
 
@@ -48,7 +49,8 @@ func checkVariantConfiguration(p params.Params) (cg *model.CheckGroup) {
 
 func checkVariantSelection(p params.Params) (cp []model.CheckPoint) {
 	switch variantName := strings.ToLower(p.Variant); variantName {
-	case variant.Relaxed.Name(), variant.BTCR.Name(), variant.Introspective.Name():
+	case variant.Relaxed.Name(), variant.BTCR.Name(), variant.Introspective.Name(), variant.Shelve.Name(),
+		variant.RedGreenRefactor.Name():
 		cp = append(cp, model.OkCheckPoint("selected variant is ", variantName))
 	case "original":
 		cp = append(cp, model.ErrorCheckPoint("original variant is not yet supported"))
@@ -59,3 +61,13 @@ func checkVariantSelection(p params.Params) (cp []model.CheckPoint) {
 	}
 	return cp
 }
+
+func checkLintPolicy(p params.Params) (cp []model.CheckPoint) {
+	policy, err := variant.SelectLintPolicy(p.LintPolicy)
+	if err != nil {
+		cp = append(cp, model.ErrorCheckPoint(err))
+		return cp
+	}
+	cp = append(cp, model.OkCheckPoint("selected lint policy is ", policy.Name()))
+	return cp
+}
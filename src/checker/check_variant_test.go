@@ -80,6 +80,18 @@ func Test_check_variant_selection(t *testing.T) {
 				model.OkCheckPoint("selected variant is relaxed"),
 			},
 		},
+		{
+			"Shelve", "shelve",
+			[]model.CheckPoint{
+				model.OkCheckPoint("selected variant is shelve"),
+			},
+		},
+		{
+			"Red-green-refactor", "red-green-refactor",
+			[]model.CheckPoint{
+				model.OkCheckPoint("selected variant is red-green-refactor"),
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -88,3 +100,36 @@ func Test_check_variant_selection(t *testing.T) {
 		})
 	}
 }
+
+func Test_check_lint_policy(t *testing.T) {
+	tests := []struct {
+		desc       string
+		policyName string
+		expected   []model.CheckPoint
+	}{
+		{
+			"unknown", "unknown-policy",
+			[]model.CheckPoint{
+				model.ErrorCheckPoint("lint policy not supported: \"unknown-policy\""),
+			},
+		},
+		{
+			"off", "off",
+			[]model.CheckPoint{
+				model.OkCheckPoint("selected lint policy is off"),
+			},
+		},
+		{
+			"Block", "Block",
+			[]model.CheckPoint{
+				model.OkCheckPoint("selected lint policy is block"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := *params.AParamSet(params.WithLintPolicy(test.policyName))
+			assert.Equal(t, test.expected, checkLintPolicy(p))
+		})
+	}
+}
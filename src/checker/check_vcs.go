@@ -29,6 +29,8 @@ import (
 	"github.com/murex/tcr/checker/model"
 	"github.com/murex/tcr/params"
 	"github.com/murex/tcr/vcs/git"
+	"github.com/murex/tcr/vcs/hg"
+	"github.com/murex/tcr/vcs/jj"
 	"github.com/murex/tcr/vcs/p4"
 )
 
@@ -51,7 +53,7 @@ func checkVCSConfiguration(p params.Params) (cg *model.CheckGroup) {
 
 func checkVCSSelection(p params.Params) (cp []model.CheckPoint) {
 	switch vcs := strings.ToLower(p.VCS); vcs {
-	case git.Name, p4.Name:
+	case git.Name, p4.Name, hg.Name, jj.Name:
 		cp = append(cp, model.OkCheckPoint("selected VCS is ", vcs))
 	case "":
 		cp = append(cp, model.ErrorCheckPoint("no VCS is selected"))
@@ -29,6 +29,8 @@ import (
 	"github.com/murex/tcr/checker/model"
 	"github.com/murex/tcr/params"
 	"github.com/murex/tcr/vcs/git"
+	"github.com/murex/tcr/vcs/hg"
+	"github.com/murex/tcr/vcs/jj"
 	"github.com/murex/tcr/vcs/p4"
 	"github.com/stretchr/testify/assert"
 )
@@ -83,6 +85,18 @@ func Test_check_vcs_selection(t *testing.T) {
 				model.OkCheckPoint("selected VCS is p4"),
 			},
 		},
+		{
+			"hg", hg.Name,
+			[]model.CheckPoint{
+				model.OkCheckPoint("selected VCS is hg"),
+			},
+		},
+		{
+			"jj", jj.Name,
+			[]model.CheckPoint{
+				model.OkCheckPoint("selected VCS is jj"),
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -58,9 +58,12 @@ var checkGroupRunners = []checkGroupRunner{
 	checkDirectories,
 	checkLanguage,
 	checkToolchain,
+	checkModules,
 	checkVCSConfiguration,
 	checkGitEnvironment,
 	checkP4Environment,
+	checkHgEnvironment,
+	checkJjEnvironment,
 	checkVariantConfiguration,
 	checkMobConfiguration,
 }
@@ -24,6 +24,8 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/logrusorgru/aurora"
@@ -37,15 +39,29 @@ const (
 var (
 	colorizer  = aurora.NewAurora(true)
 	linePrefix = ""
+	// printToStderr indicates that terminal messages are printed to stderr instead of stdout
+	printToStderr = false
 )
 
 func setLinePrefix(value string) {
 	linePrefix = value
 }
 
+func setPrintToStderr(value bool) {
+	printToStderr = value
+}
+
+// output returns where terminal messages are printed
+func output() io.Writer {
+	if printToStderr {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
 func printPrefixedAndColored(fgColor aurora.Color, message string) {
 	setupTerminal()
-	_, _ = fmt.Println(
+	_, _ = fmt.Fprintln(output(),
 		colorizer.Colorize(linePrefix, fgColor),
 		colorizer.Colorize(message, fgColor))
 }
@@ -67,7 +83,7 @@ func printInRed(a ...any) {
 }
 
 func printUntouched(a ...any) {
-	_, _ = fmt.Println(a...)
+	_, _ = fmt.Fprintln(output(), a...)
 }
 
 func printHorizontalLine() {
@@ -57,6 +57,20 @@ func Test_print_untouched_function_does_not_alter_data(t *testing.T) {
 	assert.Equal(t, msg+"\n", out)
 }
 
+func Test_print_to_stderr(t *testing.T) {
+	msg := "Dummy Message"
+	setPrintToStderr(true)
+	t.Cleanup(func() { setPrintToStderr(false) })
+	var stdout string
+	stderr := capturer.CaptureStderr(func() {
+		stdout = capturer.CaptureStdout(func() {
+			printUntouched(msg)
+		})
+	})
+	assert.Equal(t, msg+"\n", stderr)
+	assert.Empty(t, stdout)
+}
+
 func assertPrintInColor(t *testing.T, printInColorFunc func(a ...interface{}), ansiCode string) {
 	msg := "Some message in color"
 	assertPrintFormatting(t, func() { printInColorFunc(msg) }, ansiCode, "TCR", msg)
@@ -23,11 +23,16 @@ SOFTWARE.
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/murex/tcr/desktop"
 	"github.com/murex/tcr/engine"
+	"github.com/murex/tcr/export"
 	"github.com/murex/tcr/params"
 	"github.com/murex/tcr/report"
 	"github.com/murex/tcr/report/role_event"
@@ -38,6 +43,8 @@ import (
 	"github.com/murex/tcr/settings"
 	"github.com/murex/tcr/timer"
 	"github.com/murex/tcr/vcs/git"
+	"github.com/murex/tcr/vcs/hg"
+	"github.com/murex/tcr/vcs/jj"
 	"github.com/murex/tcr/vcs/p4"
 )
 
@@ -64,6 +71,7 @@ const (
 	openBrowserMenuHelper        = "Open in browser"
 	gitAutoPushMenuHelper        = "Turn on/off git auto-push"
 	abortCommandMenuHelper       = "Abort current command"
+	commitIntentMenuHelper       = "Set commit intent"
 	quitMenuHelper               = "Quit"
 	optionsMenuHelper            = "List available options"
 	timerStatusMenuHelper        = "Timer status"
@@ -77,6 +85,8 @@ const timerMessagePrefix = "(Mob Timer) "
 // New creates a new instance of terminal
 func New(p params.Params, tcr engine.TCRInterface) *TerminalUI {
 	setLinePrefix("[" + settings.ApplicationName + "]")
+	// Keep the standard output for exported data only
+	setPrintToStderr(isExportingToStdout(p))
 	term := TerminalUI{params: p, tcr: tcr, desktop: desktop.NewDesktop(nil)}
 	tcr.AttachUI(&term, true)
 	term.soloMenu = term.initSoloMenu()
@@ -87,6 +97,12 @@ func New(p params.Params, tcr engine.TCRInterface) *TerminalUI {
 	return &term
 }
 
+// isExportingToStdout indicates if TCR is going to write machine-readable data to the standard output
+func isExportingToStdout(p params.Params) bool {
+	return (p.Mode == runmode.Log{} || p.Mode == runmode.Stats{}) &&
+		export.IsMachineReadable(p.Format) && p.OutputFile == ""
+}
+
 // StartReporting tells the terminal to start reporting information
 func (term *TerminalUI) StartReporting() {
 	term.reportingChannel = report.Subscribe(term)
@@ -341,7 +357,7 @@ func (term *TerminalUI) ShowSessionInfo() {
 
 func (term *TerminalUI) printVCSInfo(info engine.SessionInfo) {
 	switch info.VCSName {
-	case git.Name:
+	case git.Name, hg.Name, jj.Name:
 		autoPush := "disabled"
 		if info.GitAutoPush {
 			autoPush = "enabled"
@@ -436,6 +452,16 @@ func (term *TerminalUI) Start() {
 		// we directly ask TCR engine to generate the retrospective file and quit when done
 		term.tcr.GenerateRetro(term.params)
 		term.tcr.Quit()
+	case runmode.Recover{}:
+		// When running TCR in recover mode, there's no selection menu:
+		// we directly ask TCR engine to list or re-apply shelved changes and quit when done
+		term.tcr.Recover(term.params)
+		term.tcr.Quit()
+	case runmode.Mutate{}:
+		// When running TCR in mutate mode, there's no selection menu:
+		// we directly ask TCR engine to run mutation tests and quit when done
+		term.tcr.RunMutationTests(term.params)
+		term.tcr.Quit()
 	default:
 		term.printError("Unknown run mode: ", term.params.Mode)
 	}
@@ -465,6 +491,9 @@ func (term *TerminalUI) initCommonMenuOptions() []*menuOption {
 		newMenuOption('A', abortCommandMenuHelper,
 			term.abortCommandEnabler(),
 			term.abortCommandMenuAction(), false),
+		newMenuOption('I', commitIntentMenuHelper,
+			term.commitIntentMenuEnabler(),
+			term.commitIntentMenuAction(), false),
 	}
 }
 
@@ -527,13 +556,14 @@ func (term *TerminalUI) enterRoleMenuAction(r role.Role) menuAction {
 
 func (term *TerminalUI) openBrowserMenuAction() menuAction {
 	return func() {
-		desktop.OpenBrowser(term.params.PortNumber)
+		desktop.OpenBrowser(term.params.PortNumber, term.params.TLS, term.params.WebToken)
 	}
 }
 
 func (term *TerminalUI) gitMenuEnabler() menuEnabler {
 	return func() bool {
-		return term.params.VCS == git.Name
+		// Distributed VCS share the same menu options as git
+		return slices.Contains([]string{git.Name, hg.Name, jj.Name}, term.params.VCS)
 	}
 }
 
@@ -611,6 +641,51 @@ func (term *TerminalUI) abortCommandEnabler() menuEnabler {
 	}
 }
 
+func (term *TerminalUI) commitIntentMenuEnabler() menuEnabler {
+	return func() bool {
+		// Commit intent is only used by commit message templates
+		return term.tcr.GetCurrentRole() == role.Driver{} && engine.UsesMessageTemplate(term.params.MessageTemplate)
+	}
+}
+
+func (term *TerminalUI) commitIntentMenuAction() menuAction {
+	return func() {
+		term.readCommitIntent()
+	}
+}
+
+// readCommitIntent prompts the user for the intent of the next commits
+func (term *TerminalUI) readCommitIntent() {
+	if term.params.Mode.IsInteractive() {
+		Restore()
+		defer SetRaw()
+	}
+	term.printInfo("Enter commit intent (leave empty to clear it):")
+	intent, err := readLine()
+	if err != nil {
+		term.printWarning("Something went wrong while reading from stdin: ", err)
+		return
+	}
+	term.tcr.SetCommitIntent(intent)
+}
+
+// readLine reads stdin until the end of the line. Stdin is read one byte at a time
+// so that nothing is consumed beyond the end of the line
+func readLine() (string, error) {
+	var line strings.Builder
+	input := make([]byte, 1)
+	for {
+		n, err := os.Stdin.Read(input)
+		if errors.Is(err, io.EOF) || (n == 1 && (input[0] == '\n' || input[0] == '\r')) {
+			return line.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		line.WriteByte(input[0])
+	}
+}
+
 func (term *TerminalUI) quitRoleMenuEnabler(r role.Role) menuEnabler {
 	return func() bool {
 		return term.tcr.GetCurrentRole() == r
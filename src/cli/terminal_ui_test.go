@@ -548,6 +548,16 @@ func Test_print_vcs_info(t *testing.T) {
 			engine.SessionInfo{VCSName: "git", VCSSessionSummary: "git branch \"my-branch\"", GitAutoPush: false},
 			asCyanTrace("Running on git branch \"my-branch\" with auto-push disabled"),
 		},
+		{
+			"hg with auto-push on",
+			engine.SessionInfo{VCSName: "hg", VCSSessionSummary: "hg branch \"my-branch\"", GitAutoPush: true},
+			asCyanTrace("Running on hg branch \"my-branch\" with auto-push enabled"),
+		},
+		{
+			"jj with auto-push off",
+			engine.SessionInfo{VCSName: "jj", VCSSessionSummary: "jj bookmark \"my-bookmark\"", GitAutoPush: false},
+			asCyanTrace("Running on jj bookmark \"my-bookmark\" with auto-push disabled"),
+		},
 		{
 			"p4",
 			engine.SessionInfo{VCSName: "p4", VCSSessionSummary: "p4 client \"my-client\"", GitAutoPush: false},
@@ -877,6 +887,41 @@ func Test_mob_menu_actions(t *testing.T) {
 	}
 }
 
+func Test_commit_intent_menu_action(t *testing.T) {
+	testFlags := []struct {
+		desc            string
+		messageTemplate string
+		input           string
+		expected        []engine.TCRCall
+	}{
+		{
+			"I key has no action with default message template", "default", "diqq",
+			[]engine.TCRCall{engine.TCRCallRunAsDriver, engine.TCRCallStop, engine.TCRCallQuit},
+		},
+		{
+			"I key sets commit intent with a message template", "conventional", "di" + "add login\n" + "qq",
+			[]engine.TCRCall{engine.TCRCallRunAsDriver, engine.TCRCallSetCommitIntent, engine.TCRCallStop, engine.TCRCallQuit},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			stdin := os.Stdin
+			stdout := os.Stdout
+			stderr := os.Stderr
+			defer func() { os.Stdin = stdin; os.Stdout = stdout; os.Stderr = stderr }()
+			os.Stdin = fakeStdin(t, []byte(tt.input))
+			os.Stdout = os.NewFile(0, os.DevNull)
+			os.Stderr = os.NewFile(0, os.DevNull)
+
+			term, fakeEngine, _ := terminalSetup(*params.AParamSet(
+				params.WithVCS(git.Name), params.WithMessageTemplate(tt.messageTemplate)))
+			term.enterMobMenu()
+			assert.Equal(t, tt.expected, fakeEngine.GetCallHistory())
+			terminalTeardown(*term)
+		})
+	}
+}
+
 func assertMainMenuActions(t *testing.T, vcsName string, input []byte, expected []engine.TCRCall) {
 	t.Helper()
 	stdin := os.Stdin
@@ -953,6 +998,20 @@ func Test_start_terminal(t *testing.T) {
 				engine.TCRCallQuit,
 			},
 		},
+		{
+			"recover mode", runmode.Recover{}, []byte{},
+			[]engine.TCRCall{
+				engine.TCRCallRecover,
+				engine.TCRCallQuit,
+			},
+		},
+		{
+			"mutate mode", runmode.Mutate{}, []byte{},
+			[]engine.TCRCall{
+				engine.TCRCallRunMutationTests,
+				engine.TCRCallQuit,
+			},
+		},
 	}
 	for _, tt := range testFlags {
 		t.Run(tt.desc, func(t *testing.T) {
@@ -978,3 +1037,28 @@ func assertStartTerminal(t *testing.T, mode runmode.RunMode, input []byte, expec
 	terminalTeardown(*term)
 	assert.Equal(t, expected, fakeEngine.GetCallHistory())
 }
+
+func Test_is_exporting_to_stdout(t *testing.T) {
+	testFlags := []struct {
+		desc     string
+		p        *params.Params
+		expected bool
+	}{
+		{"log mode with text format",
+			params.AParamSet(params.WithRunMode(runmode.Log{})), false},
+		{"log mode with json format",
+			params.AParamSet(params.WithRunMode(runmode.Log{}), params.WithFormat("json")), true},
+		{"stats mode with csv format",
+			params.AParamSet(params.WithRunMode(runmode.Stats{}), params.WithFormat("csv")), true},
+		{"stats mode with yaml format and output file",
+			params.AParamSet(params.WithRunMode(runmode.Stats{}), params.WithFormat("yaml"),
+				params.WithOutputFile("stats.yaml")), false},
+		{"solo mode with json format",
+			params.AParamSet(params.WithRunMode(runmode.Solo{}), params.WithFormat("json")), false},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isExportingToStdout(*tt.p))
+		})
+	}
+}
@@ -44,6 +44,17 @@ branch set for this repository.
 
 Only TCR commits are printed. All other commits are filtered out.
 
+The history can be restricted with --since, --until and --author options,
+and retrieved from other branches with --branch option.
+
+The history can be exported in a machine-readable format with --format option
+(json, csv or yaml), either to the standard output or into the file
+provided with --output-file option.
+
+When --from-journal option is set, the entries of the local session journal
+are printed instead of the commit history. The journal also contains
+build failures, reverts, aborted commands and role switches.
+
 This subcommand does not start TCR engine.`,
 	Run: func(_ *cobra.Command, _ []string) {
 		parameters.Mode = runmode.Log{}
@@ -23,8 +23,13 @@ SOFTWARE.
 package cmd
 
 import (
+	"context"
+	"os"
+
 	"github.com/murex/tcr/cli"
 	"github.com/murex/tcr/engine"
+	"github.com/murex/tcr/http"
+	"github.com/murex/tcr/mob"
 	"github.com/murex/tcr/runmode"
 	"github.com/spf13/cobra"
 )
@@ -36,21 +41,68 @@ var mobCmd = &cobra.Command{
 	Long: `
 When used in "mob" mode, TCR ensures that any commit
 is shared with other participants through calling VCS push-pull.
+
+Mob participants can be listed with --members option. TCR then
+announces driver rotations and records the current driver
+as co-author of TCR commits.
+
+With --handover option, uncommitted changes are handed over
+from one driver to the next through a dedicated remote branch.
+
+With --mob-host option, TCR starts an HTTP server (port 8483 by default)
+accepting connections from other TCR instances on the local network.
+Guests must provide the token set with --mob-token option, or the
+one generated and displayed by the mob host when not set.
+
+With --mob-join option, TCR follows the session of the mob host
+listening at the provided address (host:port) instead of running
+TCR engine: it displays the driver's cycle results, diffs and timer
+events, and allows requesting role changes on the mob host.
+When the mob host serves requests over TLS, the guest must provide
+with --mob-fingerprint option the certificate fingerprint displayed
+by the mob host.
 `,
 	Run: func(_ *cobra.Command, _ []string) {
+		if parameters.MobJoin != "" {
+			joinMobHost()
+			return
+		}
+
 		parameters.Mode = runmode.Mob{}
 		parameters.AutoPush = parameters.Mode.AutoPushDefault()
 
-		// Create TCR engine and UI instance
+		// Create TCR engine and UI instances
 		tcr := engine.NewTCREngine()
+		var h *http.WebUIServer
+		if parameters.MobHost {
+			// Same default port number as with web subcommand
+			if parameters.PortNumber == 0 {
+				parameters.PortNumber = 8483
+			}
+			http.InitSessionToken(&parameters)
+			h = http.New(parameters, tcr)
+		}
 		u := cli.New(parameters, tcr)
 
-		// Initialize TCR engine and start UI
+		// Initialize TCR engine and start UIs
 		tcr.Init(parameters)
+		if h != nil {
+			h.Start()
+		}
 		u.Start()
 	},
 }
 
+// joinMobHost follows the session of a mob host until the guest quits
+func joinMobHost() {
+	guest, err := mob.NewGuest(parameters.MobJoin, parameters.MobToken, os.Stdout)
+	cobra.CheckErr(err)
+	if parameters.TLS {
+		cobra.CheckErr(guest.UseTLS(parameters.MobFingerprint))
+	}
+	cobra.CheckErr(guest.Run(context.Background(), os.Stdin))
+}
+
 func init() {
 	rootCmd.AddCommand(mobCmd)
 }
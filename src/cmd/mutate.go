@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"github.com/murex/tcr/cli"
+	"github.com/murex/tcr/engine"
+	"github.com/murex/tcr/runmode"
+	"github.com/spf13/cobra"
+)
+
+// mutateCmd represents the mutate command
+var mutateCmd = &cobra.Command{
+	Use:   "mutate",
+	Short: "Run mutation tests on the code changed by the last TCR commits",
+	Long: `
+TCR mutate subcommand checks that the code changed by the last TCR commits
+is actually covered by test assertions.
+
+The source files changed by the last TCR commits (cf. --mutation-commits option)
+are mutated one change at a time, by swapping an operator or a boolean literal
+with another one (== with !=, < with >=, && with ||, + with -, true with false, etc.).
+Build and tests are run through the toolchain for each of these mutants:
+
+- a mutant is killed when tests fail
+- a mutant survives when tests pass: the mutated code is not checked by any assertion
+- a mutant is ignored when it does not build
+
+Surviving mutants are reported along with the resulting mutation score.
+Mutation is currently available for Go and Java source files.
+
+Source files are modified in place and restored once mutated. The working tree
+must have no local changes, and tests must pass before mutation starts.
+
+This subcommand does not start TCR engine.`,
+	Args: cobra.NoArgs,
+	Run: func(_ *cobra.Command, _ []string) {
+		parameters.Mode = runmode.Mutate{}
+		u := cli.New(parameters, engine.NewTCREngine())
+		u.Start()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(mutateCmd)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"github.com/murex/tcr/cli"
+	"github.com/murex/tcr/engine"
+	"github.com/murex/tcr/runmode"
+	"github.com/spf13/cobra"
+)
+
+// recoverCmd represents the recover command
+var recoverCmd = &cobra.Command{
+	Use:   "recover [shelf-id]",
+	Short: "List or re-apply changes shelved by TCR",
+	Long: `
+TCR recover subcommand gives access to the changes that were shelved
+when running TCR with the "shelve" variant (cf. -r option).
+
+With the shelve variant, the changes that would normally be lost when tests
+fail are saved in the VCS recovery area before being reverted:
+a stash entry with git, a shelved changelist with p4.
+
+When called without argument, TCR recover lists all shelved changes
+along with the TCR info recorded at the time they were shelved.
+
+When called with a shelf-id argument, TCR recover re-applies the
+corresponding changes in the working directory. The shelf entry
+is kept so that it can be recovered again if needed.
+
+This subcommand does not start TCR engine.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(_ *cobra.Command, args []string) {
+		parameters.Mode = runmode.Recover{}
+		if len(args) > 0 {
+			parameters.ShelfID = args[0]
+		}
+
+		// Create TCR engine and UI instance
+		tcr := engine.NewTCREngine()
+		u := cli.New(parameters, tcr)
+
+		// Initialize TCR engine and start UI
+		tcr.Init(parameters)
+		u.Start()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(recoverCmd)
+}
@@ -41,10 +41,29 @@ The following information is included in the markdown:
 
 - Average size of changes per passing commit 
 - Average size of changes per failing commit
+- Number of passing and failing commits
+- Time spent in green and in red
+- Time between commits (commit cadence)
+- Size of changes per commit, and the largest commits
+- Evolution of test counts and test execution duration, with Mermaid charts
+
+When --format option is set to html, the retrospective is generated as an HTML page
+with inline SVG charts and saved with the name 'tcr-retro.html'.
+The output file location can be changed with --output-file option.
+
+A custom template (Go text/template syntax) can be provided with --template option.
+When the TCR configuration directory contains a 'retro/retro.md' or 'retro/retro.html' file,
+it is used instead of the built-in template for the corresponding format.
 
 These stats are extracted for the repository containing TCR base directory (cf. -b option). 
 The branch is the current working branch set for this repository.
 
+The history can be restricted with --since, --until and --author options,
+and retrieved from other branches with --branch option.
+
+When --from-journal option is set, these stats are extracted from the local session journal
+instead of the commit history.
+
 This subcommand does not start TCR engine.`,
 	Run: func(_ *cobra.Command, _ []string) {
 		parameters.Mode = runmode.Retro{}
@@ -40,6 +40,9 @@ The commit history is retrieved for the repository containing
 TCR base directory (cf. -b option). The branch is the current working
 branch set for this repository.
 
+The history can be restricted with --since, --until and --author options,
+and retrieved from other branches with --branch option.
+
 The following stats are reported:
 
 - First commit date and time
@@ -64,6 +67,20 @@ The following stats are reported:
 > - "Number of failing commits" and "time in red" will always be at 0%
 > - "Failing tests" will always be at 0
 
+Stats can be exported in a machine-readable format with --format option
+(json, csv or yaml), either to the standard output or into the file
+provided with --output-file option. Exported durations are expressed in seconds.
+JSON and YAML formats also include the list of TCR events used to compute the stats.
+
+When --from-journal option is set, stats are computed from the local session journal
+instead of the commit history, and the following stats are reported in addition:
+
+- Number of builds
+- Number of failing builds (absolute value and percentage)
+- Number of reverts (absolute value and percentage of test runs)
+- Number of aborted commands
+- Number of driver turns
+
 This subcommand does not start TCR engine.`,
 	Run: func(_ *cobra.Command, _ []string) {
 		parameters.Mode = runmode.Stats{}
@@ -42,6 +42,14 @@ addition to the command line interface.
 TCR is listening by default to port 8483. The port number
 can be changed through the port-number option.
 
+TCR is listening by default on 127.0.0.1 only. The bind-address
+option allows listening on another network address. In this
+case requests sent from remote hosts must provide the session
+token generated at startup and printed in the terminal.
+The web-auth option requires this token for local requests
+too, and the tls option serves the web UI over HTTPS with
+a self-signed certificate.
+
 IMPORTANT: This feature is still at an experimental stage!
 `,
 	Run: func(_ *cobra.Command, _ []string) {
@@ -58,6 +66,8 @@ IMPORTANT: This feature is still at an experimental stage!
 			parameters.PortNumber = 8483
 		}
 
+		http.InitSessionToken(&parameters)
+
 		// Create TCR engine and UI instances
 		tcr := engine.NewTCREngine()
 		h := http.New(parameters, tcr)
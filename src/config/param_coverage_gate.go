@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package config
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// AddCoverageGateParam adds coverage-gate parameter to the provided command
+func AddCoverageGateParam(cmd *cobra.Command) *BoolParam {
+	param := BoolParam{
+		s: paramSettings{
+			viperSettings: viperSettings{
+				enabled: true,
+				keyPath: "config.tcr",
+				name:    "coverage-gate",
+			},
+			cobraSettings: cobraSettings{
+				name:       "coverage-gate",
+				shorthand:  "",
+				usage:      "revert changes lowering the line coverage found in the toolchain's coverage report",
+				persistent: true,
+			},
+		},
+		v: paramValueBool{
+			value:        false,
+			defaultValue: false,
+		},
+	}
+	param.addToCommand(cmd)
+	return &param
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package config
+
+import (
+	"github.com/murex/tcr/hook"
+	"github.com/spf13/cobra"
+)
+
+var hookUsages = map[hook.Name]string{
+	hook.PreBuild:     "shell command run before building, a non-zero exit code aborts the TCR cycle",
+	hook.PostTest:     "shell command run after running tests",
+	hook.PreCommit:    "shell command run before committing, a non-zero exit code vetoes the commit",
+	hook.PostCommit:   "shell command run after committing",
+	hook.PostRevert:   "shell command run after reverting changes",
+	hook.OnRoleChange: "shell command run when starting or ending a driver or navigator role",
+}
+
+// AddHookParams adds lifecycle hook parameters to the provided command, one per hook
+func AddHookParams(cmd *cobra.Command) map[hook.Name]*StringParam {
+	params := make(map[hook.Name]*StringParam)
+	for _, name := range hook.Names() {
+		params[name] = addHookParam(cmd, name)
+	}
+	return params
+}
+
+func addHookParam(cmd *cobra.Command, name hook.Name) *StringParam {
+	param := StringParam{
+		s: paramSettings{
+			viperSettings: viperSettings{
+				enabled: true,
+				keyPath: "config.hooks",
+				name:    string(name),
+			},
+			cobraSettings: cobraSettings{
+				name:       string(name) + "-hook",
+				shorthand:  "",
+				usage:      hookUsages[name],
+				persistent: true,
+			},
+		},
+		v: paramValueString{
+			value:        "",
+			defaultValue: "",
+		},
+	}
+	param.addToCommand(cmd)
+	return &param
+}
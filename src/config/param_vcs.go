@@ -38,7 +38,7 @@ func AddVCSParam(cmd *cobra.Command) *StringParam {
 			cobraSettings: cobraSettings{
 				name:       "vcs",
 				shorthand:  "V",
-				usage:      "indicate the VCS (version control system) to be used by TCR: git (default) or p4",
+				usage:      "indicate the VCS (version control system) to be used by TCR: git (default), p4, hg or jj",
 				persistent: true,
 			},
 		},
@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// AddWatchDebounceParam adds watch debounce parameter to the provided command
+func AddWatchDebounceParam(cmd *cobra.Command) *DurationParam {
+	param := DurationParam{
+		s: paramSettings{
+			viperSettings: viperSettings{
+				enabled: true,
+				keyPath: "config.tcr",
+				name:    "watch-debounce",
+			},
+			cobraSettings: cobraSettings{
+				name:       "watch-debounce",
+				shorthand:  "",
+				usage:      "set the quiet period following the last file change before a TCR cycle is triggered",
+				persistent: true,
+			},
+		},
+		v: paramValueDuration{
+			value:        0,
+			defaultValue: 300 * time.Millisecond,
+		},
+	}
+	param.addToCommand(cmd)
+	return &param
+}
@@ -29,8 +29,13 @@ import (
 	"sort"
 
 	"github.com/murex/tcr/helpers"
+	"github.com/murex/tcr/hook"
+	"github.com/murex/tcr/journal"
 	"github.com/murex/tcr/language"
+	"github.com/murex/tcr/mutation"
+	"github.com/murex/tcr/notification"
 	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/retro"
 	"github.com/murex/tcr/settings"
 	"github.com/murex/tcr/toolchain"
 	"github.com/spf13/cobra"
@@ -39,34 +44,94 @@ import (
 
 // TcrConfig wraps all possible TCR configuration parameters
 type TcrConfig struct {
-	BaseDir          *StringParam
-	WorkDir          *StringParam
-	ConfigDir        *StringParam
-	Language         *StringParam
-	Toolchain        *StringParam
-	PollingPeriod    *DurationParam
-	MobTimerDuration *DurationParam
-	GitRemote        *StringParam
-	AutoPush         *BoolParam
-	Variant          *StringParam
-	VCS              *StringParam
-	MessageSuffix    *StringParam
-	Trace            *StringParam
-	PortNumber       *IntParam
+	BaseDir            *StringParam
+	WorkDir            *StringParam
+	ConfigDir          *StringParam
+	Language           *StringParam
+	Toolchain          *StringParam
+	Modules            *StringSliceParam
+	PollingPeriod      *DurationParam
+	WatchDebounce      *DurationParam
+	WatchPollingPeriod *DurationParam
+	MobTimerDuration   *DurationParam
+	GitRemote          *StringParam
+	AutoPush           *BoolParam
+	IsolatedRuns       *BoolParam
+	TestSelection      *BoolParam
+	FullTestRunPeriod  *IntParam
+	Variant            *StringParam
+	LintPolicy         *StringParam
+	AutoFormat         *BoolParam
+	CoverageGate       *BoolParam
+	VCS                *StringParam
+	MessageSuffix      *StringParam
+	Trace              *StringParam
+	PortNumber         *IntParam
+	FromJournal        *BoolParam
+	Format             *StringParam
+	OutputFile         *StringParam
+	Since              *StringParam
+	Until              *StringParam
+	Authors            *StringSliceParam
+	Branches           *StringSliceParam
+	Template           *StringParam
+	MutationCommits    *IntParam
+	MessageTemplate    *StringParam
+	Members            *StringSliceParam
+	Handover           *BoolParam
+	MobHost            *BoolParam
+	MobJoin            *StringParam
+	MobToken           *StringParam
+	MobFingerprint     *StringParam
+	WebAuth            *BoolParam
+	BindAddress        *StringParam
+	TLS                *BoolParam
+	Hooks              map[hook.Name]*StringParam
 }
 
 func (c TcrConfig) reset() {
 	c.Language.reset()
 	c.Toolchain.reset()
+	c.Modules.reset()
 	c.PollingPeriod.reset()
+	c.WatchDebounce.reset()
+	c.WatchPollingPeriod.reset()
 	c.MobTimerDuration.reset()
 	c.AutoPush.reset()
+	c.IsolatedRuns.reset()
+	c.TestSelection.reset()
+	c.FullTestRunPeriod.reset()
 	c.GitRemote.reset()
 	c.Variant.reset()
+	c.LintPolicy.reset()
+	c.AutoFormat.reset()
+	c.CoverageGate.reset()
 	c.VCS.reset()
 	c.MessageSuffix.reset()
 	c.Trace.reset()
 	c.PortNumber.reset()
+	c.FromJournal.reset()
+	c.Format.reset()
+	c.OutputFile.reset()
+	c.Since.reset()
+	c.Until.reset()
+	c.Authors.reset()
+	c.Branches.reset()
+	c.Template.reset()
+	c.MutationCommits.reset()
+	c.MessageTemplate.reset()
+	c.Members.reset()
+	c.Handover.reset()
+	c.MobHost.reset()
+	c.MobJoin.reset()
+	c.MobToken.reset()
+	c.MobFingerprint.reset()
+	c.WebAuth.reset()
+	c.BindAddress.reset()
+	c.TLS.reset()
+	for _, h := range c.Hooks {
+		h.reset()
+	}
 }
 
 // Config is the placeholder for all TCR configuration parameters
@@ -99,6 +164,10 @@ func initConfig(writer io.Writer) {
 	initTCRConfig()
 	toolchain.InitConfig(configDirPath)
 	language.InitConfig(configDirPath)
+	journal.InitConfig(configDirPath)
+	mutation.InitConfig(configDirPath)
+	retro.InitConfig(configDirPath)
+	notification.InitConfig(configDirPath)
 }
 
 func initTCRConfig() {
@@ -182,6 +251,7 @@ func Show() {
 	showTCRConfig()
 	toolchain.ShowConfigs()
 	language.ShowConfigs()
+	notification.ShowConfigs()
 }
 
 func showTCRConfig() {
@@ -200,15 +270,44 @@ func AddParameters(cmd *cobra.Command, defaultDir string) {
 	Config.ConfigDir = AddConfigDirParam(cmd)
 	Config.Language = AddLanguageParam(cmd)
 	Config.Toolchain = AddToolchainParam(cmd)
+	Config.Modules = AddModulesParam(cmd)
 	Config.PollingPeriod = AddPollingPeriodParam(cmd)
+	Config.WatchDebounce = AddWatchDebounceParam(cmd)
+	Config.WatchPollingPeriod = AddWatchPollingPeriodParam(cmd)
 	Config.MobTimerDuration = AddMobTimerDurationParam(cmd)
 	Config.GitRemote = AddGitRemoteParam(cmd)
 	Config.AutoPush = AddAutoPushParam(cmd)
+	Config.IsolatedRuns = AddIsolatedRunsParam(cmd)
+	Config.TestSelection = AddTestSelectionParam(cmd)
+	Config.FullTestRunPeriod = AddFullTestRunPeriodParam(cmd)
 	Config.Variant = AddVariantParam(cmd)
+	Config.LintPolicy = AddLintPolicyParam(cmd)
+	Config.AutoFormat = AddAutoFormatParam(cmd)
+	Config.CoverageGate = AddCoverageGateParam(cmd)
 	Config.VCS = AddVCSParam(cmd)
 	Config.MessageSuffix = AddMessageSuffixParam(cmd)
 	Config.Trace = AddTraceParam(cmd)
 	Config.PortNumber = AddPortNumberParam(cmd)
+	Config.FromJournal = AddFromJournalParam(cmd)
+	Config.Format = AddFormatParam(cmd)
+	Config.OutputFile = AddOutputFileParam(cmd)
+	Config.Since = AddSinceParam(cmd)
+	Config.Until = AddUntilParam(cmd)
+	Config.Authors = AddAuthorParam(cmd)
+	Config.Branches = AddBranchParam(cmd)
+	Config.Template = AddTemplateParam(cmd)
+	Config.MutationCommits = AddMutationCommitsParam(cmd)
+	Config.MessageTemplate = AddMessageTemplateParam(cmd)
+	Config.Members = AddMembersParam(cmd)
+	Config.Handover = AddHandoverParam(cmd)
+	Config.MobHost = AddMobHostParam(cmd)
+	Config.MobJoin = AddMobJoinParam(cmd)
+	Config.MobToken = AddMobTokenParam(cmd)
+	Config.MobFingerprint = AddMobFingerprintParam(cmd)
+	Config.WebAuth = AddWebAuthParam(cmd)
+	Config.BindAddress = AddBindAddressParam(cmd)
+	Config.TLS = AddTLSParam(cmd)
+	Config.Hooks = AddHookParams(cmd)
 }
 
 // UpdateEngineParams updates TCR engine parameters based on configuration values
@@ -219,12 +318,44 @@ func UpdateEngineParams(p *params.Params) {
 	p.MobTurnDuration = Config.MobTimerDuration.GetValue()
 	p.Language = Config.Language.GetValue()
 	p.Toolchain = Config.Toolchain.GetValue()
+	p.Modules = Config.Modules.GetValue()
 	p.PollingPeriod = Config.PollingPeriod.GetValue()
+	p.WatchDebounce = Config.WatchDebounce.GetValue()
+	p.WatchPollingPeriod = Config.WatchPollingPeriod.GetValue()
 	p.AutoPush = Config.AutoPush.GetValue()
+	p.IsolatedRuns = Config.IsolatedRuns.GetValue()
+	p.TestSelection = Config.TestSelection.GetValue()
+	p.FullTestRunPeriod = Config.FullTestRunPeriod.GetValue()
 	p.GitRemote = Config.GitRemote.GetValue()
 	p.Variant = Config.Variant.GetValue()
+	p.LintPolicy = Config.LintPolicy.GetValue()
+	p.AutoFormat = Config.AutoFormat.GetValue()
+	p.CoverageGate = Config.CoverageGate.GetValue()
 	p.VCS = Config.VCS.GetValue()
 	p.MessageSuffix = Config.MessageSuffix.GetValue()
 	p.Trace = Config.Trace.GetValue()
 	p.PortNumber = Config.PortNumber.GetValue()
+	p.FromJournal = Config.FromJournal.GetValue()
+	p.Format = Config.Format.GetValue()
+	p.OutputFile = Config.OutputFile.GetValue()
+	p.Since = Config.Since.GetValue()
+	p.Until = Config.Until.GetValue()
+	p.Authors = Config.Authors.GetValue()
+	p.Branches = Config.Branches.GetValue()
+	p.Template = Config.Template.GetValue()
+	p.MutationCommits = Config.MutationCommits.GetValue()
+	p.MessageTemplate = Config.MessageTemplate.GetValue()
+	p.Members = Config.Members.GetValue()
+	p.Handover = Config.Handover.GetValue()
+	p.MobHost = Config.MobHost.GetValue()
+	p.MobJoin = Config.MobJoin.GetValue()
+	p.MobToken = Config.MobToken.GetValue()
+	p.MobFingerprint = Config.MobFingerprint.GetValue()
+	p.WebAuth = Config.WebAuth.GetValue()
+	p.BindAddress = Config.BindAddress.GetValue()
+	p.TLS = Config.TLS.GetValue()
+	p.Hooks = make(map[string]string)
+	for name, h := range Config.Hooks {
+		p.Hooks[string(name)] = h.GetValue()
+	}
 }
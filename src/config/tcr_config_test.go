@@ -241,12 +241,34 @@ func Test_show_tcr_config_with_default_values(t *testing.T) {
 		"TCR configuration:",
 		fmt.Sprintf("%v.git.auto-push: %v", prefix, false),
 		fmt.Sprintf("%v.git.polling-period: %v", prefix, 2*time.Second),
+		fmt.Sprintf("%v.hooks.on-role-change: %v", prefix, ""),
+		fmt.Sprintf("%v.hooks.post-commit: %v", prefix, ""),
+		fmt.Sprintf("%v.hooks.post-revert: %v", prefix, ""),
+		fmt.Sprintf("%v.hooks.post-test: %v", prefix, ""),
+		fmt.Sprintf("%v.hooks.pre-build: %v", prefix, ""),
+		fmt.Sprintf("%v.hooks.pre-commit: %v", prefix, ""),
 		fmt.Sprintf("%v.mob-timer.duration: %v", prefix, 5*time.Minute),
+		fmt.Sprintf("%v.mob.handover: %v", prefix, false),
+		fmt.Sprintf("%v.mob.host: %v", prefix, false),
+		fmt.Sprintf("%v.mob.members: %v", prefix, "[]"),
+		fmt.Sprintf("%v.tcr.auto-format: %v", prefix, false),
+		fmt.Sprintf("%v.tcr.coverage-gate: %v", prefix, false),
+		fmt.Sprintf("%v.tcr.full-test-run-period: %v", prefix, 10),
+		fmt.Sprintf("%v.tcr.isolated-runs: %v", prefix, false),
 		fmt.Sprintf("%v.tcr.language: %v", prefix, ""),
+		fmt.Sprintf("%v.tcr.lint-policy: %v", prefix, variant.LintOff),
+		fmt.Sprintf("%v.tcr.message-template: %v", prefix, "default"),
+		fmt.Sprintf("%v.tcr.modules: %v", prefix, "[]"),
+		fmt.Sprintf("%v.tcr.test-selection: %v", prefix, false),
 		fmt.Sprintf("%v.tcr.toolchain: %v", prefix, ""),
 		fmt.Sprintf("%v.tcr.trace: %v", prefix, "none"),
 		fmt.Sprintf("%v.tcr.variant: %v", prefix, variant.Relaxed),
+		fmt.Sprintf("%v.tcr.watch-debounce: %v", prefix, 300*time.Millisecond),
+		fmt.Sprintf("%v.tcr.watch-polling-period: %v", prefix, 0*time.Second),
 		fmt.Sprintf("%v.vcs.name: %v", prefix, "git"),
+		fmt.Sprintf("%v.web.auth: %v", prefix, false),
+		fmt.Sprintf("%v.web.bind-address: %v", prefix, ""),
+		fmt.Sprintf("%v.web.tls: %v", prefix, false),
 	}
 	helpers.AssertSimpleTrace(t, expected,
 		func() {
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package config
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type paramValueStringSlice struct {
+	value        []string
+	defaultValue []string
+}
+
+func (p *paramValueStringSlice) reset() {
+	p.value = p.defaultValue
+}
+
+// StringSliceParam is a parameter of type []string that can be handled by both viper and cobra frameworks.
+// The corresponding command line flag can be repeated, or take comma-separated values
+type StringSliceParam struct {
+	s paramSettings
+	v paramValueStringSlice
+}
+
+func (param *StringSliceParam) addToCommand(cmd *cobra.Command) {
+	flags := param.s.getCmdFlags(cmd)
+	flags.StringSliceVarP(&param.v.value,
+		param.s.cobraSettings.name,
+		param.s.shorthand,
+		nil,
+		param.s.usage)
+
+	flag := flags.Lookup(param.s.cobraSettings.name)
+	param.s.bindToViper(flag)
+}
+
+func (param *StringSliceParam) useDefaultValueIfNotSet() {
+	if len(param.v.value) == 0 {
+		if param.s.enabled {
+			if cfgValue := viper.GetStringSlice(param.s.getViperKey()); len(cfgValue) > 0 {
+				param.v.value = cfgValue
+			} else {
+				param.reset()
+			}
+		} else {
+			param.reset()
+		}
+	}
+}
+
+// GetValue returns the current value for this parameter
+func (param *StringSliceParam) GetValue() []string {
+	param.useDefaultValueIfNotSet()
+	return param.v.value
+}
+
+func (param *StringSliceParam) reset() {
+	param.v.reset()
+	if param.s.enabled {
+		viper.Set(param.s.getViperKey(), param.v.value)
+	}
+}
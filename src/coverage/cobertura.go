@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package coverage
+
+import (
+	"encoding/xml"
+)
+
+type coberturaReport struct {
+	LinesCovered *int `xml:"lines-covered,attr"`
+	LinesValid   *int `xml:"lines-valid,attr"`
+	Packages     []struct {
+		Classes []struct {
+			Lines []struct {
+				Number int `xml:"number,attr"`
+				Hits   int `xml:"hits,attr"`
+			} `xml:"lines>line"`
+		} `xml:"classes>class"`
+	} `xml:"packages>package"`
+}
+
+// parseCobertura extracts line coverage from a Cobertura XML report. Summary
+// attributes of the root element are used when present, otherwise lines are counted
+func parseCobertura(data []byte) (Stats, error) {
+	var report coberturaReport
+	if err := xml.Unmarshal(data, &report); err != nil {
+		return Stats{}, err
+	}
+	if report.LinesCovered != nil && report.LinesValid != nil {
+		return Stats{Covered: *report.LinesCovered, Total: *report.LinesValid}, nil
+	}
+	var stats Stats
+	for _, pkg := range report.Packages {
+		for _, class := range pkg.Classes {
+			for _, line := range class.Lines {
+				stats.Total++
+				if line.Hits > 0 {
+					stats.Covered++
+				}
+			}
+		}
+	}
+	return stats, nil
+}
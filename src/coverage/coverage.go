@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package coverage extracts line coverage from the coverage reports generated by test tools
+package coverage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Format is the format of a coverage report
+type Format string
+
+// Supported coverage report formats
+const (
+	Cobertura Format = "cobertura"
+	JaCoCo    Format = "jacoco"
+	GoCover   Format = "go"
+	LCOV      Format = "lcov"
+)
+
+var recognized = []Format{Cobertura, JaCoCo, GoCover, LCOV}
+
+// SelectFormat returns the coverage report format with the provided name.
+// The name is case-insensitive
+func SelectFormat(name string) (Format, error) {
+	for _, format := range recognized {
+		if strings.EqualFold(name, string(format)) {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("coverage report format not supported: \"%s\"", name)
+}
+
+// Report describes where a toolchain's test command writes its coverage report, and in which format
+type Report struct {
+	Format Format
+	Path   string
+}
+
+// IsSet indicates if the report is defined
+func (r Report) IsSet() bool {
+	return r.Path != ""
+}
+
+// Stats contains the number of covered lines out of the total number of lines
+// found in a coverage report. Go coverage profiles count statements rather than lines
+type Stats struct {
+	Covered int
+	Total   int
+}
+
+// Ratio returns the ratio of covered lines (between 0 and 1).
+// Returns 1 when there is no line to cover
+func (s Stats) Ratio() float64 {
+	if s.Total == 0 {
+		return 1
+	}
+	return float64(s.Covered) / float64(s.Total)
+}
+
+// Percent returns the coverage ratio as a percentage
+func (s Stats) Percent() float64 {
+	return 100 * s.Ratio()
+}
+
+// ErrEmptyReport is returned when a coverage report contains no coverage data
+var ErrEmptyReport = errors.New("coverage report contains no coverage data")
+
+// ParseFile extracts line coverage stats from the coverage report file
+// located at the provided path
+func ParseFile(path string, format Format) (Stats, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path comes from toolchain configuration
+	if err != nil {
+		return Stats{}, err
+	}
+	return Parse(data, format)
+}
+
+// Parse extracts line coverage stats from the provided coverage report data
+func Parse(data []byte, format Format) (stats Stats, err error) {
+	switch format {
+	case Cobertura:
+		stats, err = parseCobertura(data)
+	case JaCoCo:
+		stats, err = parseJaCoCo(data)
+	case GoCover:
+		stats, err = parseGoCover(data)
+	case LCOV:
+		stats, err = parseLCOV(data)
+	default:
+		return Stats{}, fmt.Errorf("coverage report format not supported: \"%s\"", format)
+	}
+	if err == nil && stats.Total == 0 {
+		err = ErrEmptyReport
+	}
+	return stats, err
+}
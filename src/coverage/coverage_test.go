@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package coverage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_select_format(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected Format
+		wantErr  bool
+	}{
+		{"cobertura", Cobertura, false},
+		{"JaCoCo", JaCoCo, false},
+		{"go", GoCover, false},
+		{"lcov", LCOV, false},
+		{"clover", "", true},
+		{"", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			format, err := SelectFormat(test.name)
+			assert.Equal(t, test.expected, format)
+			assert.Equal(t, test.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_report_is_set(t *testing.T) {
+	assert.False(t, Report{}.IsSet())
+	assert.True(t, Report{Format: GoCover, Path: "coverage.out"}.IsSet())
+}
+
+func Test_stats_ratio_and_percent(t *testing.T) {
+	assert.InDelta(t, 0.75, Stats{Covered: 3, Total: 4}.Ratio(), 1e-9)
+	assert.InDelta(t, 75.0, Stats{Covered: 3, Total: 4}.Percent(), 1e-9)
+	assert.InDelta(t, 1.0, Stats{}.Ratio(), 1e-9)
+}
+
+func Test_parse_cobertura_report(t *testing.T) {
+	tests := []struct {
+		desc     string
+		data     string
+		expected Stats
+	}{
+		{
+			"with summary attributes",
+			`<?xml version="1.0" ?>
+<coverage line-rate="0.8" lines-covered="8" lines-valid="10" version="7.2"></coverage>`,
+			Stats{Covered: 8, Total: 10},
+		},
+		{
+			"without summary attributes",
+			`<coverage line-rate="0.5">
+  <packages><package name="pkg"><classes>
+    <class name="A" filename="a.py"><lines>
+      <line number="1" hits="1"/><line number="2" hits="0"/>
+    </lines></class>
+    <class name="B" filename="b.py"><lines>
+      <line number="1" hits="3"/><line number="2" hits="0"/>
+    </lines></class>
+  </classes></package></packages>
+</coverage>`,
+			Stats{Covered: 2, Total: 4},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			stats, err := Parse([]byte(test.data), Cobertura)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, stats)
+		})
+	}
+}
+
+func Test_parse_jacoco_report(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<!DOCTYPE report PUBLIC "-//JACOCO//DTD Report 1.1//EN" "report.dtd">
+<report name="demo">
+  <package name="demo">
+    <class name="demo/A"><counter type="LINE" missed="1" covered="1"/></class>
+    <counter type="LINE" missed="1" covered="1"/>
+  </package>
+  <counter type="INSTRUCTION" missed="10" covered="30"/>
+  <counter type="LINE" missed="5" covered="15"/>
+</report>`
+	stats, err := Parse([]byte(data), JaCoCo)
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{Covered: 15, Total: 20}, stats)
+}
+
+func Test_parse_go_coverage_profile(t *testing.T) {
+	data := `mode: set
+example.com/x/a.go:3.24,5.2 2 1
+example.com/x/a.go:7.24,9.2 3 0
+example.com/x/b.go:3.24,5.2 1 0
+example.com/x/b.go:3.24,5.2 1 1
+`
+	stats, err := Parse([]byte(data), GoCover)
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{Covered: 3, Total: 6}, stats)
+}
+
+func Test_parse_invalid_go_coverage_profile(t *testing.T) {
+	_, err := Parse([]byte("mode: set\nexample.com/x/a.go:3.24,5.2 two 1\n"), GoCover)
+	assert.Error(t, err)
+}
+
+func Test_parse_lcov_report(t *testing.T) {
+	data := `TN:
+SF:src/a.js
+DA:1,1
+DA:2,0
+LF:2
+LH:1
+end_of_record
+SF:src/b.js
+LF:8
+LH:6
+end_of_record
+`
+	stats, err := Parse([]byte(data), LCOV)
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{Covered: 7, Total: 10}, stats)
+}
+
+func Test_parse_empty_report(t *testing.T) {
+	for _, format := range recognized {
+		t.Run(string(format), func(t *testing.T) {
+			data := ""
+			if format == Cobertura || format == JaCoCo {
+				data = "<report></report>"
+			}
+			_, err := Parse([]byte(data), format)
+			assert.ErrorIs(t, err, ErrEmptyReport)
+		})
+	}
+}
+
+func Test_parse_unsupported_format(t *testing.T) {
+	_, err := Parse([]byte("data"), "clover")
+	assert.Error(t, err)
+}
+
+func Test_parse_file(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "coverage.out")
+	assert.NoError(t, os.WriteFile(path, []byte("mode: set\na.go:1.1,2.2 4 1\n"), 0600))
+
+	stats, err := ParseFile(path, GoCover)
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{Covered: 4, Total: 4}, stats)
+
+	_, err = ParseFile(filepath.Join(t.TempDir(), "missing.out"), GoCover)
+	assert.Error(t, err)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package coverage
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type goCoverBlock struct {
+	statements int
+	count      int
+}
+
+// parseGoCover extracts statement coverage from a Go coverage profile. Blocks
+// appearing several times (as with -coverpkg) are counted once
+func parseGoCover(data []byte) (Stats, error) {
+	blocks := make(map[string]goCoverBlock)
+	var order []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+		// Each line has the form "file.go:startLine.startCol,endLine.endCol statements count"
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return Stats{}, fmt.Errorf("invalid go coverage profile line %d: %s", lineNumber, line)
+		}
+		statements, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return Stats{}, fmt.Errorf("invalid go coverage profile line %d: %w", lineNumber, err)
+		}
+		count, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return Stats{}, fmt.Errorf("invalid go coverage profile line %d: %w", lineNumber, err)
+		}
+		block, found := blocks[fields[0]]
+		if !found {
+			order = append(order, fields[0])
+		}
+		blocks[fields[0]] = goCoverBlock{statements: statements, count: block.count + count}
+	}
+	if err := scanner.Err(); err != nil {
+		return Stats{}, err
+	}
+	var stats Stats
+	for _, key := range order {
+		stats.Total += blocks[key].statements
+		if blocks[key].count > 0 {
+			stats.Covered += blocks[key].statements
+		}
+	}
+	return stats, nil
+}
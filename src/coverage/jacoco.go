@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package coverage
+
+import (
+	"bytes"
+	"encoding/xml"
+)
+
+type jacocoCounter struct {
+	Type    string `xml:"type,attr"`
+	Missed  int    `xml:"missed,attr"`
+	Covered int    `xml:"covered,attr"`
+}
+
+type jacocoReport struct {
+	Counters []jacocoCounter `xml:"counter"`
+}
+
+// parseJaCoCo extracts line coverage from the report-level LINE counter of a JaCoCo XML report
+func parseJaCoCo(data []byte) (Stats, error) {
+	var report jacocoReport
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	// JaCoCo reports refer to a DTD that we don't need to resolve
+	decoder.Strict = false
+	if err := decoder.Decode(&report); err != nil {
+		return Stats{}, err
+	}
+	for _, counter := range report.Counters {
+		if counter.Type == "LINE" {
+			return Stats{Covered: counter.Covered, Total: counter.Covered + counter.Missed}, nil
+		}
+	}
+	return Stats{}, nil
+}
@@ -32,20 +32,27 @@ import (
 
 const hostname = "127.0.0.1"
 
-// OpenBrowser opens a browser on localhost on the provided port number
-func OpenBrowser(portNumber int) {
-	u := browserURL(portNumber)
+// OpenBrowser opens a browser on localhost on the provided port number.
+// When set, the session token is passed to the web UI through the URL
+func OpenBrowser(portNumber int, secure bool, token string) {
+	u := browserURL(portNumber, secure, token)
 	err := browser.OpenURL(u)
 	if err != nil {
 		report.PostWarning("Could not open ", u, ": ", err.Error())
 	}
 }
 
-func browserURL(portNumber int) string {
+func browserURL(portNumber int, secure bool, token string) string {
 	u := url.URL{
 		Scheme: "http",
 		Host:   fmt.Sprintf("%s:%d", hostname, portNumber),
 		Path:   "/",
 	}
+	if secure {
+		u.Scheme = "https"
+	}
+	if token != "" {
+		u.RawQuery = url.Values{"token": {token}}.Encode()
+	}
 	return u.String()
 }
@@ -29,6 +29,15 @@ import (
 )
 
 func Test_build_browser_url(t *testing.T) {
-	assert.Equal(t, "http://127.0.0.1:8483/", browserURL(8483))
-	assert.Equal(t, "http://127.0.0.1:80/", browserURL(80))
+	assert.Equal(t, "http://127.0.0.1:8483/", browserURL(8483, false, ""))
+	assert.Equal(t, "http://127.0.0.1:80/", browserURL(80, false, ""))
+}
+
+func Test_build_secure_browser_url(t *testing.T) {
+	assert.Equal(t, "https://127.0.0.1:8483/", browserURL(8483, true, ""))
+}
+
+func Test_build_browser_url_with_token(t *testing.T) {
+	assert.Equal(t, "http://127.0.0.1:8483/?token=s3cr3t", browserURL(8483, false, "s3cr3t"))
+	assert.Equal(t, "https://127.0.0.1:8483/?token=s3cr3t", browserURL(8483, true, "s3cr3t"))
 }
@@ -22,19 +22,30 @@ SOFTWARE.
 
 package engine
 
-import "fmt"
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/murex/tcr/events"
+)
 
 // CommitMessage is a struct that holds information of the commit message
 type CommitMessage struct {
 	Emoji       rune
 	Tag         string
 	Description string
+	// Kind identifies the commit message in TCR status trailer
+	Kind string
 }
 
 var (
-	messagePassed   = CommitMessage{Emoji: '✅', Tag: "[TCR - PASSED]", Description: "tests passing"}
-	messageFailed   = CommitMessage{Emoji: '❌', Tag: "[TCR - FAILED]", Description: "tests failing"}
-	messageReverted = CommitMessage{Emoji: '⏪', Tag: "[TCR - REVERTED]", Description: "revert changes"}
+	messagePassed   = CommitMessage{Emoji: '✅', Tag: "[TCR - PASSED]", Description: "tests passing", Kind: "passed"}
+	messageFailed   = CommitMessage{Emoji: '❌', Tag: "[TCR - FAILED]", Description: "tests failing", Kind: "failed"}
+	messageReverted = CommitMessage{Emoji: '⏪', Tag: "[TCR - REVERTED]", Description: "revert changes", Kind: "reverted"}
+	messageShelved  = CommitMessage{Emoji: '📦', Tag: "[TCR - SHELVED]", Description: "tests failing", Kind: "shelved"}
+	messageRed      = CommitMessage{Emoji: '🟥', Tag: "[TCR - RED]", Description: "new failing test", Kind: "red"}
 )
 
 func (cm CommitMessage) toString(withEmoji bool) string {
@@ -44,3 +55,105 @@ func (cm CommitMessage) toString(withEmoji bool) string {
 	}
 	return textOnly
 }
+
+// Names of the built-in commit message templates
+const (
+	DefaultMessageTemplate      = "default"
+	ConventionalMessageTemplate = "conventional"
+)
+
+const conventionalMessageTemplate = "{{.Type}}(tcr): {{if .Intent}}{{.Intent}}{{else}}{{.Description}}{{end}}"
+
+// statusTrailerKey is the key of the trailer added at the end of commit messages
+// built from a template, allowing to recognize TCR commits regardless of their header
+const statusTrailerKey = "TCR-Status: "
+
+// CommitMessageData contains the information available to commit message templates
+type CommitMessageData struct {
+	Kind        string
+	Emoji       string
+	Tag         string
+	Description string
+	// Type is the Conventional Commits type matching the commit
+	Type   string
+	Event  events.TCREvent
+	Role   string
+	Branch string
+	Intent string
+}
+
+// UsesMessageTemplate indicates if the provided commit message template value
+// requires commit message headers to be built from a template
+func UsesMessageTemplate(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", DefaultMessageTemplate:
+		return false
+	default:
+		return true
+	}
+}
+
+// parseMessageTemplate returns the commit message template corresponding to the provided
+// value, which can be either the name of a built-in template, or a Go template text.
+// Returns nil when the default commit message should be used
+func parseMessageTemplate(value string) (*template.Template, error) {
+	if !UsesMessageTemplate(value) {
+		return nil, nil
+	}
+	if strings.EqualFold(strings.TrimSpace(value), ConventionalMessageTemplate) {
+		value = conventionalMessageTemplate
+	}
+	t, err := template.New("commit-message").Option("missingkey=error").Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid commit message template: %w", err)
+	}
+	return t, nil
+}
+
+// conventionalType returns the Conventional Commits type matching the provided
+// commit message and event
+func conventionalType(cm CommitMessage, event events.TCREvent) string {
+	switch cm.Kind {
+	case messagePassed.Kind:
+		if event.Phase == events.PhaseRefactor {
+			return "refactor"
+		}
+		return "feat"
+	case messageFailed.Kind, messageRed.Kind:
+		return "test"
+	case messageReverted.Kind:
+		return "revert"
+	default:
+		return "chore"
+	}
+}
+
+// applyMessageTemplate builds a commit message header using the provided template.
+// Line breaks are replaced with spaces so that the header remains on a single line
+func applyMessageTemplate(t *template.Template, data CommitMessageData) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("cannot apply commit message template: %w", err)
+	}
+	return strings.Join(strings.Fields(buf.String()), " "), nil
+}
+
+// statusTrailer returns the TCR status trailer for the provided commit message
+func statusTrailer(cm CommitMessage) string {
+	return statusTrailerKey + cm.Kind
+}
+
+// matches indicates if the provided message contains either the tag of the
+// commit message in its first line, or its TCR status trailer on a dedicated line
+func (cm CommitMessage) matches(message string) bool {
+	header, _, _ := strings.Cut(message, "\n")
+	if strings.Contains(header, cm.Tag) {
+		return true
+	}
+	for line := range strings.SplitSeq(message, "\n") {
+		if strings.TrimSpace(line) == statusTrailer(cm) {
+			return true
+		}
+	}
+	return false
+}
@@ -25,6 +25,7 @@ package engine
 import (
 	"testing"
 
+	"github.com/murex/tcr/events"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -79,3 +80,111 @@ func Test_building_commit_messages(t *testing.T) {
 		})
 	}
 }
+
+func Test_uses_message_template(t *testing.T) {
+	assert.False(t, UsesMessageTemplate(""))
+	assert.False(t, UsesMessageTemplate("default"))
+	assert.False(t, UsesMessageTemplate("Default"))
+	assert.True(t, UsesMessageTemplate("conventional"))
+	assert.True(t, UsesMessageTemplate("{{.Tag}}"))
+}
+
+func Test_parse_message_template(t *testing.T) {
+	testFlags := []struct {
+		desc          string
+		value         string
+		expectNil     bool
+		expectedError bool
+	}{
+		{"default template", "default", true, false},
+		{"empty template", "", true, false},
+		{"conventional preset", "Conventional", false, false},
+		{"custom template", "{{.Type}}: {{.Intent}}", false, false},
+		{"invalid template", "{{.Type", true, true},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tmpl, err := parseMessageTemplate(tt.value)
+			assert.Equal(t, tt.expectNil, tmpl == nil)
+			assert.Equal(t, tt.expectedError, err != nil)
+		})
+	}
+}
+
+func Test_apply_message_template(t *testing.T) {
+	testFlags := []struct {
+		desc     string
+		template string
+		data     CommitMessageData
+		expected string
+	}{
+		{
+			"conventional preset without intent", "conventional",
+			CommitMessageData{Type: "feat", Description: "tests passing"},
+			"feat(tcr): tests passing",
+		},
+		{
+			"conventional preset with intent", "conventional",
+			CommitMessageData{Type: "feat", Description: "tests passing", Intent: "add login"},
+			"feat(tcr): add login",
+		},
+		{
+			"custom template with event and role", "{{.Emoji}} [{{.Role}}@{{.Branch}}] +{{.Event.Changes.Src}}",
+			CommitMessageData{Emoji: "✅", Role: "driver", Branch: "main",
+				Event: events.TCREvent{Changes: events.ChangedLines{Src: 3}}},
+			"✅ [driver@main] +3",
+		},
+		{
+			"line breaks are removed", "{{.Tag}}\n{{.Description}}",
+			CommitMessageData{Tag: "[TCR - PASSED]", Description: "tests passing"},
+			"[TCR - PASSED] tests passing",
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tmpl, err := parseMessageTemplate(tt.template)
+			assert.NoError(t, err)
+			result, err := applyMessageTemplate(tmpl, tt.data)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_conventional_commit_types(t *testing.T) {
+	testFlags := []struct {
+		message  CommitMessage
+		phase    events.Phase
+		expected string
+	}{
+		{messagePassed, events.PhaseNone, "feat"},
+		{messagePassed, events.PhaseGreen, "feat"},
+		{messagePassed, events.PhaseRefactor, "refactor"},
+		{messageFailed, events.PhaseNone, "test"},
+		{messageRed, events.PhaseRed, "test"},
+		{messageReverted, events.PhaseNone, "revert"},
+		{messageShelved, events.PhaseNone, "chore"},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.message.Kind+" "+string(tt.phase), func(t *testing.T) {
+			assert.Equal(t, tt.expected, conventionalType(tt.message, events.TCREvent{Phase: tt.phase}))
+		})
+	}
+}
+
+func Test_commit_message_matches_tag_or_status_trailer(t *testing.T) {
+	assert.True(t, messagePassed.matches(passedCommitMessage))
+	assert.True(t, messagePassed.matches("feat(tcr): add login\n\n"+statusTrailer(messagePassed)))
+	assert.False(t, messagePassed.matches("feat(tcr): add login\n\n"+statusTrailer(messageFailed)))
+	assert.False(t, messagePassed.matches("feat: add login"))
+	assert.False(t, messagePassed.matches("fix: parsing\n\nNo longer crashes on "+messagePassed.Tag+" headers"))
+	assert.False(t, messagePassed.matches("fix: parsing\n\nSee "+statusTrailer(messagePassed)+" trailer"))
+}
+
+func Test_non_tcr_commit_mentioning_tcr_tags_is_not_a_tcr_commit(t *testing.T) {
+	message := "docs: explain commit tags\n\n" +
+		"TCR commits start with " + messagePassed.Tag + " or " + messageFailed.Tag + "\n" +
+		"and may contain a trailer such as " + statusTrailer(messagePassed)
+	assert.False(t, isTCRCommitMessage(message))
+	assert.Equal(t, events.StatusUnknown, parseCommitMessage(message).Status)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/status"
+)
+
+// coverageTolerance prevents rounding errors from being reported as coverage drops
+const coverageTolerance = 1e-6
+
+// setCoverageGate turns on the coverage gate when requested. The gate has no
+// effect with toolchains providing no coverage report. The reference coverage
+// is retrieved from the last TCR commit
+func (tcr *TCREngine) setCoverageGate(flag bool) {
+	tcr.coverageGate = flag
+	tcr.coverageBaseline = nil
+	if flag && !tcr.supportedWithModules("Coverage gate") {
+		tcr.coverageGate = false
+		return
+	}
+	if flag && !tcr.toolchain.GetCoverageReport().IsSet() {
+		report.PostWarning(tcr.toolchain.GetName(), " toolchain has no coverage report: coverage gate is ignored")
+		tcr.coverageGate = false
+	}
+	if tcr.coverageGate {
+		tcr.seedCoverageBaseline()
+	}
+}
+
+// seedCoverageBaseline sets the coverage recorded in the last TCR commit as the
+// reference coverage, so that the coverage gate applies from the session's first cycle
+func (tcr *TCREngine) seedCoverageBaseline() {
+	if event, found := tcr.lastTCREvent(); found {
+		tcr.updateCoverageBaseline(event)
+	}
+}
+
+// applyCoverageGate turns a passing event into a failing one when its line coverage
+// is lower than the one of the last commit. When no commit recorded any coverage,
+// the first cycle with a coverage report sets the reference coverage
+func (tcr *TCREngine) applyCoverageGate(event *events.TCREvent) {
+	if !tcr.coverageGate || event.Status != events.StatusPass || event.Coverage == nil || tcr.coverageBaseline == nil {
+		return
+	}
+	if event.Coverage.Percent() < tcr.coverageBaseline.Percent()-coverageTolerance {
+		status.RecordState(status.TestFailed)
+		report.PostErrorWithEmphasis(fmt.Sprintf("Line coverage dropped from %.1f%% to %.1f%%",
+			tcr.coverageBaseline.Percent(), event.Coverage.Percent()))
+		event.Status = events.StatusFail
+	}
+}
+
+// updateCoverageBaseline sets the coverage of the committed event as the reference coverage
+func (tcr *TCREngine) updateCoverageBaseline(event events.TCREvent) {
+	if event.Coverage != nil {
+		tcr.coverageBaseline = event.Coverage
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/coverage"
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/journal"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/vcs"
+	"github.com/stretchr/testify/assert"
+)
+
+// initTCREngineWithCoverage initializes TCR engine with a fake toolchain
+// returning line coverage with its test results
+func initTCREngineWithCoverage(t *testing.T, isolatedRuns bool, gate bool) (*TCREngine, *toolchain.FakeToolchain) {
+	t.Helper()
+	initJournalForTest(t)
+	tcr, _ := initTCREngineWithFakes(params.AParamSet(params.WithIsolatedRuns(isolatedRuns)), nil, nil, nil)
+	tchn := toolchain.NewFakeToolchain(nil, toolchain.TestStats{})
+	tcr.toolchain = tchn
+	tcr.coverageGate = gate
+	return tcr, tchn
+}
+
+func Test_tcr_cycle_with_coverage_gate(t *testing.T) {
+	testFlags := []struct {
+		desc            string
+		gate            bool
+		second          *coverage.Stats
+		expectedEntries []journal.EntryType
+		expectedBase    *events.LineCoverage
+	}{
+		{
+			"coverage increasing",
+			true, &coverage.Stats{Covered: 9, Total: 10},
+			[]journal.EntryType{journal.Build, journal.Test, journal.Commit, journal.Build, journal.Test, journal.Commit},
+			events.NewLineCoverage(9, 10),
+		},
+		{
+			"coverage unchanged",
+			true, &coverage.Stats{Covered: 16, Total: 20},
+			[]journal.EntryType{journal.Build, journal.Test, journal.Commit, journal.Build, journal.Test, journal.Commit},
+			events.NewLineCoverage(16, 20),
+		},
+		{
+			"coverage dropping",
+			true, &coverage.Stats{Covered: 7, Total: 10},
+			[]journal.EntryType{journal.Build, journal.Test, journal.Commit, journal.Build, journal.Test, journal.Revert},
+			events.NewLineCoverage(8, 10),
+		},
+		{
+			"coverage dropping with gate turned off",
+			false, &coverage.Stats{Covered: 7, Total: 10},
+			[]journal.EntryType{journal.Build, journal.Test, journal.Commit, journal.Build, journal.Test, journal.Commit},
+			events.NewLineCoverage(7, 10),
+		},
+		{
+			"coverage report missing",
+			true, nil,
+			[]journal.EntryType{journal.Build, journal.Test, journal.Commit, journal.Build, journal.Test, journal.Commit},
+			events.NewLineCoverage(8, 10),
+		},
+	}
+
+	for _, isolatedRuns := range []bool{false, true} {
+		for _, tt := range testFlags {
+			desc := tt.desc
+			if isolatedRuns {
+				desc += " in isolated runs"
+			}
+			t.Run(desc, func(t *testing.T) {
+				tcr, tchn := initTCREngineWithCoverage(t, isolatedRuns, tt.gate)
+				tchn.WithCoverage(&coverage.Stats{Covered: 8, Total: 10})
+				tcr.RunTCRCycle()
+				tchn.WithCoverage(tt.second)
+				tcr.RunTCRCycle()
+				assert.Equal(t, tt.expectedEntries, journalEntryTypes(t, tcr))
+				assert.Equal(t, tt.expectedBase, tcr.coverageBaseline)
+			})
+		}
+	}
+}
+
+func Test_coverage_gate_marks_event_as_failed_when_coverage_drops(t *testing.T) {
+	tcr, _ := initTCREngineWithCoverage(t, false, true)
+	tcr.coverageBaseline = events.NewLineCoverage(8, 10)
+	event := events.ATcrEvent(events.WithCommandStatus(events.StatusPass), events.WithLineCoverage(7, 10))
+	tcr.applyCoverageGate(event)
+	assert.Equal(t, events.StatusFail, event.Status)
+}
+
+func Test_coverage_gate_is_ignored_when_toolchain_has_no_coverage_report(t *testing.T) {
+	tcr, _ := initTCREngineWithFakes(params.AParamSet(params.WithCoverageGate(true)), nil, nil, nil)
+	assert.False(t, tcr.coverageGate)
+}
+
+func Test_coverage_baseline_is_seeded_from_last_tcr_commit(t *testing.T) {
+	now := time.Now().UTC()
+	commitMessage := func(event *events.TCREvent) string {
+		return messagePassed.toString(true) + "\n\n" + event.ToYAML()
+	}
+	testFlags := []struct {
+		desc     string
+		logs     vcs.LogItems
+		expected *events.LineCoverage
+	}{
+		{"no previous commit", nil, nil},
+		{
+			"last commit with coverage",
+			vcs.LogItems{
+				vcs.NewLogItem("1111", now.Add(-time.Minute), commitMessage(events.ATcrEvent(events.WithLineCoverage(5, 10)))),
+				vcs.NewLogItem("2222", now, commitMessage(events.ATcrEvent(events.WithLineCoverage(8, 10)))),
+				vcs.NewLogItem("3333", now.Add(time.Minute), "other commit message"),
+			},
+			events.NewLineCoverage(8, 10),
+		},
+		{
+			"last commit without coverage",
+			vcs.LogItems{vcs.NewLogItem("1111", now, commitMessage(events.ATcrEvent()))},
+			nil,
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, _ := initTCREngineWithFakes(nil, nil, nil, tt.logs)
+			tcr.seedCoverageBaseline()
+			assert.Equal(t, tt.expected, tcr.coverageBaseline)
+		})
+	}
+}
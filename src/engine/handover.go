@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"errors"
+
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/status"
+	"github.com/murex/tcr/vcs"
+)
+
+// handoverMessage is the message attached to the uncommitted changes handed over to the next driver
+const handoverMessage = "TCR - handover"
+
+// setHandover turns on handover of uncommitted changes between mob workstations
+// when requested and supported by both the VCS and the run mode in use
+func (tcr *TCREngine) setHandover(flag bool) {
+	tcr.handover = false
+	if !flag {
+		return
+	}
+	if !tcr.mode.IsMultiRole() {
+		report.PostInfo("Handover is not used in " + tcr.mode.Name() + " mode")
+		return
+	}
+	if !tcr.vcs.SupportsHandover() {
+		report.PostWarning("Handover is not supported with ", tcr.vcs.Name())
+		return
+	}
+	tcr.handover = true
+	report.PostInfo("Uncommitted changes will be handed over at the end of each driver turn")
+}
+
+// pushHandover hands over uncommitted changes to the next driver
+func (tcr *TCREngine) pushHandover() {
+	if !tcr.handover {
+		return
+	}
+	message := handoverMessage
+	if driver, ok := tcr.GetRoster().Driver(); ok {
+		message += " from " + driver.Name
+	}
+	pushed, err := tcr.vcs.PushHandover(message)
+	tcr.handleError(err, false, status.VCSError)
+	if pushed {
+		report.PostSuccessWithEmphasis("Uncommitted changes handed over to the next driver")
+	}
+}
+
+// pullHandover restores uncommitted changes handed over by the previous driver
+func (tcr *TCREngine) pullHandover() {
+	if !tcr.handover {
+		return
+	}
+	pulled, err := tcr.vcs.PullHandover()
+	var conflictError *vcs.HandoverConflictError
+	if errors.As(err, &conflictError) {
+		status.RecordState(status.VCSError)
+		report.PostErrorWithEmphasis(conflictError.Error(),
+			". Resolve conflicts before going on: handed over changes are kept on the remote until then")
+		return
+	}
+	tcr.handleError(err, false, status.VCSError)
+	if pulled {
+		report.PostSuccessWithEmphasis("Uncommitted changes handed over by the previous driver were restored")
+	}
+}
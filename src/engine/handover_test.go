@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/runmode"
+	"github.com/murex/tcr/status"
+	"github.com/murex/tcr/vcs"
+	"github.com/murex/tcr/vcs/fake"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_handover_activation(t *testing.T) {
+	testFlags := []struct {
+		desc             string
+		handover         bool
+		mode             runmode.RunMode
+		supportsHandover bool
+		expected         bool
+	}{
+		{"handover off", false, runmode.Mob{}, true, false},
+		{"handover on in mob mode", true, runmode.Mob{}, true, true},
+		{"handover on in solo mode", true, runmode.Solo{}, true, false},
+		{"handover on with vcs not supporting handover", true, runmode.Mob{}, false, false},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, vcsFake := initTCREngineWithFakes(
+				params.AParamSet(params.WithRunMode(tt.mode)),
+				nil, nil, nil)
+			vcsFake.SetSupportsHandover(tt.supportsHandover)
+			tcr.setHandover(tt.handover)
+			assert.Equal(t, tt.expected, tcr.handover)
+		})
+	}
+}
+
+func Test_push_handover(t *testing.T) {
+	testFlags := []struct {
+		desc            string
+		handover        bool
+		failingCommands fake.Commands
+		expectedStatus  status.Status
+	}{
+		{"handover off", false, fake.Commands{fake.PushHandoverCommand}, status.Ok},
+		{"handover on", true, nil, status.Ok},
+		{"handover push failure", true, fake.Commands{fake.PushHandoverCommand}, status.VCSError},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, vcsFake := initTCREngineWithFakesWithFileDiffs(
+				params.AParamSet(params.WithRunMode(runmode.Mob{}), params.WithHandover(tt.handover)),
+				nil, tt.failingCommands, nil, vcs.FileDiffs{vcs.NewFileDiff("fake-src", 1, 1)})
+			status.RecordState(status.Ok)
+			tcr.pushHandover()
+			if tt.handover {
+				assert.Equal(t, fake.PushHandoverCommand, vcsFake.GetLastCommand())
+			}
+			assert.Equal(t, tt.expectedStatus, status.GetCurrentState())
+		})
+	}
+}
+
+func Test_pull_handover(t *testing.T) {
+	testFlags := []struct {
+		desc            string
+		failingCommands fake.Commands
+		conflicts       []string
+		expectedStatus  status.Status
+	}{
+		{"handover restored", nil, nil, status.Ok},
+		{"handover pull failure", fake.Commands{fake.PullHandoverCommand}, nil, status.VCSError},
+		{"handover conflict", nil, []string{"some-file"}, status.VCSError},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, vcsFake := initTCREngineWithFakeSettings(
+				params.AParamSet(params.WithRunMode(runmode.Mob{}), params.WithHandover(true)),
+				nil, fake.Settings{FailingCommands: tt.failingCommands, HandoverConflicts: tt.conflicts})
+			status.RecordState(status.Ok)
+			tcr.pullHandover()
+			assert.Equal(t, fake.PullHandoverCommand, vcsFake.GetLastCommand())
+			assert.Equal(t, tt.expectedStatus, status.GetCurrentState())
+		})
+	}
+}
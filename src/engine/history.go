@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"path/filepath"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/export"
+	"github.com/murex/tcr/retro"
+)
+
+// HistoryQuery contains the criteria for querying the history of the current
+// TCR session, either from VCS commits or from the session journal
+type HistoryQuery struct {
+	Since       string
+	Until       string
+	Authors     []string
+	Branches    []string
+	FromJournal bool
+}
+
+// GetLog returns the TCR commit history, or the session journal entries
+// when q.FromJournal is set
+func (tcr *TCREngine) GetLog(q HistoryQuery) (export.Exportable, error) {
+	filter, err := newLogFilter(q.Since, q.Until, q.Authors, q.Branches)
+	if err != nil {
+		return nil, err
+	}
+	if q.FromJournal {
+		entries, err := tcr.readJournal(filter)
+		return export.NewJournal(entries), err
+	}
+	logs, err := tcr.readVCSLogs(filter)
+	return tcrLogsToExportLog(logs), err
+}
+
+// GetStats returns the TCR execution stats
+func (tcr *TCREngine) GetStats(q HistoryQuery) (export.Stats, error) {
+	filter, err := newLogFilter(q.Since, q.Until, q.Authors, q.Branches)
+	if err != nil {
+		return export.Stats{}, err
+	}
+	if q.FromJournal {
+		entries, err := tcr.readJournal(filter)
+		return export.NewJournalStats(tcr.vcs.SessionSummary(), entries), err
+	}
+	logs, err := tcr.readVCSLogs(filter)
+	return export.NewStats(tcr.vcs.SessionSummary(), tcrLogsToEvents(logs)), err
+}
+
+// GetRetro returns the contents of a retrospective in the provided format,
+// using the template found in TCR configuration directory, if any
+func (tcr *TCREngine) GetRetro(q HistoryQuery, format string) (string, error) {
+	retroFormat, err := retro.SelectFormat(format)
+	if err != nil {
+		return "", err
+	}
+	filter, err := newLogFilter(q.Since, q.Until, q.Authors, q.Branches)
+	if err != nil {
+		return "", err
+	}
+	var tcrEvents events.TcrEvents
+	if q.FromJournal {
+		entries, err := tcr.readJournal(filter)
+		if err != nil {
+			return "", err
+		}
+		tcrEvents = entries.ToTcrEvents()
+	} else {
+		logs, err := tcr.readVCSLogs(filter)
+		if err != nil {
+			return "", err
+		}
+		tcrEvents = tcrLogsToEvents(logs)
+	}
+	return retro.Generate(retroFormat, "", filepath.Base(tcr.vcs.GetRootDir()), &tcrEvents)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/export"
+	"github.com/murex/tcr/journal"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/vcs"
+	"github.com/murex/tcr/vcs/fake"
+	"github.com/stretchr/testify/assert"
+)
+
+func historyLogItems() vcs.LogItems {
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	return vcs.LogItems{
+		vcs.NewLogItem("1111", now, passedCommitMessage),
+		vcs.NewLogItem("2222", now.Add(time.Minute), failedCommitMessage),
+		vcs.NewLogItem("3333", now, "other commit message"),
+	}
+}
+
+func Test_get_log(t *testing.T) {
+	tcr, _ := initTCREngineWithFakes(nil, nil, nil, historyLogItems())
+
+	data, err := tcr.GetLog(HistoryQuery{})
+
+	assert.NoError(t, err)
+	log, ok := data.(export.Log)
+	assert.True(t, ok)
+	var hashes []string
+	for _, c := range log {
+		hashes = append(hashes, c.Hash)
+	}
+	assert.Equal(t, []string{"1111", "2222"}, hashes)
+}
+
+func Test_get_log_from_journal(t *testing.T) {
+	initJournalForTest(t)
+	tcr, _ := initTCREngineWithFakes(nil, toolchain.Operations{toolchain.BuildOperation}, nil, nil)
+	tcr.RunTCRCycle()
+
+	data, err := tcr.GetLog(HistoryQuery{FromJournal: true})
+
+	assert.NoError(t, err)
+	entries, ok := data.(export.Journal)
+	assert.True(t, ok)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, journal.Build, entries[0].Type)
+}
+
+func Test_get_history_with_invalid_query(t *testing.T) {
+	tcr, _ := initTCREngineWithFakes(nil, nil, nil, historyLogItems())
+	q := HistoryQuery{Since: "not a time"}
+
+	_, err := tcr.GetLog(q)
+	assert.Error(t, err)
+	_, err = tcr.GetStats(q)
+	assert.Error(t, err)
+	_, err = tcr.GetRetro(q, "markdown")
+	assert.Error(t, err)
+}
+
+func Test_get_history_with_vcs_log_failure(t *testing.T) {
+	tcr, _ := initTCREngineWithFakes(nil, nil, fake.Commands{fake.LogCommand}, historyLogItems())
+
+	_, err := tcr.GetLog(HistoryQuery{})
+	assert.Error(t, err)
+	_, err = tcr.GetStats(HistoryQuery{})
+	assert.Error(t, err)
+	_, err = tcr.GetRetro(HistoryQuery{}, "markdown")
+	assert.Error(t, err)
+}
+
+func Test_get_stats(t *testing.T) {
+	tcr, _ := initTCREngineWithFakes(nil, nil, nil, historyLogItems())
+
+	stats, err := tcr.GetStats(HistoryQuery{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, stats.Commits)
+}
+
+func Test_get_retro(t *testing.T) {
+	tests := []struct {
+		desc     string
+		format   string
+		expected string
+	}{
+		{"markdown format", "markdown", "# Quick Retrospective"},
+		{"html format", "html", "<html"},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			tcr, _ := initTCREngineWithFakes(nil, nil, nil, historyLogItems())
+			contents, err := tcr.GetRetro(HistoryQuery{}, test.format)
+			assert.NoError(t, err)
+			assert.Contains(t, contents, test.expected)
+		})
+	}
+}
+
+func Test_get_retro_with_invalid_format(t *testing.T) {
+	tcr, _ := initTCREngineWithFakes(nil, nil, nil, historyLogItems())
+	_, err := tcr.GetRetro(HistoryQuery{}, "pdf")
+	assert.Error(t, err)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/hook"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/report/role_event"
+	"github.com/murex/tcr/role"
+	"github.com/murex/tcr/status"
+)
+
+// noEvent is passed to hooks triggered outside of test results (pre-build, on-role-change)
+var noEvent = events.TCREvent{Status: events.StatusUnknown}
+
+// runHook runs the command associated to the provided lifecycle hook, if any.
+// Hooks such as formatters may rewrite source files: the hook is run as a source tree
+// rewrite so that these changes do not trigger another TCR cycle.
+// Returns false when the hook command failed
+func (tcr *TCREngine) runHook(name hook.Name, event events.TCREvent, env ...string) bool {
+	if !tcr.hooks.IsSet(name) {
+		return true
+	}
+	report.PostInfo("Running ", name, " hook")
+	var output string
+	var err error
+	tcr.sourceTree.Rewrite(func() { output, err = tcr.hooks.Run(name, event, env...) })
+	if output != "" {
+		report.PostText(output)
+	}
+	if err != nil {
+		report.PostWarning(err)
+		return false
+	}
+	return true
+}
+
+// runPreBuildHook runs pre-build hook. Returns false when the hook aborts the TCR cycle
+func (tcr *TCREngine) runPreBuildHook() bool {
+	if tcr.runHook(hook.PreBuild, noEvent) {
+		return true
+	}
+	status.RecordState(status.OtherError)
+	report.PostWarning("TCR cycle aborted by ", hook.PreBuild, " hook")
+	return false
+}
+
+// runPreCommitHook runs pre-commit hook. Returns false when the hook vetoes the commit.
+// Changes are then neither committed nor reverted
+func (tcr *TCREngine) runPreCommitHook(event events.TCREvent) bool {
+	if tcr.runHook(hook.PreCommit, event) {
+		return true
+	}
+	status.RecordState(status.OtherError)
+	report.PostWarning("Commit vetoed by ", hook.PreCommit, " hook: changes are kept for the next cycle")
+	return false
+}
+
+// runRoleChangeHook runs on-role-change hook, passing the role and trigger
+// through TCR_ROLE and TCR_ROLE_TRIGGER environment variables
+func (tcr *TCREngine) runRoleChangeHook(trigger role_event.Trigger, r role.Role) {
+	tcr.runHook(hook.OnRoleChange, noEvent, "TCR_ROLE="+r.Name(), "TCR_ROLE_TRIGGER="+string(trigger))
+}
@@ -0,0 +1,216 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/filesystem"
+	"github.com/murex/tcr/hook"
+	"github.com/murex/tcr/journal"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/role"
+	"github.com/murex/tcr/status"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/vcs/fake"
+	"github.com/stretchr/testify/assert"
+)
+
+// hookRecorder returns a hook command appending the hook name to the provided file
+func hookRecorder(path string) string {
+	return `echo "$TCR_HOOK$TCR_ROLE_TRIGGER" >> "` + filepath.ToSlash(path) + `"`
+}
+
+func readHookRecords(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	assert.NoError(t, err)
+	return strings.Fields(string(data))
+}
+
+func withAllHooks(command string) func(p *params.Params) {
+	return func(p *params.Params) {
+		for _, name := range hook.Names() {
+			params.WithHook(string(name), command)(p)
+		}
+	}
+}
+
+func Test_tcr_cycle_hooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a POSIX shell")
+	}
+	testFlags := []struct {
+		desc              string
+		isolatedRuns      bool
+		toolchainFailures toolchain.Operations
+		expected          []string
+	}{
+		{
+			"with no failure",
+			false, nil,
+			[]string{"pre-build", "post-test", "pre-commit", "post-commit"},
+		},
+		{
+			"with build failure",
+			false, toolchain.Operations{toolchain.BuildOperation},
+			[]string{"pre-build"},
+		},
+		{
+			"with test failure",
+			false, toolchain.Operations{toolchain.TestOperation},
+			[]string{"pre-build", "post-test", "post-revert"},
+		},
+		{
+			"with no failure in isolated runs",
+			true, nil,
+			[]string{"pre-build", "post-test", "pre-commit", "post-commit"},
+		},
+		{
+			"with build failure in isolated runs",
+			true, toolchain.Operations{toolchain.BuildOperation},
+			[]string{"pre-build"},
+		},
+		{
+			"with test failure in isolated runs",
+			true, toolchain.Operations{toolchain.TestOperation},
+			[]string{"pre-build", "post-test", "post-revert"},
+		},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			records := filepath.Join(t.TempDir(), "hooks.txt")
+			tcr, _ := initTCREngineWithFakes(
+				params.AParamSet(withAllHooks(hookRecorder(records)), params.WithIsolatedRuns(tt.isolatedRuns)),
+				tt.toolchainFailures, nil, nil)
+			tcr.RunTCRCycle()
+			assert.Equal(t, tt.expected, readHookRecords(t, records))
+		})
+	}
+}
+
+// rewriteRecorder is a source tree recording the hooks run as source tree rewrites
+type rewriteRecorder struct {
+	filesystem.SourceTree
+	t         *testing.T
+	records   string
+	rewritten []string
+}
+
+func (r *rewriteRecorder) Rewrite(operation func()) {
+	before := len(readHookRecords(r.t, r.records))
+	r.SourceTree.Rewrite(operation)
+	r.rewritten = append(r.rewritten, readHookRecords(r.t, r.records)[before:]...)
+}
+
+func Test_hooks_run_as_source_tree_rewrites(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a POSIX shell")
+	}
+	records := filepath.Join(t.TempDir(), "hooks.txt")
+	tcr, _ := initTCREngineWithFakes(
+		params.AParamSet(withAllHooks(hookRecorder(records))),
+		nil, nil, nil)
+	recorder := &rewriteRecorder{SourceTree: tcr.sourceTree, t: t, records: records}
+	tcr.sourceTree = recorder
+	tcr.RunTCRCycle()
+	assert.Equal(t, []string{"pre-build", "post-test", "pre-commit", "post-commit"}, recorder.rewritten)
+}
+
+func Test_pre_build_hook_can_abort_tcr_cycle(t *testing.T) {
+	initJournalForTest(t)
+	tcr, _ := initTCREngineWithFakes(
+		params.AParamSet(params.WithHook(string(hook.PreBuild), "exit 1")),
+		nil, nil, nil)
+	tcr.RunTCRCycle()
+	assert.Equal(t, status.OtherError, status.GetCurrentState())
+	assert.Empty(t, journalEntryTypes(t, tcr))
+}
+
+func Test_pre_commit_hook_can_veto_commit(t *testing.T) {
+	initJournalForTest(t)
+	tcr, _ := initTCREngineWithFakes(
+		params.AParamSet(params.WithHook(string(hook.PreCommit), "exit 1")),
+		nil, nil, nil)
+	tcr.RunTCRCycle()
+	assert.Equal(t, status.OtherError, status.GetCurrentState())
+	assert.Equal(t, []journal.EntryType{journal.Build, journal.Test}, journalEntryTypes(t, tcr))
+}
+
+func Test_pre_commit_hook_can_veto_commit_in_isolated_runs(t *testing.T) {
+	tcr, vcsFake := initTCREngineWithFakes(
+		params.AParamSet(params.WithIsolatedRuns(true), params.WithHook(string(hook.PreCommit), "exit 1")),
+		nil, nil, nil)
+	tcr.RunTCRCycle()
+	assert.Equal(t, status.OtherError, status.GetCurrentState())
+	assert.Equal(t, []fake.Command{
+		fake.TakeSnapshotCommand,
+		fake.DiffCommand,
+		fake.DropSnapshotCommand,
+	}, vcsFake.GetLastCommands(3))
+}
+
+func Test_hooks_receive_tcr_event_as_json(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a POSIX shell")
+	}
+	out := filepath.Join(t.TempDir(), "event.json")
+	tcr, _ := initTCREngineWithFakes(
+		params.AParamSet(params.WithHook(string(hook.PostCommit), `cat > "`+out+`"`)),
+		nil, nil, nil)
+	tcr.RunTCRCycle()
+
+	data, err := os.ReadFile(out)
+	assert.NoError(t, err)
+	var event events.TCREvent
+	assert.NoError(t, json.Unmarshal(data, &event))
+	assert.Equal(t, events.StatusPass, event.Status)
+}
+
+func Test_on_role_change_hook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a POSIX shell")
+	}
+	records := filepath.Join(t.TempDir(), "hooks.txt")
+	tcr, _ := initTCREngineWithFakes(
+		params.AParamSet(params.WithHook(string(hook.OnRoleChange), hookRecorder(records))),
+		nil, nil, nil)
+	tcr.setCurrentRole(role.Driver{})
+	tcr.resetCurrentRole()
+	assert.Equal(t, []string{"on-role-changestart", "on-role-changeend"}, readHookRecords(t, records))
+}
+
+func Test_hooks_not_set(t *testing.T) {
+	tcr, _ := initTCREngineWithFakes(nil, nil, nil, nil)
+	assert.True(t, tcr.runHook(hook.PreCommit, noEvent))
+}
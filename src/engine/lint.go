@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/journal"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/status"
+	"github.com/murex/tcr/toolchain/command"
+	"github.com/murex/tcr/variant"
+)
+
+// lintOutcome tells what a TCR cycle does with changes once they went through the lint stage
+type lintOutcome int
+
+const (
+	lintCommit lintOutcome = iota
+	lintKeep
+	lintRevert
+)
+
+// setLintPolicy sets the policy applied to changes failing the toolchain's lint command.
+// The lint stage is turned off when the toolchain has no lint command
+func (tcr *TCREngine) setLintPolicy(name string) {
+	policy, err := variant.SelectLintPolicy(name)
+	if err != nil {
+		var unsupportedLintPolicyError *variant.UnsupportedLintPolicyError
+		if errors.As(err, &unsupportedLintPolicyError) {
+			tcr.handleError(err, true, status.ConfigError)
+		}
+	}
+	tcr.lintPolicy = policy
+	if policy.IsOn() && !tcr.supportedWithModules("Lint policy") {
+		tcr.lintPolicy = variant.LintOff
+		return
+	}
+	if policy.IsOn() && !tcr.toolchain.HasLintCommand() {
+		report.PostWarning(tcr.toolchain.GetName(), " toolchain has no lint command: lint policy is ignored")
+		tcr.lintPolicy = variant.LintOff
+	}
+}
+
+// setAutoFormat turns on code formatting before each build when requested
+// and supported by the toolchain
+func (tcr *TCREngine) setAutoFormat(flag bool) {
+	if flag && !tcr.supportedWithModules("Auto-format") {
+		tcr.autoFormat = false
+		return
+	}
+	tcr.autoFormat = flag && tcr.toolchain.HasFormatCommand()
+	if flag && !tcr.autoFormat {
+		report.PostWarning(tcr.toolchain.GetName(), " toolchain has no format command: code will not be formatted")
+	}
+}
+
+// format runs the toolchain's format command when auto-format is on. A format
+// failure does not stop the TCR cycle: the build is the one reporting code errors
+func (tcr *TCREngine) format() {
+	if !tcr.autoFormat {
+		return
+	}
+	report.PostInfo("Formatting Code")
+	start := time.Now()
+	var result command.Result
+	tcr.sourceTree.Rewrite(func() { result = tcr.toolchain.RunFormat() })
+	entry := journal.Entry{Type: journal.Format, Status: events.StatusPass, Duration: time.Since(start)}
+	if result.Failed() {
+		entry.Status = events.StatusFail
+		report.PostWarning("Code formatting failed")
+	}
+	tcr.record(entry)
+}
+
+// lint runs the toolchain's lint command when the lint policy is on.
+// Returns false when lint found issues
+func (tcr *TCREngine) lint() bool {
+	if !tcr.lintPolicy.IsOn() {
+		return true
+	}
+	report.PostInfo("Running Lint")
+	start := time.Now()
+	result := tcr.toolchain.RunLint()
+	entry := journal.Entry{Type: journal.Lint, Status: events.StatusPass, Duration: time.Since(start)}
+	if result.Failed() {
+		entry.Status = events.StatusFail
+	}
+	tcr.record(entry)
+	return result.Passed()
+}
+
+// applyLintPolicy tells what to do with changes depending on the lint stage result
+func (tcr *TCREngine) applyLintPolicy(linted bool) lintOutcome {
+	if linted {
+		return lintCommit
+	}
+	switch tcr.lintPolicy {
+	case variant.LintBlock:
+		status.RecordState(status.OtherError)
+		report.PostWarningWithEmphasis("Commit blocked by lint issues: changes are kept for the next cycle")
+		return lintKeep
+	case variant.LintRevert:
+		status.RecordState(status.OtherError)
+		report.PostErrorWithEmphasis("Lint found issues! Changes will be reverted")
+		return lintRevert
+	default:
+		report.PostWarningWithEmphasis("Lint found issues! Committing anyway")
+		return lintCommit
+	}
+}
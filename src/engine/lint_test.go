@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/murex/tcr/journal"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/status"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/variant"
+	"github.com/stretchr/testify/assert"
+)
+
+// initTCREngineWithLint initializes TCR engine with a fake toolchain providing
+// both lint and format commands
+func initTCREngineWithLint(
+	t *testing.T,
+	isolatedRuns bool,
+	toolchainFailures toolchain.Operations,
+	policy variant.LintPolicy,
+) (*TCREngine, *toolchain.FakeToolchain) {
+	t.Helper()
+	initJournalForTest(t)
+	tcr, _ := initTCREngineWithFakes(params.AParamSet(params.WithIsolatedRuns(isolatedRuns)),
+		toolchainFailures, nil, nil)
+	tchn := toolchain.NewFakeToolchain(toolchainFailures, toolchain.TestStats{}).WithLint(true).WithFormat(true)
+	tcr.toolchain = tchn
+	tcr.setLintPolicy(policy.Name())
+	tcr.setAutoFormat(true)
+	return tcr, tchn
+}
+
+func Test_tcr_cycle_with_lint_and_format(t *testing.T) {
+	testFlags := []struct {
+		desc              string
+		policy            variant.LintPolicy
+		toolchainFailures toolchain.Operations
+		expectedOps       toolchain.Operations
+		expectedEntries   []journal.EntryType
+		expectedStatus    status.Status
+	}{
+		{
+			"lint off",
+			variant.LintOff, nil,
+			toolchain.Operations{toolchain.FormatOperation, toolchain.BuildOperation, toolchain.TestOperation},
+			[]journal.EntryType{journal.Format, journal.Build, journal.Test, journal.Commit},
+			status.Ok,
+		},
+		{
+			"lint passing",
+			variant.LintRevert, nil,
+			toolchain.Operations{toolchain.FormatOperation, toolchain.BuildOperation, toolchain.TestOperation, toolchain.LintOperation},
+			[]journal.EntryType{journal.Format, journal.Build, journal.Test, journal.Lint, journal.Commit},
+			status.Ok,
+		},
+		{
+			"lint failing with warn policy",
+			variant.LintWarn, toolchain.Operations{toolchain.LintOperation},
+			toolchain.Operations{toolchain.FormatOperation, toolchain.BuildOperation, toolchain.TestOperation, toolchain.LintOperation},
+			[]journal.EntryType{journal.Format, journal.Build, journal.Test, journal.Lint, journal.Commit},
+			status.Ok,
+		},
+		{
+			"lint failing with block policy",
+			variant.LintBlock, toolchain.Operations{toolchain.LintOperation},
+			toolchain.Operations{toolchain.FormatOperation, toolchain.BuildOperation, toolchain.TestOperation, toolchain.LintOperation},
+			[]journal.EntryType{journal.Format, journal.Build, journal.Test, journal.Lint},
+			status.OtherError,
+		},
+		{
+			"lint failing with revert policy",
+			variant.LintRevert, toolchain.Operations{toolchain.LintOperation},
+			toolchain.Operations{toolchain.FormatOperation, toolchain.BuildOperation, toolchain.TestOperation, toolchain.LintOperation},
+			[]journal.EntryType{journal.Format, journal.Build, journal.Test, journal.Lint, journal.Revert},
+			status.Ok,
+		},
+		{
+			"tests failing",
+			variant.LintRevert, toolchain.Operations{toolchain.TestOperation},
+			toolchain.Operations{toolchain.FormatOperation, toolchain.BuildOperation, toolchain.TestOperation},
+			[]journal.EntryType{journal.Format, journal.Build, journal.Test, journal.Revert},
+			status.Ok,
+		},
+		{
+			"format failing",
+			variant.LintOff, toolchain.Operations{toolchain.FormatOperation},
+			toolchain.Operations{toolchain.FormatOperation, toolchain.BuildOperation, toolchain.TestOperation},
+			[]journal.EntryType{journal.Format, journal.Build, journal.Test, journal.Commit},
+			status.Ok,
+		},
+	}
+
+	for _, isolatedRuns := range []bool{false, true} {
+		for _, tt := range testFlags {
+			desc := tt.desc
+			if isolatedRuns {
+				desc += " in isolated runs"
+			}
+			t.Run(desc, func(t *testing.T) {
+				tcr, tchn := initTCREngineWithLint(t, isolatedRuns, tt.toolchainFailures, tt.policy)
+				tcr.RunTCRCycle()
+				assert.Equal(t, tt.expectedOps, tchn.GetRanOperations())
+				assert.Equal(t, tt.expectedEntries, journalEntryTypes(t, tcr))
+				assert.Equal(t, tt.expectedStatus, status.GetCurrentState())
+			})
+		}
+	}
+}
+
+func Test_lint_policy_is_ignored_when_toolchain_has_no_lint_command(t *testing.T) {
+	tcr, _ := initTCREngineWithFakes(params.AParamSet(params.WithLintPolicy("block")), nil, nil, nil)
+	assert.Equal(t, variant.LintOff, tcr.lintPolicy)
+}
+
+func Test_auto_format_is_ignored_when_toolchain_has_no_format_command(t *testing.T) {
+	tcr, _ := initTCREngineWithFakes(params.AParamSet(params.WithAutoFormat(true)), nil, nil, nil)
+	assert.False(t, tcr.autoFormat)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"path/filepath"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/mob"
+	"github.com/murex/tcr/vcs"
+)
+
+// publishCycle makes the outcome of a TCR cycle available to mob guests
+// following the driver's session
+func (tcr *TCREngine) publishCycle(event events.TCREvent, diffs vcs.FileDiffs) {
+	var driver string
+	if d, ok := tcr.GetRoster().Driver(); ok {
+		driver = d.Name
+	}
+	// Guests do not share host's file system: paths are made relative to base directory
+	var relDiffs vcs.FileDiffs
+	for _, diff := range diffs {
+		if rel, err := filepath.Rel(tcr.sourceTree.GetBaseDir(), diff.Path); err == nil {
+			diff.Path = filepath.ToSlash(rel)
+		}
+		relDiffs = append(relDiffs, diff)
+	}
+	mob.Publish(mob.NewCycle(driver, event, relDiffs))
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/murex/tcr/mob"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/vcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_tcr_cycle_publishes_cycle_outcome_to_mob_guests(t *testing.T) {
+	testFlags := []struct {
+		desc              string
+		toolchainFailures toolchain.Operations
+		expected          []string
+	}{
+		{"with no failure", nil, []string{"pass"}},
+		{"with build failure", toolchain.Operations{toolchain.BuildOperation}, nil},
+		{"with test failure", toolchain.Operations{toolchain.TestOperation}, []string{"fail"}},
+	}
+
+	// Diff paths are absolute, and should be published relative to base directory
+	srcPath, _ := filepath.Abs("fake-src")
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var cycles []mob.Cycle
+			unsubscribe := mob.Subscribe(func(c mob.Cycle) { cycles = append(cycles, c) })
+			defer unsubscribe()
+
+			tcr, _ := initTCREngineWithFakesWithFileDiffs(
+				params.AParamSet(params.WithMembers("alice", "bob")),
+				tt.toolchainFailures, nil, nil, vcs.FileDiffs{vcs.NewFileDiff(srcPath, 2, 1)})
+			tcr.RunTCRCycle()
+
+			var statuses []string
+			for _, c := range cycles {
+				statuses = append(statuses, c.Status)
+				assert.Equal(t, "alice", c.Driver)
+				assert.Equal(t, []mob.FileDiff{{Path: "fake-src", Added: 2, Removed: 1}}, c.Diffs)
+			}
+			assert.Equal(t, tt.expected, statuses)
+		})
+	}
+}
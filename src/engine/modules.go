@@ -0,0 +1,243 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/murex/tcr/language"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/status"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/toolchain/command"
+	"github.com/murex/tcr/vcs"
+)
+
+// module is a session module, along with its directory path relative to the
+// work directory, allowing to locate it in snapshots of the working tree
+type module struct {
+	*language.Module
+	workDir string
+}
+
+// initModules initializes the modules declared for the session. The language
+// and toolchain of the first module are used as session-wide defaults
+func (tcr *TCREngine) initModules(declarations []string) {
+	tcr.modules = nil
+	for _, declaration := range declarations {
+		m, err := language.GetModule(declaration, tcr.sourceTree.GetBaseDir())
+		tcr.handleError(err, true, status.ConfigError)
+		workDir, err := filepath.Rel(toolchain.GetWorkDir(), m.Dir)
+		tcr.handleError(err, true, status.ConfigError)
+		report.PostInfo("Module ", m)
+		tcr.modules = append(tcr.modules, module{Module: m, workDir: workDir})
+	}
+	tcr.language = tcr.modules[0].Language
+	tcr.toolchain = tcr.modules[0].Toolchain
+}
+
+// supportedWithModules returns false, with a warning, when the session declares modules.
+// Used for turning off features that are not supported yet in such sessions
+func (tcr *TCREngine) supportedWithModules(feature string) bool {
+	if len(tcr.modules) == 0 {
+		return true
+	}
+	report.PostWarning(feature, " is not supported with modules: it is turned off")
+	return false
+}
+
+// moduleContaining returns the module containing the provided path
+func (tcr *TCREngine) moduleContaining(path string) (module, bool) {
+	for _, m := range tcr.modules {
+		if m.Contains(path) {
+			return m, true
+		}
+	}
+	return module{}, false
+}
+
+// languageOf returns the language of the provided file: the one of the module containing
+// it when the session declares modules, or the session language otherwise. Returns nil
+// when the file does not belong to any module
+func (tcr *TCREngine) languageOf(path string) language.LangInterface {
+	if len(tcr.modules) == 0 {
+		return tcr.language
+	}
+	if m, found := tcr.moduleContaining(path); found {
+		return m.Language
+	}
+	return nil
+}
+
+func (tcr *TCREngine) isSrcFile(path string) bool {
+	lang := tcr.languageOf(path)
+	return lang != nil && lang.IsSrcFile(path)
+}
+
+func (tcr *TCREngine) isTestFile(path string) bool {
+	lang := tcr.languageOf(path)
+	return lang != nil && lang.IsTestFile(path)
+}
+
+func (tcr *TCREngine) isLanguageFile(path string) bool {
+	lang := tcr.languageOf(path)
+	return lang != nil && lang.IsLanguageFile(path)
+}
+
+// dirsToWatch returns the directories to be watched for changes
+func (tcr *TCREngine) dirsToWatch() []string {
+	if len(tcr.modules) == 0 {
+		return tcr.language.DirsToWatch(tcr.sourceTree.GetBaseDir())
+	}
+	var dirs []string
+	for _, m := range tcr.modules {
+		dirs = append(dirs, m.Language.DirsToWatch(m.Dir)...)
+	}
+	return dirs
+}
+
+// affectedModules returns the modules containing the provided changes. All modules
+// are affected when none of the changes is located in a module
+func (tcr *TCREngine) affectedModules(diffs vcs.FileDiffs) (affected []module) {
+	for _, m := range tcr.modules {
+		for _, diff := range diffs {
+			if m.Contains(diff.Path) {
+				affected = append(affected, m)
+				break
+			}
+		}
+	}
+	if len(affected) == 0 {
+		return tcr.modules
+	}
+	return affected
+}
+
+// pendingModules returns the modules affected by the changes pending in the working tree
+func (tcr *TCREngine) pendingModules() []module {
+	if len(tcr.modules) == 0 {
+		return nil
+	}
+	diffs, err := tcr.vcs.Diff()
+	if err != nil {
+		report.PostWarning(err)
+	}
+	return tcr.affectedModules(diffs)
+}
+
+// runInModule runs the provided function with toolchain work directory pointing
+// to the module directory, or to its counterpart when running in a snapshot
+func runInModule(m module, f func()) error {
+	workDir := toolchain.GetWorkDir()
+	err := toolchain.SetWorkDir(filepath.Join(workDir, m.workDir))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = toolchain.SetWorkDir(workDir) }()
+	f()
+	return nil
+}
+
+// buildModules runs the build of each provided module, stopping at the first failure
+func buildModules(modules []module) command.Result {
+	for _, m := range modules {
+		report.PostInfo("Building module ", m)
+		result := command.Result{Status: command.StatusFail}
+		if err := runInModule(m, func() { result = m.Toolchain.RunBuild() }); err != nil {
+			report.PostWarning(err)
+		}
+		if result.Failed() {
+			report.PostWarning("Build failed in module ", m.Name)
+			return result
+		}
+	}
+	return command.Result{Status: command.StatusPass}
+}
+
+// testModules runs the tests of each provided module and reports their results.
+// Test results are merged into a single result that passes only when all module
+// tests pass
+func testModules(modules []module) toolchain.TestCommandResult {
+	merged := toolchain.TestCommandResult{Result: command.Result{Status: command.StatusPass}}
+	for _, m := range modules {
+		report.PostInfo("Testing module ", m)
+		result := toolchain.TestCommandResult{Result: command.Result{Status: command.StatusFail}}
+		if err := runInModule(m, func() { result = m.Toolchain.RunTests() }); err != nil {
+			report.PostWarning(err)
+		}
+		reportModuleTests(m, result)
+		if result.Failed() {
+			merged.Status = command.StatusFail
+		}
+		merged.Output += result.Output
+		merged.Stats = addTestStats(merged.Stats, result.Stats)
+	}
+	return merged
+}
+
+// reportModuleTests reports the test results of the provided module
+func reportModuleTests(m module, result toolchain.TestCommandResult) {
+	outcome := "passed"
+	if result.Failed() {
+		outcome = "failed"
+	}
+	summary := fmt.Sprintf("Module %v: tests %v (%d run, %d passed, %d failed, %d skipped, %d error(s))",
+		m.Name, outcome, result.Stats.TotalRun, result.Stats.Passed, result.Stats.Failed,
+		result.Stats.Skipped, result.Stats.WithErrors)
+	if result.Failed() {
+		report.PostWarning(summary)
+	} else {
+		report.PostInfo(summary)
+	}
+}
+
+func addTestStats(s1, s2 toolchain.TestStats) toolchain.TestStats {
+	return toolchain.TestStats{
+		TotalRun:   s1.TotalRun + s2.TotalRun,
+		Passed:     s1.Passed + s2.Passed,
+		Failed:     s1.Failed + s2.Failed,
+		Skipped:    s1.Skipped + s2.Skipped,
+		WithErrors: s1.WithErrors + s2.WithErrors,
+		Duration:   s1.Duration + s2.Duration,
+	}
+}
+
+// modulesLanguageNames returns the names of the languages used by the session modules
+func (tcr *TCREngine) modulesLanguageNames() string {
+	var names []string
+	for _, m := range tcr.modules {
+		names = append(names, m.Language.GetName())
+	}
+	return strings.Join(names, ", ")
+}
+
+// modulesToolchainNames returns the names of the toolchains used by the session modules
+func (tcr *TCREngine) modulesToolchainNames() string {
+	var names []string
+	for _, m := range tcr.modules {
+		names = append(names, m.Toolchain.GetName())
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/murex/tcr/journal"
+	"github.com/murex/tcr/language"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/vcs"
+	"github.com/murex/tcr/vcs/fake"
+	"github.com/stretchr/testify/assert"
+)
+
+var moduleNames = []string{"backend", "frontend"}
+
+// initTCREngineWithModules initializes TCR engine with a backend and a frontend module,
+// each having a fake toolchain of its own, and with changes pending in the provided files.
+// File paths are relative to the base directory
+func initTCREngineWithModules(
+	t *testing.T,
+	isolatedRuns bool,
+	failures map[string]toolchain.Operations,
+	changedFiles ...string,
+) (*TCREngine, map[string]*toolchain.FakeToolchain) {
+	t.Helper()
+	initJournalForTest(t)
+	// Fake language treats files with "test" in their path as test files, which
+	// prevents from using t.TempDir() as it is named after the test
+	baseDir, err := os.MkdirTemp("", "tcr-modules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(baseDir) })
+	var diffs vcs.FileDiffs
+	for _, file := range changedFiles {
+		diffs = append(diffs, vcs.NewFileDiff(filepath.Join(baseDir, filepath.FromSlash(file)), 1, 0))
+	}
+	tcr, _ := initTCREngineWithFakeSettings(
+		params.AParamSet(
+			params.WithBaseDir(baseDir),
+			params.WithWorkDir(baseDir),
+			params.WithIsolatedRuns(isolatedRuns)),
+		nil,
+		fake.Settings{ChangedFiles: diffs},
+	)
+	toolchains := make(map[string]*toolchain.FakeToolchain)
+	for _, name := range moduleNames {
+		dir := filepath.Join(baseDir, name)
+		if err := os.Mkdir(dir, 0750); err != nil {
+			t.Fatal(err)
+		}
+		toolchains[name] = toolchain.NewFakeToolchain(failures[name], toolchain.TestStats{TotalRun: 2, Passed: 2})
+		tcr.modules = append(tcr.modules, module{
+			Module: &language.Module{
+				Name:      name,
+				Dir:       dir,
+				Language:  language.NewFakeLanguage("fake-toolchain"),
+				Toolchain: toolchains[name],
+			},
+			workDir: name,
+		})
+	}
+	return tcr, toolchains
+}
+
+func Test_affected_modules(t *testing.T) {
+	testFlags := []struct {
+		desc         string
+		changedFiles []string
+		expected     []string
+	}{
+		{"change in one module", []string{"frontend/src/app.ts"}, []string{"frontend"}},
+		{"changes in all modules", []string{"frontend/src/app.ts", "backend/src/App.kt"}, moduleNames},
+		{"change outside modules", []string{"README.md"}, moduleNames},
+		{"no change", nil, moduleNames},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, _ := initTCREngineWithModules(t, false, nil, tt.changedFiles...)
+			var names []string
+			for _, m := range tcr.pendingModules() {
+				names = append(names, m.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func Test_tcr_cycle_with_modules(t *testing.T) {
+	testFlags := []struct {
+		desc            string
+		failures        map[string]toolchain.Operations
+		changedFiles    []string
+		expectedRuns    map[string]toolchain.Operations
+		expectedEntries []journal.EntryType
+	}{
+		{
+			"change in one module passing tests",
+			nil,
+			[]string{"frontend/src/app.ts"},
+			map[string]toolchain.Operations{
+				"backend":  nil,
+				"frontend": {toolchain.BuildOperation, toolchain.TestOperation},
+			},
+			[]journal.EntryType{journal.Build, journal.Test, journal.Commit},
+		},
+		{
+			"change in one module failing tests",
+			map[string]toolchain.Operations{"frontend": {toolchain.TestOperation}},
+			[]string{"frontend/src/app.ts"},
+			map[string]toolchain.Operations{
+				"backend":  nil,
+				"frontend": {toolchain.BuildOperation, toolchain.TestOperation},
+			},
+			[]journal.EntryType{journal.Build, journal.Test, journal.Revert},
+		},
+		{
+			"changes in all modules with one failing build",
+			map[string]toolchain.Operations{"backend": {toolchain.BuildOperation}},
+			[]string{"frontend/src/app.ts", "backend/src/App.kt"},
+			map[string]toolchain.Operations{
+				"backend":  {toolchain.BuildOperation},
+				"frontend": nil,
+			},
+			[]journal.EntryType{journal.Build},
+		},
+		{
+			"changes in all modules with one failing test",
+			map[string]toolchain.Operations{"backend": {toolchain.TestOperation}},
+			[]string{"frontend/src/app.ts", "backend/src/App.kt"},
+			map[string]toolchain.Operations{
+				"backend":  {toolchain.BuildOperation, toolchain.TestOperation},
+				"frontend": {toolchain.BuildOperation, toolchain.TestOperation},
+			},
+			[]journal.EntryType{journal.Build, journal.Test, journal.Revert},
+		},
+	}
+	for _, isolatedRuns := range []bool{false, true} {
+		for _, tt := range testFlags {
+			desc := tt.desc
+			if isolatedRuns {
+				desc += " in isolated runs"
+			}
+			t.Run(desc, func(t *testing.T) {
+				tcr, toolchains := initTCREngineWithModules(t, isolatedRuns, tt.failures, tt.changedFiles...)
+				tcr.RunTCRCycle()
+				for _, name := range moduleNames {
+					assert.Equal(t, tt.expectedRuns[name], toolchains[name].GetRanOperations(), name)
+				}
+				assert.Equal(t, tt.expectedEntries, journalEntryTypes(t, tcr))
+			})
+		}
+	}
+}
+
+func Test_module_test_results_are_merged(t *testing.T) {
+	tcr, _ := initTCREngineWithModules(t, false, map[string]toolchain.Operations{
+		"backend": {toolchain.TestOperation},
+	})
+	result := tcr.test(tcr.modules, nil)
+	assert.True(t, result.Failed())
+	assert.Equal(t, toolchain.TestStats{TotalRun: 4, Passed: 4}, result.Stats)
+}
+
+func Test_module_tests_run_in_module_directory(t *testing.T) {
+	tcr, _ := initTCREngineWithModules(t, false, nil)
+	baseWorkDir := toolchain.GetWorkDir()
+	var workDir string
+	assert.NoError(t, runInModule(tcr.modules[1], func() { workDir = toolchain.GetWorkDir() }))
+	assert.Equal(t, filepath.Join(baseWorkDir, "frontend"), workDir)
+	assert.Equal(t, baseWorkDir, toolchain.GetWorkDir())
+}
+
+func Test_files_are_classified_with_the_language_of_their_module(t *testing.T) {
+	tcr, _ := initTCREngineWithModules(t, false, nil)
+	baseDir := tcr.sourceTree.GetBaseDir()
+	testFlags := []struct {
+		desc     string
+		path     string
+		expected bool
+	}{
+		{"source file in a module", filepath.Join(baseDir, "backend", "src", "App.kt"), true},
+		{"source file outside modules", filepath.Join(baseDir, "tools", "script.kt"), false},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tcr.isSrcFile(tt.path))
+		})
+	}
+}
+
+func Test_session_info_with_modules_lists_module_languages_and_toolchains(t *testing.T) {
+	tcr, _ := initTCREngineWithModules(t, false, nil)
+	info := tcr.GetSessionInfo()
+	assert.Equal(t, "fake-language, fake-language", info.LanguageName)
+	assert.Equal(t, "fake-toolchain, fake-toolchain", info.ToolchainName)
+}
+
+func Test_features_not_supported_with_modules_are_turned_off(t *testing.T) {
+	tcr, _ := initTCREngineWithModules(t, false, nil)
+	tcr.setTestSelection(true, 10)
+	tcr.setAutoFormat(true)
+	tcr.setLintPolicy("block")
+	tcr.setCoverageGate(true)
+	assert.False(t, tcr.testSelection)
+	assert.False(t, tcr.autoFormat)
+	assert.False(t, tcr.lintPolicy.IsOn())
+	assert.False(t, tcr.coverageGate)
+}
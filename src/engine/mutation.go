@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/murex/tcr/language"
+	"github.com/murex/tcr/mutation"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/status"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/vcs"
+)
+
+// mutantTimeoutFactor is the maximum ratio between the duration of tests run against a mutant
+// and the duration of tests run against the original sources. Mutants such as the ones turning
+// a loop condition into an endless loop are aborted once this duration is reached
+const mutantTimeoutFactor = 5
+
+// minMutantTimeout is the minimum duration that tests run against a mutant are given
+// before being aborted, preventing fast test suites from timing out on duration noise
+var minMutantTimeout = 10 * time.Second
+
+// RunMutationTests mutates the source files changed by the last TCR commits, one mutant
+// at a time, and reports the mutants surviving the tests
+func (tcr *TCREngine) RunMutationTests(p params.Params) {
+	var err error
+	tcr.initSourceTree(p)
+	restoreMutatedFiles()
+
+	tcr.language, err = language.GetLanguage(p.Language, tcr.sourceTree.GetBaseDir())
+	tcr.handleError(err, true, status.ConfigError)
+
+	tcr.toolchain, err = tcr.language.GetToolchain(p.Toolchain)
+	tcr.handleError(err, true, status.ConfigError)
+
+	err = toolchain.SetWorkDir(p.WorkDir)
+	tcr.handleError(err, true, status.ConfigError)
+
+	tcr.initVCS(p.VCS, "", p.Trace)
+	tcr.runMutationTests(p.MutationCommits, tcr.logFilter(p))
+}
+
+// runMutationTests runs mutation tests on the source files changed by the provided
+// number of TCR commits matching filter, and returns the resulting score
+func (tcr *TCREngine) runMutationTests(commits int, filter vcs.LogFilter) (score mutation.Score) {
+	diffs, err := tcr.vcs.Diff()
+	if err != nil {
+		tcr.handleError(err, false, status.VCSError)
+		return score
+	}
+	if len(diffs) > 0 {
+		status.RecordState(status.OtherError)
+		report.PostError("Mutation tests require a clean working tree: commit or revert local changes first")
+		return score
+	}
+
+	files := tcr.mutationTargets(commits, filter)
+	if len(files) == 0 {
+		report.PostWarning("no source file to mutate found in the last ", commits, " TCR commit(s)")
+		return score
+	}
+
+	report.PostInfo("Checking that tests pass before mutating ", len(files), " source file(s)")
+	outcome, testDuration := tcr.runMutant(0)
+	if outcome != mutation.Survived {
+		status.RecordState(status.TestFailed)
+		report.PostError("Build and tests must pass before running mutation tests")
+		return score
+	}
+
+	timeout := max(mutantTimeoutFactor*testDuration, minMutantTimeout)
+	for _, path := range files {
+		tcr.mutateFile(path, timeout, &score)
+	}
+
+	report.PostInfo(fmt.Sprintf("Mutation score: %.1f%% (%d killed including %d timed out, %d survived, %d not building, out of %d mutants)",
+		score.Percent(), score.Killed, score.TimedOut, score.Survived, score.Stillborn, score.Total()))
+	if score.Survived > 0 {
+		report.PostWarningWithEmphasis(score.Survived, " mutant(s) survived: changes are not fully covered by test assertions")
+	}
+	return score
+}
+
+// mutationTargets returns the absolute path of the source files changed by the provided
+// number of most recent TCR commits matching filter, for which mutants can be generated
+func (tcr *TCREngine) mutationTargets(commits int, filter vcs.LogFilter) (files []string) {
+	logs, err := tcr.readVCSLogs(filter)
+	if err != nil {
+		report.PostError(err)
+		return nil
+	}
+	slices.SortStableFunc(logs, func(a, b vcs.LogItem) int {
+		return b.Timestamp.Compare(a.Timestamp)
+	})
+	if commits > 0 && len(logs) > commits {
+		logs = logs[:commits]
+	}
+	for _, log := range logs {
+		commitFiles, err := tcr.vcs.CommitFiles(log.Hash)
+		if err != nil {
+			report.PostWarning(err)
+			continue
+		}
+		for _, path := range commitFiles {
+			if slices.Contains(files, path) || !mutation.Supports(path) || !tcr.language.IsSrcFile(path) {
+				continue
+			}
+			if _, err := os.Stat(path); err == nil {
+				files = append(files, path)
+			}
+		}
+	}
+	slices.Sort(files)
+	return files
+}
+
+// restoreMutatedFiles restores the source files left mutated by an interrupted mutation run
+func restoreMutatedFiles() {
+	restored, err := mutation.RestoreBackups()
+	for _, path := range restored {
+		report.PostWarning("Restored ", path, " left mutated by an interrupted mutation test run")
+	}
+	if err != nil {
+		report.PostError("Failed to restore files left mutated by an interrupted mutation test run: ", err)
+	}
+}
+
+// mutateFile runs the tests against each mutant of the provided file, and records
+// their outcome into score. The file's original contents are backed up beforehand
+// so that they can be restored on next start if TCR is interrupted, and restored
+// after each mutant
+func (tcr *TCREngine) mutateFile(path string, timeout time.Duration, score *mutation.Score) {
+	info, err := os.Stat(path)
+	if err != nil {
+		report.PostWarning(err)
+		return
+	}
+	src, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		report.PostWarning(err)
+		return
+	}
+	name, err := filepath.Rel(tcr.sourceTree.GetBaseDir(), path)
+	if err != nil {
+		name = path
+	}
+	mutants := mutation.Generate(name, src)
+	report.PostInfo("Mutating ", name, " (", len(mutants), " mutant(s))")
+	if err = mutation.Backup(path, info.Mode(), src); err != nil {
+		report.PostWarning("Cannot back up ", path, " before mutating it: ", err)
+		return
+	}
+	restore := func() bool {
+		if err := os.WriteFile(path, src, info.Mode()); err != nil {
+			report.PostError("Failed to restore ", path, ": ", err)
+			return false
+		}
+		return true
+	}
+	defer func() {
+		if !restore() {
+			return
+		}
+		if err := mutation.RemoveBackup(path); err != nil {
+			report.PostWarning(err)
+		}
+	}()
+	for _, m := range mutants {
+		if err := os.WriteFile(path, m.Apply(src), info.Mode()); err != nil {
+			report.PostWarning(err)
+			return
+		}
+		outcome, _ := tcr.runMutant(timeout)
+		score.Add(outcome)
+		switch outcome {
+		case mutation.Survived:
+			report.PostWarning("Surviving mutant: ", m)
+		case mutation.TimedOut:
+			report.PostWarning("Tests timed out after ", timeout, " with mutant: ", m)
+		}
+		if !restore() {
+			return
+		}
+	}
+}
+
+// runMutant builds and tests the current source tree, and returns the corresponding
+// mutant outcome along with the duration of the tests. When timeout is set, tests
+// running longer are aborted and the mutant is considered as killed by the timeout
+func (tcr *TCREngine) runMutant(timeout time.Duration) (mutation.Outcome, time.Duration) {
+	if tcr.toolchain.RunBuild().Failed() {
+		return mutation.Stillborn, 0
+	}
+	var timedOut atomic.Bool
+	if timeout > 0 {
+		timer := time.AfterFunc(timeout, func() {
+			if tcr.toolchain.AbortExecution() {
+				timedOut.Store(true)
+			}
+		})
+		defer timer.Stop()
+	}
+	start := time.Now()
+	failed := tcr.toolchain.RunTests().Failed()
+	duration := time.Since(start)
+	switch {
+	case timedOut.Load():
+		return mutation.TimedOut, duration
+	case failed:
+		return mutation.Killed, duration
+	default:
+		return mutation.Survived, duration
+	}
+}
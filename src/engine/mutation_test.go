@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/mutation"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/toolchain/command"
+	"github.com/murex/tcr/vcs"
+	"github.com/murex/tcr/vcs/fake"
+	"github.com/stretchr/testify/assert"
+)
+
+const mutationSrc = "package calc\n\nfunc Max(a, b int) int {\n\tif a > b {\n\t\treturn a\n\t}\n\treturn b\n}\n"
+
+// mutantAwareToolchain is a fake toolchain whose build and test results
+// depend on the contents of the mutated source file
+type mutantAwareToolchain struct {
+	*toolchain.FakeToolchain
+	path      string
+	buildFail func(src string) bool
+	testFail  func(src string) bool
+}
+
+func (tchn mutantAwareToolchain) RunBuild() command.Result {
+	return command.Result{Status: tchn.statusFor(tchn.buildFail)}
+}
+
+func (tchn mutantAwareToolchain) RunTests() toolchain.TestCommandResult {
+	return toolchain.TestCommandResult{Result: command.Result{Status: tchn.statusFor(tchn.testFail)}}
+}
+
+func (tchn mutantAwareToolchain) statusFor(fail func(src string) bool) command.Status {
+	src, _ := os.ReadFile(tchn.path)
+	if fail != nil && fail(string(src)) {
+		return command.StatusFail
+	}
+	return command.StatusPass
+}
+
+// loopingMutantToolchain is a fake toolchain whose tests never end with looping mutants,
+// until they are aborted
+type loopingMutantToolchain struct {
+	mutantAwareToolchain
+	loops func(src string) bool
+	abort chan struct{}
+}
+
+func (tchn loopingMutantToolchain) RunTests() toolchain.TestCommandResult {
+	src, _ := os.ReadFile(tchn.path)
+	if tchn.loops(string(src)) {
+		<-tchn.abort
+		return toolchain.TestCommandResult{Result: command.Result{Status: command.StatusFail}}
+	}
+	return tchn.mutantAwareToolchain.RunTests()
+}
+
+func (tchn loopingMutantToolchain) AbortExecution() bool {
+	select {
+	case tchn.abort <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func initTCREngineForMutation(t *testing.T, settings fake.Settings) (*TCREngine, string) {
+	t.Helper()
+	dir, err := os.MkdirTemp("", "tcr-mutate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	path := filepath.Join(dir, "calc.go")
+	if err = os.WriteFile(path, []byte(mutationSrc), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if settings.Logs == nil {
+		settings.Logs = vcs.LogItems{vcs.NewLogItem("abc", time.Now(), "✅ [TCR - PASSED] tests passing")}
+	}
+	settings.CommitFiles = map[string][]string{"abc": {path, filepath.Join(dir, "README.md")}}
+	tcr, _ := initTCREngineWithFakeSettings(nil, nil, settings)
+	return tcr, path
+}
+
+func Test_mutation_run_outcomes(t *testing.T) {
+	mutated := func(src string) bool { return strings.Contains(src, "a <= b") }
+	testFlags := []struct {
+		desc      string
+		buildFail func(src string) bool
+		testFail  func(src string) bool
+		expected  mutation.Score
+	}{
+		{"mutant killed by assertions", nil, mutated, mutation.Score{Killed: 1}},
+		{"mutant surviving", nil, nil, mutation.Score{Survived: 1}},
+		{"mutant not building", mutated, nil, mutation.Score{Stillborn: 1}},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, path := initTCREngineForMutation(t, fake.Settings{})
+			tcr.toolchain = mutantAwareToolchain{
+				toolchain.NewFakeToolchain(nil, toolchain.TestStats{}), path, tt.buildFail, tt.testFail,
+			}
+			assert.Equal(t, tt.expected, tcr.runMutationTests(5, vcs.LogFilter{}))
+			src, _ := os.ReadFile(path)
+			assert.Equal(t, mutationSrc, string(src))
+		})
+	}
+}
+
+func Test_looping_mutant_is_aborted_and_counted_as_killed(t *testing.T) {
+	defaultTimeout := minMutantTimeout
+	minMutantTimeout = 50 * time.Millisecond
+	t.Cleanup(func() { minMutantTimeout = defaultTimeout })
+
+	tcr, path := initTCREngineForMutation(t, fake.Settings{})
+	tcr.toolchain = loopingMutantToolchain{
+		mutantAwareToolchain: mutantAwareToolchain{
+			toolchain.NewFakeToolchain(nil, toolchain.TestStats{}), path, nil, nil,
+		},
+		loops: func(src string) bool { return strings.Contains(src, "a <= b") },
+		abort: make(chan struct{}),
+	}
+	assert.Equal(t, mutation.Score{Killed: 1, TimedOut: 1}, tcr.runMutationTests(5, vcs.LogFilter{}))
+	src, _ := os.ReadFile(path)
+	assert.Equal(t, mutationSrc, string(src))
+}
+
+func Test_mutation_run_is_cancelled(t *testing.T) {
+	testFlags := []struct {
+		desc     string
+		settings fake.Settings
+		testFail func(src string) bool
+	}{
+		{
+			"with local changes",
+			fake.Settings{ChangedFiles: vcs.FileDiffs{vcs.NewFileDiff("some-file", 1, 1)}},
+			nil,
+		},
+		{
+			"with failing diff",
+			fake.Settings{FailingCommands: fake.Commands{fake.DiffCommand}},
+			nil,
+		},
+		{
+			"with no TCR commit",
+			fake.Settings{Logs: vcs.LogItems{vcs.NewLogItem("abc", time.Now(), "some other commit")}},
+			nil,
+		},
+		{
+			"with failing commit files retrieval",
+			fake.Settings{FailingCommands: fake.Commands{fake.CommitFilesCommand}},
+			nil,
+		},
+		{
+			"when tests fail before mutating",
+			fake.Settings{},
+			func(_ string) bool { return true },
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, path := initTCREngineForMutation(t, tt.settings)
+			tcr.toolchain = mutantAwareToolchain{
+				toolchain.NewFakeToolchain(nil, toolchain.TestStats{}), path, nil, tt.testFail,
+			}
+			assert.Equal(t, mutation.Score{}, tcr.runMutationTests(5, vcs.LogFilter{}))
+		})
+	}
+}
+
+func Test_mutation_targets_are_limited_to_last_commits(t *testing.T) {
+	now := time.Now()
+	tcr, path := initTCREngineForMutation(t, fake.Settings{
+		Logs: vcs.LogItems{
+			vcs.NewLogItem("abc", now.Add(-time.Hour), "✅ [TCR - PASSED] tests passing"),
+			vcs.NewLogItem("def", now, "✅ [TCR - PASSED] tests passing"),
+		},
+	})
+	assert.Empty(t, tcr.mutationTargets(1, vcs.LogFilter{}))
+	assert.Equal(t, []string{path}, tcr.mutationTargets(2, vcs.LogFilter{}))
+}
+
+func Test_mutated_file_is_backed_up_while_mutated(t *testing.T) {
+	configDir := t.TempDir()
+	mutation.InitConfig(configDir)
+	t.Cleanup(func() { mutation.InitConfig("") })
+	backupCount := func() int {
+		entries, _ := os.ReadDir(filepath.Join(configDir, "mutation-backup"))
+		return len(entries)
+	}
+
+	tcr, path := initTCREngineForMutation(t, fake.Settings{})
+	var countWhileMutated int
+	tcr.toolchain = mutantAwareToolchain{
+		toolchain.NewFakeToolchain(nil, toolchain.TestStats{}), path, nil,
+		func(src string) bool {
+			if strings.Contains(src, "a <= b") {
+				countWhileMutated = backupCount()
+			}
+			return false
+		},
+	}
+	tcr.runMutationTests(5, vcs.LogFilter{})
+	assert.Equal(t, 1, countWhileMutated)
+	assert.Equal(t, 0, backupCount())
+}
+
+func Test_files_left_mutated_by_an_interrupted_run_are_restored(t *testing.T) {
+	mutation.InitConfig(t.TempDir())
+	t.Cleanup(func() { mutation.InitConfig("") })
+	path := filepath.Join(t.TempDir(), "calc.go")
+	assert.NoError(t, mutation.Backup(path, 0600, []byte(mutationSrc)))
+	assert.NoError(t, os.WriteFile(path, []byte(strings.Replace(mutationSrc, "a > b", "a <= b", 1)), 0600))
+
+	restoreMutatedFiles()
+
+	src, _ := os.ReadFile(path)
+	assert.Equal(t, mutationSrc, string(src))
+	restored, err := mutation.RestoreBackups()
+	assert.NoError(t, err)
+	assert.Empty(t, restored)
+}
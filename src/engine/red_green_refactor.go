@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/variant"
+	"github.com/murex/tcr/vcs"
+)
+
+// nextPhase returns the red-green-refactor phase of the TCR event corresponding
+// to the provided changes and test results. It always returns PhaseNone when the
+// red-green-refactor variant is not selected, or when the changes should be reverted
+func (tcr *TCREngine) nextPhase(diffs vcs.FileDiffs, result toolchain.TestCommandResult) events.Phase {
+	if tcr.variant == nil || *tcr.variant != variant.RedGreenRefactor {
+		return events.PhaseNone
+	}
+	if result.Passed() {
+		if tcr.phase == events.PhaseRed {
+			return events.PhaseGreen
+		}
+		return events.PhaseRefactor
+	}
+	if tcr.phase != events.PhaseRed && tcr.isNewFailingTest(diffs, result) {
+		return events.PhaseRed
+	}
+	return events.PhaseNone
+}
+
+// isNewFailingTest indicates if the provided changes and test results correspond to
+// a freshly added failing test: lines were added to test files, production code is
+// unchanged, and the test report contains at least one failing test
+func (tcr *TCREngine) isNewFailingTest(diffs vcs.FileDiffs, result toolchain.TestCommandResult) bool {
+	var addedTestLines int
+	for _, diff := range diffs {
+		if tcr.isSrcFile(diff.Path) {
+			return false
+		}
+		if tcr.isTestFile(diff.Path) {
+			addedTestLines += diff.AddedLines
+		}
+	}
+	return addedTestLines > 0 && result.Stats.Failed > 0
+}
+
+// commitHeader returns the commit message header to be used for the provided event
+func commitHeader(event events.TCREvent) CommitMessage {
+	if event.Phase == events.PhaseRed {
+		return messageRed
+	}
+	return messagePassed
+}
+
+// restorePhase resumes the red-green-refactor phase recorded in the last TCR commit,
+// so that restarting a session does not lose track of an ongoing red phase
+func (tcr *TCREngine) restorePhase() {
+	if tcr.variant == nil || *tcr.variant != variant.RedGreenRefactor {
+		return
+	}
+	if event, found := tcr.lastTCREvent(); found {
+		tcr.setPhase(event.Phase)
+	}
+}
+
+// setPhase records the red-green-refactor phase of the last commit
+func (tcr *TCREngine) setPhase(phase events.Phase) {
+	if phase == events.PhaseNone || phase == tcr.phase {
+		return
+	}
+	tcr.phase = phase
+	switch phase {
+	case events.PhaseRed:
+		report.PostWarning("Entering red phase: next commit requires all tests to pass")
+	case events.PhaseGreen:
+		report.PostInfo("Entering green phase: time to refactor or add a new failing test")
+	default:
+		report.PostInfo("Entering ", phase, " phase")
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/toolchain/command"
+	"github.com/murex/tcr/variant"
+	"github.com/murex/tcr/vcs"
+	"github.com/murex/tcr/vcs/fake"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_red_green_refactor_next_phase(t *testing.T) {
+	passing := toolchain.TestCommandResult{Result: command.Result{Status: command.StatusPass}}
+	failing := toolchain.TestCommandResult{
+		Result: command.Result{Status: command.StatusFail},
+		Stats:  toolchain.TestStats{TotalRun: 2, Passed: 1, Failed: 1},
+	}
+	newTest := vcs.FileDiffs{vcs.NewFileDiff("fake-test", 3, 0)}
+	testFlags := []struct {
+		desc          string
+		variant       variant.Variant
+		previousPhase events.Phase
+		diffs         vcs.FileDiffs
+		result        toolchain.TestCommandResult
+		expected      events.Phase
+	}{
+		{"other variant", variant.Relaxed, events.PhaseNone, newTest, failing, events.PhaseNone},
+		{"new failing test", variant.RedGreenRefactor, events.PhaseRefactor, newTest, failing, events.PhaseRed},
+		{"new failing test at session start", variant.RedGreenRefactor, events.PhaseNone, newTest, failing, events.PhaseRed},
+		{
+			"failing test with production code changes", variant.RedGreenRefactor, events.PhaseRefactor,
+			vcs.FileDiffs{vcs.NewFileDiff("fake-test", 3, 0), vcs.NewFileDiff("fake-src", 1, 0)},
+			failing, events.PhaseNone,
+		},
+		{
+			"failing test with removed test lines only", variant.RedGreenRefactor, events.PhaseRefactor,
+			vcs.FileDiffs{vcs.NewFileDiff("fake-test", 0, 3)}, failing, events.PhaseNone,
+		},
+		{
+			"failing tests without test stats", variant.RedGreenRefactor, events.PhaseRefactor, newTest,
+			toolchain.TestCommandResult{Result: command.Result{Status: command.StatusFail}}, events.PhaseNone,
+		},
+		{"another failing test while in red phase", variant.RedGreenRefactor, events.PhaseRed, newTest, failing, events.PhaseNone},
+		{"passing tests after red phase", variant.RedGreenRefactor, events.PhaseRed, nil, passing, events.PhaseGreen},
+		{"passing tests after green phase", variant.RedGreenRefactor, events.PhaseGreen, nil, passing, events.PhaseRefactor},
+		{"passing tests after refactor phase", variant.RedGreenRefactor, events.PhaseRefactor, nil, passing, events.PhaseRefactor},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, _ := initTCREngineWithFakes(params.AParamSet(params.WithVariant(tt.variant.Name())), nil, nil, nil)
+			tcr.phase = tt.previousPhase
+			assert.Equal(t, tt.expected, tcr.nextPhase(tt.diffs, tt.result))
+		})
+	}
+}
+
+func Test_red_green_refactor_cycles(t *testing.T) {
+	tcr, vcsFake := initTCREngineWithFakesWithFileDiffs(
+		params.AParamSet(params.WithVariant(variant.RedGreenRefactor.Name())),
+		nil, nil, nil, vcs.FileDiffs{vcs.NewFileDiff("fake-test", 3, 0)})
+
+	// A new failing test is committed as red
+	tcr.toolchain = toolchain.NewFakeToolchain(toolchain.Operations{toolchain.TestOperation},
+		toolchain.TestStats{TotalRun: 1, Failed: 1})
+	tcr.RunTCRCycle()
+	assert.Equal(t, fake.CommitCommand, vcsFake.GetLastCommand())
+	assert.Equal(t, messageRed.toString(true), vcsFake.GetLastCommitSubjects()[0])
+	assert.Equal(t, events.PhaseRed, tcr.phase)
+
+	// Tests must pass on the next commit
+	tcr.RunTCRCycle()
+	assert.NotEqual(t, fake.CommitCommand, vcsFake.GetLastCommand())
+	assert.Equal(t, events.PhaseRed, tcr.phase)
+
+	// Passing tests bring back to green
+	tcr.toolchain = toolchain.NewFakeToolchain(nil, toolchain.TestStats{TotalRun: 1, Passed: 1})
+	tcr.RunTCRCycle()
+	assert.Equal(t, fake.CommitCommand, vcsFake.GetLastCommand())
+	assert.Equal(t, messagePassed.toString(true), vcsFake.GetLastCommitSubjects()[1])
+	assert.Equal(t, events.PhaseGreen, tcr.phase)
+}
+
+func Test_red_commit_is_parsed_as_failing(t *testing.T) {
+	event := *events.ATcrEvent(events.WithModifiedTestLines(3), events.WithPhase(events.PhaseRed))
+	message := messageRed.toString(true) + "\n\n" + event.ToYAML()
+	parsed := parseCommitMessage(message)
+	assert.Equal(t, events.StatusFail, parsed.Status)
+	assert.Equal(t, events.PhaseRed, parsed.Phase)
+	assert.True(t, isTCRCommitMessage(message))
+}
+
+func Test_red_green_refactor_phase_is_restored_from_last_tcr_commit(t *testing.T) {
+	now := time.Now().UTC()
+	commitMessage := func(header CommitMessage, phase events.Phase) string {
+		return header.toString(true) + "\n\n" + events.ATcrEvent(events.WithPhase(phase)).ToYAML()
+	}
+	testFlags := []struct {
+		desc     string
+		variant  variant.Variant
+		logs     vcs.LogItems
+		expected events.Phase
+	}{
+		{"no previous commit", variant.RedGreenRefactor, nil, events.PhaseNone},
+		{
+			"last commit in red phase", variant.RedGreenRefactor,
+			vcs.LogItems{
+				vcs.NewLogItem("1111", now.Add(-time.Minute), commitMessage(messagePassed, events.PhaseGreen)),
+				vcs.NewLogItem("2222", now, commitMessage(messageRed, events.PhaseRed)),
+				vcs.NewLogItem("3333", now.Add(time.Minute), "other commit message"),
+			},
+			events.PhaseRed,
+		},
+		{
+			"last commit in green phase", variant.RedGreenRefactor,
+			vcs.LogItems{
+				vcs.NewLogItem("2222", now, commitMessage(messagePassed, events.PhaseGreen)),
+				vcs.NewLogItem("1111", now.Add(-time.Minute), commitMessage(messageRed, events.PhaseRed)),
+			},
+			events.PhaseGreen,
+		},
+		{
+			"other variant", variant.Relaxed,
+			vcs.LogItems{vcs.NewLogItem("1111", now, commitMessage(messageRed, events.PhaseRed))},
+			events.PhaseNone,
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, _ := initTCREngineWithFakes(params.AParamSet(params.WithVariant(tt.variant.Name())), nil, nil, tt.logs)
+			assert.Equal(t, tt.expected, tcr.phase)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"strings"
+
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/roster"
+	"github.com/murex/tcr/timer"
+)
+
+// setRoster initializes the mob roster with the provided members
+func (tcr *TCREngine) setRoster(members []string) {
+	tcr.roster = roster.New(members...)
+	if tcr.roster.IsEmpty() {
+		return
+	}
+	var names []string
+	for _, m := range tcr.roster.Members() {
+		names = append(names, m.Name)
+	}
+	report.PostInfo("Mob roster is ", strings.Join(names, ", "))
+}
+
+// GetRoster returns the mob roster
+func (tcr *TCREngine) GetRoster() *roster.Roster {
+	if tcr.roster == nil {
+		tcr.roster = roster.New()
+	}
+	return tcr.roster
+}
+
+// announceDriver reports who is driving and who is the next driver
+func (tcr *TCREngine) announceDriver() {
+	driver, ok := tcr.GetRoster().Driver()
+	if !ok {
+		return
+	}
+	if next, ok := tcr.roster.NextDriver(); ok {
+		report.PostInfo(driver.Name, " is driving. Next driver is ", next.Name)
+		return
+	}
+	report.PostInfo(driver.Name, " is driving")
+}
+
+// announceNextDriver reports who is the next driver when driver's turn expires
+func (tcr *TCREngine) announceNextDriver() {
+	if next, ok := tcr.GetRoster().NextDriver(); ok {
+		report.PostWarningWithEmphasis("Next driver is ", next.Name)
+	}
+}
+
+// rotateDriver hands over the driver role to the next roster member
+// when driver's turn has expired
+func (tcr *TCREngine) rotateDriver() {
+	if timer.GetCurrentState(tcr.mobTimer).State != timer.StateTimeout {
+		return
+	}
+	if driver, ok := tcr.GetRoster().Rotate(); ok {
+		report.PostInfo(driver.Name, " is now the driver")
+	}
+}
+
+// driverTrailer returns the commit message trailer recording the current
+// driver as co-author. The returned boolean is false when there is no roster
+func (tcr *TCREngine) driverTrailer() (string, bool) {
+	driver, ok := tcr.GetRoster().Driver()
+	if !ok {
+		return "", false
+	}
+	return driver.CoAuthorTrailer(), true
+}
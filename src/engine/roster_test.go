@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/runmode"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_commit_messages_record_the_current_driver(t *testing.T) {
+	event := events.ATcrEvent()
+	tests := []struct {
+		desc     string
+		template string
+		members  []string
+		expected string
+	}{
+		{"no roster", "default", nil, event.ToYAML()},
+		{"driver with e-mail", "default", []string{"alice <alice@example.com>", "bob"},
+			"Co-authored-by: alice <alice@example.com>"},
+		{"driver without e-mail", "default", []string{"alice", "bob"}, "TCR-Driver: alice"},
+		{"driver with status trailer", "conventional", []string{"alice", "bob"},
+			"TCR-Status: passed\nTCR-Driver: alice"},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := params.AParamSet(params.WithRunMode(runmode.OneShot{}),
+				params.WithMessageTemplate(test.template), params.WithMembers(test.members...))
+			tcr, _ := initTCREngineWithFakes(p, nil, nil, nil)
+			result := tcr.wrapCommitMessages(messagePassed, event)
+			assert.Equal(t, test.expected, result[len(result)-1])
+		})
+	}
+}
+
+func Test_announcing_the_next_driver(t *testing.T) {
+	sniffer := report.NewSniffer(
+		func(msg report.Message) bool {
+			return msg.Type.Category == report.Warning && msg.Type.Emphasis
+		},
+	)
+	p := params.AParamSet(params.WithRunMode(runmode.Mob{}), params.WithMembers("alice", "bob"))
+	tcr, _ := initTCREngineWithFakes(p, nil, nil, nil)
+	tcr.announceNextDriver()
+	sniffer.Stop()
+	assert.Equal(t, 1, sniffer.GetMatchCount())
+	assert.Equal(t, "Next driver is bob", sniffer.GetAllMatches()[0].Payload.ToString())
+}
+
+func Test_driver_is_not_rotated_before_timeout(t *testing.T) {
+	p := params.AParamSet(params.WithRunMode(runmode.Mob{}), params.WithMembers("alice", "bob"))
+	tcr, _ := initTCREngineWithFakes(p, nil, nil, nil)
+	tcr.initTimer()
+	tcr.rotateDriver()
+	driver, _ := tcr.GetRoster().Driver()
+	assert.Equal(t, "alice", driver.Name)
+}
+
+func Test_engine_without_roster(t *testing.T) {
+	tcr := NewTCREngine()
+	assert.True(t, tcr.GetRoster().IsEmpty())
+	_, ok := tcr.driverTrailer()
+	assert.False(t, ok)
+}
@@ -26,19 +26,26 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
+	"text/template"
 	"time"
 
 	"github.com/murex/tcr/checker"
 	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/export"
 	"github.com/murex/tcr/filesystem"
+	"github.com/murex/tcr/hook"
+	"github.com/murex/tcr/journal"
 	"github.com/murex/tcr/language"
+	"github.com/murex/tcr/notification"
 	"github.com/murex/tcr/params"
 	"github.com/murex/tcr/report"
 	"github.com/murex/tcr/report/role_event"
 	"github.com/murex/tcr/retro"
 	"github.com/murex/tcr/role"
+	"github.com/murex/tcr/roster"
 	"github.com/murex/tcr/runmode"
 	"github.com/murex/tcr/settings"
 	"github.com/murex/tcr/stats"
@@ -62,6 +69,8 @@ type (
 		ToggleAutoPush()
 		SetAutoPush(flag bool)
 		SetVariant(name string)
+		SetCommitIntent(intent string)
+		SetMobTimerDuration(duration time.Duration)
 		GetCurrentRole() role.Role
 		RunAsDriver()
 		RunAsNavigator()
@@ -70,7 +79,9 @@ type (
 		AbortCommand()
 		GetSessionInfo() SessionInfo
 		GetMobTimerStatus() timer.CurrentState
+		GetRoster() *roster.Roster
 		SetRunMode(m runmode.RunMode)
+		GetRunMode() runmode.RunMode
 		RunCheck(p params.Params)
 		PrintLog(p params.Params)
 		PrintStats(p params.Params)
@@ -78,26 +89,74 @@ type (
 		VCSPush()
 		Quit()
 		GenerateRetro(p params.Params)
+		Recover(p params.Params)
+		RunMutationTests(p params.Params)
+		GetLog(q HistoryQuery) (export.Exportable, error)
+		GetStats(q HistoryQuery) (export.Stats, error)
+		GetRetro(q HistoryQuery, format string) (string, error)
 	}
 
 	// TCREngine is the engine running all TCR operations
 	TCREngine struct {
-		mode            runmode.RunMode
-		ui              ui.Multicaster
-		vcs             vcs.Interface
-		language        language.LangInterface
-		toolchain       toolchain.TchnInterface
+		mode      runmode.RunMode
+		ui        ui.Multicaster
+		vcs       vcs.Interface
+		language  language.LangInterface
+		toolchain toolchain.TchnInterface
+		// modules are the sub-directories of the base directory built and tested
+		// with a language and a toolchain of their own. Empty unless declared
+		modules         []module
 		sourceTree      filesystem.SourceTree
 		pollingPeriod   time.Duration
 		mobTurnDuration time.Duration
 		mobTimer        *timer.PeriodicReminder
 		currentRole     role.Role
+		// roster keeps track of mob participants and of their driver turns
+		roster *roster.Roster
 		// roleMutex is used to prevent the engine from starting 2 different
 		// roles simultaneously: we wait for it to leave the previous role
 		// before starting a new one
-		roleMutex     sync.Mutex
+		roleMutex sync.Mutex
+		// cycleMutex prevents cycles triggered from outside the driver
+		// loop (ex: through the HTTP API) from overlapping with other cycles
+		cycleMutex    sync.Mutex
 		variant       *variant.Variant
 		messageSuffix string
+		// messageTemplate is used for building commit message headers. Default
+		// TCR commit message headers are used when it's nil
+		messageTemplate *template.Template
+		// commitIntent is the intent provided by the user, made available to commit message templates
+		commitIntent string
+		// phase is the red-green-refactor phase of the last commit, used with red-green-refactor variant
+		phase events.Phase
+		// journal records the events occurring during the session, independently of VCS history
+		journal *journal.Journal
+		// hooks runs the user-provided commands associated to lifecycle hooks
+		hooks *hook.Runner
+		// handover indicates if uncommitted changes are handed over from one
+		// mob workstation to another at the end of each driver turn
+		handover bool
+		// isolatedRuns indicates if build and tests run in a snapshot of the working tree
+		// rather than in the working tree itself
+		isolatedRuns bool
+		// isolatedRunsRequested keeps track of the isolated runs setting, so that
+		// isolated runs can be turned on or off when the variant changes
+		isolatedRunsRequested bool
+		// lintPolicy tells what to do with changes failing the toolchain's lint command
+		lintPolicy variant.LintPolicy
+		// autoFormat indicates if the toolchain's format command runs before each build
+		autoFormat bool
+		// coverageGate indicates if changes lowering line coverage are reverted
+		coverageGate bool
+		// coverageBaseline is the line coverage of the last commit, used by the coverage gate
+		coverageBaseline *events.LineCoverage
+		// testSelection indicates if only the tests affected by the latest changes are run
+		testSelection bool
+		// fullTestRunPeriod is the number of TCR cycles after which all tests are run
+		// when test selection is on
+		fullTestRunPeriod int
+		// affectedTestRuns is the number of consecutive TCR cycles that did not run all tests
+		affectedTestRuns int
 		// shoot channel is used for handling interruptions coming from the UI
 		shoot chan bool
 		// traceReporterWaitingTime is used to prevent trace reporter overflow when
@@ -111,8 +170,6 @@ type (
 	}
 )
 
-const retroFileName = "tcr-retro.md"
-
 const traceReporterWaitingTime = 100 * time.Millisecond
 
 const fsWatchRearmDelay = 100 * time.Millisecond
@@ -152,6 +209,7 @@ func (tcr *TCREngine) Init(p params.Params) {
 	status.RecordState(status.Ok)
 
 	report.PostInfo("Starting ", settings.ApplicationName, " version ", settings.BuildVersion, "...")
+	restoreMutatedFiles()
 
 	tcr.SetRunMode(p.Mode)
 	if !tcr.mode.IsActive() {
@@ -163,31 +221,55 @@ func (tcr *TCREngine) Init(p params.Params) {
 
 	tcr.initSourceTree(p)
 
-	tcr.language, err = language.GetLanguage(p.Language, tcr.sourceTree.GetBaseDir())
-	tcr.handleError(err, true, status.ConfigError)
-	tcr.reportFileStats()
-
-	tcr.toolchain, err = tcr.language.GetToolchain(p.Toolchain)
-	tcr.handleError(err, true, status.ConfigError)
-
 	err = toolchain.SetWorkDir(p.WorkDir)
 	tcr.handleError(err, true, status.ConfigError)
 	report.PostInfo("Work directory is ", toolchain.GetWorkDir())
 
+	if len(p.Modules) > 0 {
+		tcr.initModules(p.Modules)
+	} else {
+		tcr.language, err = language.GetLanguage(p.Language, tcr.sourceTree.GetBaseDir())
+		tcr.handleError(err, true, status.ConfigError)
+		tcr.toolchain, err = tcr.language.GetToolchain(p.Toolchain)
+		tcr.handleError(err, true, status.ConfigError)
+	}
+	tcr.reportFileStats()
+	tcr.setTestSelection(p.TestSelection, p.FullTestRunPeriod)
+
 	tcr.initVCS(p.VCS, p.GitRemote, p.Trace)
 	tcr.setMessageSuffix(p.MessageSuffix)
+	tcr.setMessageTemplate(p.MessageTemplate)
 	tcr.vcs.EnableAutoPush(p.AutoPush)
+	tcr.initJournal()
+	tcr.initNotifications()
+	tcr.hooks = hook.NewRunner(p.Hooks, tcr.sourceTree.GetBaseDir())
 
 	tcr.SetVariant(p.Variant)
-	tcr.setMobTimerDuration(p.MobTurnDuration)
+	tcr.restorePhase()
+	tcr.setLintPolicy(p.LintPolicy)
+	tcr.setAutoFormat(p.AutoFormat)
+	tcr.setCoverageGate(p.CoverageGate)
+	tcr.setIsolatedRuns(p.IsolatedRuns)
+	tcr.SetMobTimerDuration(p.MobTurnDuration)
+	tcr.setRoster(p.Members)
+	tcr.setHandover(p.Handover)
 
 	tcr.ui.ShowRunningMode(tcr.mode)
 	tcr.ui.ShowSessionInfo()
 	tcr.warnIfOnRootBranch(tcr.mode.IsInteractive())
 }
 
-// SetVariant sets the TCR variant that will be used by TCR engine
+// SetVariant sets the TCR variant that will be used by TCR engine.
+// The change applies from the next TCR cycle
 func (tcr *TCREngine) SetVariant(name string) {
+	tcr.cycleMutex.Lock()
+	defer tcr.cycleMutex.Unlock()
+	tcr.selectVariant(name)
+	// Isolated runs depend on the variant in use
+	tcr.setIsolatedRuns(tcr.isolatedRunsRequested)
+}
+
+func (tcr *TCREngine) selectVariant(name string) {
 	var err error
 	tcr.variant, err = variant.Select(name)
 	if err != nil {
@@ -198,7 +280,29 @@ func (tcr *TCREngine) SetVariant(name string) {
 	}
 }
 
-func (tcr *TCREngine) setMobTimerDuration(duration time.Duration) {
+// setIsolatedRuns turns on isolated runs when requested and supported by
+// both the VCS and the TCR variant in use
+func (tcr *TCREngine) setIsolatedRuns(flag bool) {
+	tcr.isolatedRunsRequested = flag
+	tcr.isolatedRuns = false
+	if !flag {
+		return
+	}
+	if !tcr.vcs.SupportsSnapshots() {
+		report.PostWarning("Isolated runs are not supported with ", tcr.vcs.Name(), ": build and tests will run in the working tree")
+		return
+	}
+	if *tcr.variant != variant.Relaxed && *tcr.variant != variant.BTCR {
+		report.PostWarning("Isolated runs are not supported with ", tcr.variant.Name(), " variant: build and tests will run in the working tree")
+		return
+	}
+	tcr.isolatedRuns = true
+	report.PostInfo("Build and tests will run in an isolated snapshot of the working tree")
+}
+
+// SetMobTimerDuration sets the duration of driver turns. When a driver turn
+// is ongoing, the new duration applies from the next one
+func (tcr *TCREngine) SetMobTimerDuration(duration time.Duration) {
 	if settings.EnableMobTimer {
 		if tcr.mode.IsMultiRole() {
 			tcr.mobTurnDuration = duration
@@ -214,9 +318,24 @@ func (*TCREngine) RunCheck(p params.Params) {
 	checker.Run(p)
 }
 
-// PrintLog prints the TCR VCS commit history
+// PrintLog prints the TCR VCS commit history, or the session journal entries
+// when p.FromJournal is set
 func (tcr *TCREngine) PrintLog(p params.Params) {
+	format := tcr.selectFormat(p)
+	if p.FromJournal {
+		entries := tcr.queryJournal(p)
+		if format != export.Text {
+			tcr.export(p.OutputFile, format, export.NewJournal(entries))
+			return
+		}
+		tcr.printJournalLog(entries)
+		return
+	}
 	tcrLogs := tcr.queryVCSLogs(p)
+	if format != export.Text {
+		tcr.export(p.OutputFile, format, tcrLogsToExportLog(tcrLogs))
+		return
+	}
 	report.PostInfo("Printing TCR log for ", tcr.vcs.SessionSummary())
 	for _, log := range tcrLogs {
 		report.PostTitle("commit:    ", log.Hash)
@@ -227,18 +346,111 @@ func (tcr *TCREngine) PrintLog(p params.Params) {
 	}
 }
 
+func (tcr *TCREngine) printJournalLog(entries journal.Entries) {
+	report.PostInfo("Printing TCR journal for ", tcr.vcs.SessionSummary())
+	for _, entry := range entries {
+		report.PostTitle("entry:     ", entry.Type)
+		report.PostInfo("timestamp: ", entry.Timestamp)
+		report.PostInfo("details:   ", entry.Summary())
+		// Giving trace reporter some time to flush its contents
+		time.Sleep(tcr.traceReporterWaitingTime)
+	}
+}
+
 // PrintStats prints the TCR execution stats
 func (tcr *TCREngine) PrintStats(p params.Params) {
+	format := tcr.selectFormat(p)
+	if p.FromJournal {
+		entries := tcr.queryJournal(p)
+		if format != export.Text {
+			tcr.export(p.OutputFile, format, export.NewJournalStats(tcr.vcs.SessionSummary(), entries))
+			return
+		}
+		stats.PrintJournal(tcr.vcs.SessionSummary(), entries)
+		return
+	}
 	tcrLogs := tcr.queryVCSLogs(p)
+	if format != export.Text {
+		tcr.export(p.OutputFile, format, export.NewStats(tcr.vcs.SessionSummary(), tcrLogsToEvents(tcrLogs)))
+		return
+	}
 	stats.Print(tcr.vcs.SessionSummary(), tcrLogsToEvents(tcrLogs))
 }
 
-// GenerateRetro generates a retrospective markdown file template using stats
+// selectFormat returns the output format requested in the provided parameters
+func (tcr *TCREngine) selectFormat(p params.Params) export.Format {
+	format, err := export.Select(p.Format)
+	tcr.handleError(err, true, status.ConfigError)
+	if format == export.Text && p.OutputFile != "" {
+		report.PostWarning("Output file is ignored with ", export.Text, " format")
+	}
+	return format
+}
+
+// export writes the provided data to the provided output file using
+// the provided format, or to the standard output if no file is provided
+func (tcr *TCREngine) export(path string, format export.Format, data export.Exportable) {
+	err := export.WriteToFile(path, format, data)
+	tcr.handleError(err, false, status.OtherError)
+	if err == nil && path != "" {
+		report.PostInfo("Output written to ", path)
+	}
+}
+
+// GenerateRetro generates a retrospective file template using stats.
+// The retrospective is generated in markdown format unless html format is requested
 func (tcr *TCREngine) GenerateRetro(p params.Params) {
-	tcrEvents := tcrLogsToEvents(tcr.queryVCSLogs(p))
-	markdown := retro.GenerateMarkdown(filepath.Base(tcr.vcs.GetRootDir()), &tcrEvents)
-	retroPath := filepath.Join(tcr.sourceTree.GetBaseDir(), retroFileName)
-	filesystem.WriteFile(retroPath, []byte(markdown))
+	format, err := retro.SelectFormat(p.Format)
+	tcr.handleError(err, true, status.ConfigError)
+	var tcrEvents events.TcrEvents
+	if p.FromJournal {
+		tcrEvents = tcr.queryJournal(p).ToTcrEvents()
+	} else {
+		tcrEvents = tcrLogsToEvents(tcr.queryVCSLogs(p))
+	}
+	contents, err := retro.Generate(format, p.Template, filepath.Base(tcr.vcs.GetRootDir()), &tcrEvents)
+	tcr.handleError(err, true, status.ConfigError)
+	retroPath := p.OutputFile
+	if retroPath == "" {
+		retroPath = filepath.Join(tcr.sourceTree.GetBaseDir(), format.FileName())
+	}
+	filesystem.WriteFile(retroPath, []byte(contents))
+}
+
+// Recover lists the changes saved in the VCS recovery area by the shelve variant.
+// When a shelf entry ID is provided, the corresponding changes are re-applied instead
+func (tcr *TCREngine) Recover(p params.Params) {
+	tcr.initSourceTree(p)
+	tcr.initVCS(p.VCS, "", p.Trace)
+
+	if p.ShelfID != "" {
+		report.PostInfo("Recovering shelved changes ", p.ShelfID, " on ", tcr.vcs.SessionSummary())
+		tcr.handleError(tcr.vcs.Unshelve(p.ShelfID), false, status.VCSError)
+		return
+	}
+
+	items, err := tcr.vcs.ListShelved(isShelvedMessage)
+	if err != nil {
+		report.PostError(err)
+	}
+	if len(items) == 0 {
+		report.PostWarning("no shelved changes found in ", tcr.vcs.SessionSummary())
+		return
+	}
+	report.PostInfo("Printing shelved changes for ", tcr.vcs.SessionSummary())
+	for _, item := range items {
+		event := parseCommitMessage(item.Message)
+		report.PostTitle("shelf:     ", item.ID)
+		report.PostInfo("timestamp: ", item.Timestamp)
+		report.PostInfo("changes:   ", event.Changes.Src, " src line(s), ", event.Changes.Test, " test line(s)")
+		report.PostInfo("tests:     ", event.Tests.Failed, " failed out of ", event.Tests.Run)
+		// Giving trace reporter some time to flush its contents
+		time.Sleep(tcr.traceReporterWaitingTime)
+	}
+}
+
+func isShelvedMessage(msg string) bool {
+	return strings.Contains(msg, messageShelved.Tag)
 }
 
 func tcrLogsToEvents(tcrLogs vcs.LogItems) (tcrEvents events.TcrEvents) {
@@ -249,11 +461,19 @@ func tcrLogsToEvents(tcrLogs vcs.LogItems) (tcrEvents events.TcrEvents) {
 	return tcrEvents
 }
 
+func tcrLogsToExportLog(tcrLogs vcs.LogItems) (log export.Log) {
+	log = make(export.Log, 0, len(tcrLogs))
+	for _, item := range tcrLogs {
+		log = append(log, export.NewCommit(item, parseCommitMessage(item.Message)))
+	}
+	return log
+}
+
 func (tcr *TCREngine) queryVCSLogs(p params.Params) vcs.LogItems {
 	tcr.initSourceTree(p)
 	tcr.initVCS(p.VCS, "", p.Trace)
 
-	logs, err := tcr.vcs.Log(isTCRCommitMessage)
+	logs, err := tcr.readVCSLogs(tcr.logFilter(p))
 	if err != nil {
 		report.PostError(err)
 	}
@@ -263,13 +483,104 @@ func (tcr *TCREngine) queryVCSLogs(p params.Params) vcs.LogItems {
 	return logs
 }
 
+// readVCSLogs returns the TCR commits matching the provided filter
+func (tcr *TCREngine) readVCSLogs(filter vcs.LogFilter) (vcs.LogItems, error) {
+	return tcr.vcs.Log(isTCRCommitMessage, filter)
+}
+
+// lastTCREvent returns the TCR event recorded in the most recent TCR commit, if any
+func (tcr *TCREngine) lastTCREvent() (event events.TCREvent, found bool) {
+	logs, err := tcr.readVCSLogs(vcs.LogFilter{})
+	if err != nil || len(logs) == 0 {
+		return event, false
+	}
+	last := logs[0]
+	for _, log := range logs[1:] {
+		if log.Timestamp.After(last.Timestamp) {
+			last = log
+		}
+	}
+	return parseCommitMessage(last.Message), true
+}
+
+// logFilter returns the filter to apply on history based on the provided parameters
+func (tcr *TCREngine) logFilter(p params.Params) vcs.LogFilter {
+	filter, err := newLogFilter(p.Since, p.Until, p.Authors, p.Branches)
+	tcr.handleError(err, true, status.ConfigError)
+	return filter
+}
+
+// newLogFilter returns the filter to apply on history based on the provided criteria
+func newLogFilter(since string, until string, authors []string, branches []string) (vcs.LogFilter, error) {
+	now := time.Now()
+	sinceTime, err := vcs.ParseLogTime(since, now)
+	if err != nil {
+		return vcs.LogFilter{}, err
+	}
+	untilTime, err := vcs.ParseLogUntilTime(until, now)
+	if err != nil {
+		return vcs.LogFilter{}, err
+	}
+	return vcs.LogFilter{
+		Since:    sinceTime,
+		Until:    untilTime,
+		Authors:  authors,
+		Branches: branches,
+	}, nil
+}
+
+func (tcr *TCREngine) queryJournal(p params.Params) (entries journal.Entries) {
+	tcr.initSourceTree(p)
+	tcr.initVCS(p.VCS, "", p.Trace)
+
+	filter := tcr.logFilter(p)
+	if len(filter.Authors) > 0 {
+		report.PostWarning("Author filter is ignored with session journal")
+	}
+	entries, err := tcr.readJournal(filter)
+	if err != nil {
+		report.PostError(err)
+	}
+	if len(entries) == 0 {
+		report.PostWarning("no TCR journal entry found for ", tcr.vcs.SessionSummary())
+	}
+	return entries
+}
+
+// readJournal returns the session journal entries matching the provided filter.
+// Author filter does not apply to session journal entries
+func (tcr *TCREngine) readJournal(filter vcs.LogFilter) (entries journal.Entries, err error) {
+	branches := filter.Branches
+	if len(branches) == 0 {
+		branches = []string{tcr.vcs.GetWorkingBranch()}
+	}
+	var errs []error
+	for _, branch := range branches {
+		branchEntries, readErr := journal.Read(journal.GetFilePath(), tcr.sourceTree.GetBaseDir(), branch)
+		if readErr != nil {
+			errs = append(errs, readErr)
+		}
+		for _, entry := range branchEntries {
+			if filter.IncludesTime(entry.Timestamp) {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	if len(branches) > 1 {
+		slices.SortStableFunc(entries, func(a, b journal.Entry) int {
+			return a.Timestamp.Compare(b.Timestamp)
+		})
+	}
+	return entries, errors.Join(errs...)
+}
+
 func isTCRCommitMessage(msg string) bool {
 	return parseCommitStatus(msg) != events.StatusUnknown
 }
 
 func parseCommitMessage(message string) events.TCREvent {
-	header, event := parseCommitHeaderAndEvents(message)
-	event.Status = parseCommitStatus(header)
+	_, event := parseCommitHeaderAndEvents(message)
+	event.Status = parseCommitStatus(message)
 	return event
 }
 
@@ -307,31 +618,90 @@ func parseCommitHeaderAndEvents(message string) (string, events.TCREvent) {
 	return header, event
 }
 
-func parseCommitStatus(header string) events.CommandStatus {
-	if strings.Contains(header, messagePassed.Tag) {
+// parseCommitStatus returns the status of a TCR commit, retrieved either from
+// the tag in its header, or from its TCR status trailer
+func parseCommitStatus(message string) events.CommandStatus {
+	switch {
+	case messagePassed.matches(message):
 		return events.StatusPass
-	}
-	if strings.Contains(header, messageFailed.Tag) {
+	case messageFailed.matches(message), messageRed.matches(message):
 		return events.StatusFail
+	default:
+		return events.StatusUnknown
 	}
-	return events.StatusUnknown
 }
 
 func (tcr *TCREngine) setMessageSuffix(suffix string) {
 	tcr.messageSuffix = suffix
 }
 
+func (tcr *TCREngine) setMessageTemplate(value string) {
+	var err error
+	tcr.messageTemplate, err = parseMessageTemplate(value)
+	tcr.handleError(err, true, status.ConfigError)
+}
+
+// SetCommitIntent sets the intent made available to commit message templates.
+// An empty intent clears the previous one
+func (tcr *TCREngine) SetCommitIntent(intent string) {
+	tcr.commitIntent = strings.TrimSpace(intent)
+	if tcr.commitIntent == "" {
+		report.PostInfo("Commit intent cleared")
+		return
+	}
+	report.PostInfo("Commit intent set to \"", tcr.commitIntent, "\"")
+}
+
 func (tcr *TCREngine) wrapCommitMessages(header CommitMessage, event *events.TCREvent) []string {
-	messages := []string{header.toString(tcr.vcs.SupportsEmojis())}
+	messages := []string{tcr.buildCommitHeader(header, event)}
 	if event != nil {
 		messages = append(messages, event.ToYAML())
 	}
 	if tcr.messageSuffix != "" {
 		messages = append(messages, "\n"+tcr.messageSuffix)
 	}
+	var trailers []string
+	if tcr.messageTemplate != nil {
+		trailers = append(trailers, statusTrailer(header))
+	}
+	if trailer, ok := tcr.driverTrailer(); ok {
+		trailers = append(trailers, trailer)
+	}
+	if len(trailers) > 0 {
+		messages = append(messages, strings.Join(trailers, "\n"))
+	}
 	return messages
 }
 
+// buildCommitHeader returns the first line of the commit message, built from
+// the commit message template when there is one
+func (tcr *TCREngine) buildCommitHeader(header CommitMessage, event *events.TCREvent) string {
+	if tcr.messageTemplate == nil {
+		return header.toString(tcr.vcs.SupportsEmojis())
+	}
+	data := CommitMessageData{
+		Kind:        header.Kind,
+		Emoji:       string(header.Emoji),
+		Tag:         header.Tag,
+		Description: header.Description,
+		Branch:      tcr.vcs.GetWorkingBranch(),
+		Intent:      tcr.commitIntent,
+	}
+	if event != nil {
+		data.Event = *event
+	}
+	data.Type = conventionalType(header, data.Event)
+	if tcr.currentRole != nil {
+		data.Role = tcr.currentRole.Name()
+	}
+	result, err := applyMessageTemplate(tcr.messageTemplate, data)
+	if err != nil {
+		report.PostWarning(err)
+		return header.toString(tcr.vcs.SupportsEmojis())
+	}
+	return result
+}
+
 func (tcr *TCREngine) initVCS(vcsName string, remoteName string, trace string) {
 	if tcr.vcs != nil {
 		return // VCS should be initialized only once
@@ -349,11 +719,34 @@ func (tcr *TCREngine) initVCS(vcsName string, remoteName string, trace string) {
 	}
 }
 
+func (tcr *TCREngine) initJournal() {
+	tcr.journal = journal.New(journal.GetFilePath(), tcr.sourceTree.GetBaseDir(), tcr.vcs.GetWorkingBranch())
+}
+
+// initNotifications starts the notification sinks found in TCR configuration directory
+func (*TCREngine) initNotifications() {
+	if count, _ := notification.Start(); count > 0 {
+		report.PostInfo("Sending notifications to ", count, " sink(s)")
+	}
+}
+
+// record appends the provided entry to the session journal. Failing to do so
+// is reported without interrupting TCR operations
+func (tcr *TCREngine) record(entry journal.Entry) {
+	if err := tcr.journal.Record(entry); err != nil {
+		report.PostWarning("Cannot record into session journal: ", err)
+	}
+}
+
 func (tcr *TCREngine) initSourceTree(p params.Params) {
 	var err error
 	tcr.sourceTree, err = filesystem.New(p.BaseDir)
 	tcr.handleError(err, true, status.ConfigError)
 	report.PostInfo("Base directory is ", tcr.sourceTree.GetBaseDir())
+	tcr.sourceTree.SetWatchSettings(filesystem.WatchSettings{
+		Debounce:      p.WatchDebounce,
+		PollingPeriod: p.WatchPollingPeriod,
+	})
 }
 
 func (tcr *TCREngine) setVCS(vcsInterface vcs.Interface) {
@@ -389,6 +782,8 @@ func (tcr *TCREngine) SetAutoPush(ap bool) {
 func (tcr *TCREngine) resetCurrentRole() {
 	if tcr.currentRole != nil {
 		report.PostRoleEvent(role_event.TriggerEnd, tcr.currentRole)
+		tcr.record(journal.Entry{Type: journal.RoleEnd, Role: tcr.currentRole.Name()})
+		tcr.runRoleChangeHook(role_event.TriggerEnd, tcr.currentRole)
 		tcr.currentRole = nil
 	}
 	tcr.roleMutex.Unlock()
@@ -407,6 +802,8 @@ func (tcr *TCREngine) setCurrentRole(r role.Role) {
 	if r != tcr.currentRole {
 		tcr.currentRole = r
 		report.PostRoleEvent(role_event.TriggerStart, tcr.currentRole)
+		tcr.record(journal.Entry{Type: journal.RoleStart, Role: tcr.currentRole.Name()})
+		tcr.runRoleChangeHook(role_event.TriggerStart, tcr.currentRole)
 	}
 }
 
@@ -430,7 +827,9 @@ func (tcr *TCREngine) RunAsDriver() {
 			// the goroutine waits until currenRole is reset
 			// prior to starting driver role
 			tcr.setCurrentRole(role.Driver{})
+			tcr.announceDriver()
 			tcr.handleError(tcr.vcs.Pull(), false, status.VCSError)
+			tcr.pullHandover()
 			tcr.startTimer()
 		},
 		func(interrupt <-chan bool) bool {
@@ -444,6 +843,7 @@ func (tcr *TCREngine) RunAsDriver() {
 			return false
 		},
 		func() {
+			tcr.pushHandover()
 			tcr.stopTimer()
 			tcr.resetCurrentRole()
 		},
@@ -515,8 +915,7 @@ func (tcr *TCREngine) fromBirthTillDeath(
 }
 
 func (tcr *TCREngine) waitForChange(interrupt <-chan bool) bool {
-	languageDirs := tcr.language.DirsToWatch(tcr.sourceTree.GetBaseDir())
-	existingDirs, err := language.ExistingDirsIn(languageDirs)
+	existingDirs, err := language.ExistingDirsIn(tcr.dirsToWatch())
 	if err != nil {
 		tcr.handleError(err, true, status.OtherError)
 	}
@@ -527,44 +926,260 @@ func (tcr *TCREngine) waitForChange(interrupt <-chan bool) bool {
 
 	return tcr.sourceTree.Watch(
 		existingDirs,
-		tcr.language.IsLanguageFile,
+		tcr.isLanguageFile,
 		interrupt)
 }
 
 // RunTCRCycle is the core of TCR engine: e.g. it runs one test && commit || revert cycle
 func (tcr *TCREngine) RunTCRCycle() {
+	tcr.cycleMutex.Lock()
+	defer tcr.cycleMutex.Unlock()
+	if tcr.isolatedRuns {
+		tcr.runIsolatedTCRCycles()
+		return
+	}
 	status.RecordState(status.Ok)
-	if tcr.build().Failed() {
+	if !tcr.runPreBuildHook() {
 		return
 	}
-	result := tcr.test()
-	event := tcr.createTCREvent(result)
-	if result.Passed() {
-		tcr.commit(event)
-	} else {
+	tcr.format()
+	modules := tcr.pendingModules()
+	if tcr.build(modules).Failed() {
+		return
+	}
+	var affected []string
+	if tcr.testSelection {
+		diffs, err := tcr.vcs.Diff()
+		if err != nil {
+			report.PostWarning(err)
+		}
+		affected = tcr.selectTests(diffs)
+	}
+	result := tcr.test(modules, affected)
+	event, diffs := tcr.createTCREvent(result)
+	tcr.recordTestEvent(event)
+	tcr.publishCycle(event, diffs)
+	tcr.runHook(hook.PostTest, event)
+	if event.Status != events.StatusPass && event.Phase != events.PhaseRed {
+		tcr.revert(event)
+		return
+	}
+	switch tcr.applyLintPolicy(tcr.lint()) {
+	case lintRevert:
 		tcr.revert(event)
+	case lintCommit:
+		if tcr.runPreCommitHook(event) {
+			tcr.commit(event)
+		}
 	}
 }
 
 // AbortCommand triggers interruption of an ongoing TCR cycle operation
 func (tcr *TCREngine) AbortCommand() {
-	_ = tcr.toolchain.AbortExecution()
+	if tcr.toolchain.AbortExecution() {
+		tcr.record(journal.Entry{Type: journal.Abort})
+	}
+}
+
+// runIsolatedTCRCycles runs TCR cycles in snapshots of the working tree, until
+// there is no more change pending from edits done while a cycle was running
+func (tcr *TCREngine) runIsolatedTCRCycles() {
+	for tcr.runIsolatedTCRCycle() {
+		report.PostInfo("Changes were made while running the previous cycle: starting a new one")
+	}
+}
+
+// runIsolatedTCRCycle runs one test && commit || revert cycle in a snapshot of the
+// working tree. Only the snapshot contents are committed or reverted, so that the
+// working tree can keep on being edited while build and tests are running.
+// Returns true if there are changes pending for the next cycle
+func (tcr *TCREngine) runIsolatedTCRCycle() (pending bool) {
+	status.RecordState(status.Ok)
+	if !tcr.runPreBuildHook() {
+		return false
+	}
+	tcr.format()
+	snapshot, err := tcr.vcs.TakeSnapshot()
+	if err != nil {
+		tcr.handleError(err, false, status.VCSError)
+		return false
+	}
+	defer tcr.dropSnapshot(snapshot)
+
+	var built bool
+	var result toolchain.TestCommandResult
+	var modules []module
+	if len(tcr.modules) > 0 {
+		modules = tcr.affectedModules(snapshot.Diffs)
+	}
+	err = runInSnapshot(snapshot, func() {
+		if built = tcr.build(modules).Passed(); built {
+			var affected []string
+			for _, test := range tcr.selectTests(snapshot.Diffs) {
+				affected = append(affected, snapshot.MapPath(test))
+			}
+			result = tcr.test(modules, affected)
+		}
+	})
+	if err != nil {
+		tcr.handleError(err, false, status.OtherError)
+		return false
+	}
+
+	if built {
+		event := tcr.newTCREvent(snapshot.Diffs, result)
+		tcr.recordTestEvent(event)
+		tcr.publishCycle(event, snapshot.Diffs)
+		tcr.runHook(hook.PostTest, event)
+		if event.Status != events.StatusPass {
+			tcr.revertSnapshotAndRunHook(snapshot, event)
+			return tcr.hasPendingChanges(snapshot)
+		}
+		var linted bool
+		if err = runInSnapshot(snapshot, func() { linted = tcr.lint() }); err != nil {
+			tcr.handleError(err, false, status.OtherError)
+			return false
+		}
+		switch tcr.applyLintPolicy(linted) {
+		case lintRevert:
+			tcr.revertSnapshotAndRunHook(snapshot, event)
+		case lintCommit:
+			if tcr.runPreCommitHook(event) {
+				tcr.commitSnapshot(snapshot, event)
+			}
+		}
+	}
+	return tcr.hasPendingChanges(snapshot)
+}
+
+// runInSnapshot runs the provided function with toolchain work directory
+// pointing to its counterpart in the snapshot
+func runInSnapshot(snapshot *vcs.Snapshot, f func()) error {
+	workDir := toolchain.GetWorkDir()
+	err := toolchain.SetWorkDir(snapshot.MapPath(workDir))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = toolchain.SetWorkDir(workDir) }()
+	f()
+	return nil
+}
+
+func (tcr *TCREngine) commitSnapshot(snapshot *vcs.Snapshot, event events.TCREvent) {
+	report.PostInfo("Committing snapshot changes on ", tcr.vcs.SessionSummary())
+	err := tcr.vcs.CommitSnapshot(snapshot, tcr.wrapCommitMessages(messagePassed, &event)...)
+	tcr.handleError(err, false, status.VCSError)
+	if err != nil {
+		return
+	}
+	tcr.recordCommitEvent(event)
+	tcr.updateCoverageBaseline(event)
+	tcr.runHook(hook.PostCommit, event)
+	tcr.handleError(tcr.vcsPushAuto(), false, status.VCSError)
+}
+
+// revertSnapshotAndRunHook reverts the files that were tested in the snapshot,
+// then runs post-revert hook if the revert succeeded
+func (tcr *TCREngine) revertSnapshotAndRunHook(snapshot *vcs.Snapshot, event events.TCREvent) {
+	var err error
+	tcr.sourceTree.Rewrite(func() { err = tcr.revertSnapshot(snapshot) })
+	tcr.handleError(err, false, status.VCSError)
+	if err == nil {
+		tcr.runHook(hook.PostRevert, event)
+	}
+}
+
+// revertSnapshot reverts the files that were tested in the snapshot.
+// Files edited since the snapshot was taken are kept for the next cycle
+func (tcr *TCREngine) revertSnapshot(snapshot *vcs.Snapshot) error {
+	var reverted, kept int
+	for _, diff := range snapshot.Diffs {
+		if !tcr.shouldRevertFile(diff.Path) {
+			continue
+		}
+		if !snapshot.IsUnchanged(diff.Path) {
+			kept++
+			continue
+		}
+		err := tcr.revertFile(diff.Path)
+		if err != nil {
+			return err
+		}
+		reverted++
+	}
+	if reverted > 0 {
+		report.PostWarning(reverted, " file(s) reverted")
+		tcr.record(journal.Entry{Type: journal.Revert, Files: reverted})
+	}
+	if kept > 0 {
+		report.PostWarning(kept, " file(s) edited while tests were running kept for the next cycle")
+	}
+	if reverted+kept == 0 {
+		report.PostInfo(tcr.noFilesRevertedMessage())
+	}
+	return nil
+}
+
+// dropSnapshot removes the snapshot. Errors are reported without
+// altering the status of the cycle that ran in the snapshot
+func (tcr *TCREngine) dropSnapshot(snapshot *vcs.Snapshot) {
+	if err := tcr.vcs.DropSnapshot(snapshot); err != nil {
+		report.PostWarning(err)
+	}
+}
+
+// hasPendingChanges indicates if some language files were changed
+// in the working tree since the snapshot was taken
+func (tcr *TCREngine) hasPendingChanges(snapshot *vcs.Snapshot) bool {
+	diffs, err := tcr.vcs.Diff()
+	if err != nil {
+		report.PostWarning(err)
+		return false
+	}
+	for _, diff := range diffs {
+		if tcr.isLanguageFile(diff.Path) && !snapshot.IsUnchanged(diff.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (tcr *TCREngine) recordTestEvent(event events.TCREvent) {
+	tcr.record(journal.Entry{
+		Type:    journal.Test,
+		Status:  event.Status,
+		Changes: journal.NewChangedLines(event.Changes),
+		Tests:   journal.NewTestStats(event.Tests),
+	})
 }
 
-func (tcr *TCREngine) createTCREvent(testResult toolchain.TestCommandResult) (event events.TCREvent) {
+func (tcr *TCREngine) recordCommitEvent(event events.TCREvent) {
+	tcr.record(journal.Entry{
+		Type:    journal.Commit,
+		Status:  event.Status,
+		Changes: journal.NewChangedLines(event.Changes),
+		Phase:   event.Phase,
+	})
+}
+
+func (tcr *TCREngine) createTCREvent(testResult toolchain.TestCommandResult) (events.TCREvent, vcs.FileDiffs) {
 	diffs, err := tcr.vcs.Diff()
 	if err != nil {
 		report.PostWarning(err)
 	}
+	return tcr.newTCREvent(diffs, testResult), diffs
+}
+
+func (tcr *TCREngine) newTCREvent(diffs vcs.FileDiffs, testResult toolchain.TestCommandResult) events.TCREvent {
 	commandStatus := events.StatusFail
 	if testResult.Passed() {
 		commandStatus = events.StatusPass
 	}
-	return events.NewTCREvent(
+	event := events.NewTCREvent(
 		commandStatus,
 		events.NewChangedLines(
-			diffs.ChangedLines(tcr.language.IsSrcFile),
-			diffs.ChangedLines(tcr.language.IsTestFile),
+			diffs.ChangedLines(tcr.isSrcFile),
+			diffs.ChangedLines(tcr.isTestFile),
 		),
 		events.NewTestStats(
 			testResult.Stats.TotalRun,
@@ -575,21 +1190,48 @@ func (tcr *TCREngine) createTCREvent(testResult toolchain.TestCommandResult) (ev
 			testResult.Stats.Duration,
 		),
 	)
+	event.Phase = tcr.nextPhase(diffs, testResult)
+	if testResult.Coverage != nil {
+		event.Coverage = events.NewLineCoverage(testResult.Coverage.Covered, testResult.Coverage.Total)
+	}
+	tcr.applyCoverageGate(&event)
+	return event
 }
 
-func (tcr *TCREngine) build() (result command.Result) {
+// build runs the build of the provided modules, or of the whole session if none is provided
+func (tcr *TCREngine) build(modules []module) (result command.Result) {
 	report.PostInfo("Launching Build")
-	result = tcr.toolchain.RunBuild()
+	start := time.Now()
+	if len(modules) > 0 {
+		result = buildModules(modules)
+	} else {
+		result = tcr.toolchain.RunBuild()
+	}
+	entry := journal.Entry{Type: journal.Build, Status: events.StatusPass, Duration: time.Since(start)}
 	if result.Failed() {
+		entry.Status = events.StatusFail
 		status.RecordState(status.BuildFailed)
 		report.PostWarningWithEmphasis(buildFailureMessage)
 	}
+	tcr.record(entry)
 	return result
 }
 
-func (tcr *TCREngine) test() (result toolchain.TestCommandResult) {
-	report.PostInfo("Running Tests")
-	result = tcr.toolchain.RunTests()
+// test runs the tests of the provided modules, or the provided test files, or all tests
+// if none is provided
+func (tcr *TCREngine) test(modules []module, affectedTests []string) (result toolchain.TestCommandResult) {
+	if len(modules) > 0 {
+		report.PostInfo("Running Tests (", len(modules), " affected module(s))")
+		result = testModules(modules)
+	} else if len(affectedTests) > 0 {
+		report.PostInfo("Running Tests (", len(affectedTests), " affected test file(s))")
+		result = tcr.toolchain.RunAffectedTests(affectedTests)
+		// Coverage of a partial test run cannot be compared with the one of a full run
+		result.Coverage = nil
+	} else {
+		report.PostInfo("Running Tests")
+		result = tcr.toolchain.RunTests()
+	}
 	if result.Failed() {
 		status.RecordState(status.TestFailed)
 		report.PostErrorWithEmphasis(testFailureMessage)
@@ -607,25 +1249,36 @@ func (tcr *TCREngine) commit(event events.TCREvent) {
 	if err != nil {
 		return
 	}
-	err = tcr.vcs.Commit(tcr.wrapCommitMessages(messagePassed, &event)...)
+	err = tcr.vcs.Commit(tcr.wrapCommitMessages(commitHeader(event), &event)...)
 	tcr.handleError(err, false, status.VCSError)
 	if err != nil {
 		return
 	}
+	tcr.recordCommitEvent(event)
+	tcr.setPhase(event.Phase)
+	tcr.updateCoverageBaseline(event)
+	tcr.runHook(hook.PostCommit, event)
 	tcr.handleError(tcr.vcsPushAuto(), false, status.VCSError)
 }
 
 func (tcr *TCREngine) revert(e events.TCREvent) {
 	var err error
 
-	switch *tcr.variant {
-	case variant.Introspective:
-		err = tcr.introspectiveRevert(e)
-	default:
-		err = tcr.simpleRevert()
-	}
+	tcr.sourceTree.Rewrite(func() {
+		switch *tcr.variant {
+		case variant.Introspective:
+			err = tcr.introspectiveRevert(e)
+		case variant.Shelve:
+			err = tcr.shelveRevert(e)
+		default:
+			err = tcr.simpleRevert()
+		}
+	})
 
 	tcr.handleError(err, false, status.VCSError)
+	if err == nil {
+		tcr.runHook(hook.PostRevert, e)
+	}
 }
 
 func (tcr *TCREngine) simpleRevert() error {
@@ -645,6 +1298,7 @@ func (tcr *TCREngine) simpleRevert() error {
 	}
 	if reverted > 0 {
 		report.PostWarning(reverted, " file(s) reverted")
+		tcr.record(journal.Entry{Type: journal.Revert, Files: reverted})
 	} else {
 		report.PostInfo(tcr.noFilesRevertedMessage())
 	}
@@ -665,18 +1319,48 @@ func (tcr *TCREngine) introspectiveRevert(event events.TCREvent) (err error) {
 		return err
 	}
 	err = tcr.vcs.Commit(tcr.wrapCommitMessages(messageReverted, nil)...)
-	return err
+	if err != nil {
+		return err
+	}
+	tcr.record(journal.Entry{Type: journal.Revert, Changes: journal.NewChangedLines(event.Changes)})
+	return nil
+}
+
+// shelveRevert saves source file changes into the VCS recovery area
+// before reverting them, so that they can be recovered later on
+func (tcr *TCREngine) shelveRevert(event events.TCREvent) error {
+	diffs, err := tcr.vcs.Diff()
+	if err != nil {
+		return err
+	}
+	var paths []string
+	for _, diff := range diffs {
+		if tcr.shouldRevertFile(diff.Path) {
+			paths = append(paths, diff.Path)
+		}
+	}
+	if len(paths) == 0 {
+		report.PostInfo(tcr.noFilesRevertedMessage())
+		return nil
+	}
+	err = tcr.vcs.Shelve(paths, tcr.wrapCommitMessages(messageShelved, &event)...)
+	if err != nil {
+		return err
+	}
+	report.PostWarning(len(paths), " file(s) reverted (shelved changes can be recovered with \"tcr recover\")")
+	tcr.record(journal.Entry{Type: journal.Revert, Files: len(paths)})
+	return nil
 }
 
 func (tcr *TCREngine) noFilesRevertedMessage() string {
-	if *tcr.variant == variant.Relaxed {
+	if *tcr.variant == variant.Relaxed || *tcr.variant == variant.Shelve || *tcr.variant == variant.RedGreenRefactor {
 		return "No file reverted (only test files were updated since last commit)"
 	}
 	return "No file reverted"
 }
 
 func (tcr *TCREngine) shouldRevertFile(path string) bool {
-	return *tcr.variant == variant.BTCR || tcr.language.IsSrcFile(path)
+	return *tcr.variant == variant.BTCR || tcr.isSrcFile(path)
 }
 
 func (tcr *TCREngine) revertFile(file string) error {
@@ -686,11 +1370,15 @@ func (tcr *TCREngine) revertFile(file string) error {
 // GetSessionInfo provides the information related to the current TCR session.
 // Used mainly by the user interface packages to retrieve and display this information
 func (tcr *TCREngine) GetSessionInfo() SessionInfo {
+	languageName, toolchainName := tcr.language.GetName(), tcr.toolchain.GetName()
+	if len(tcr.modules) > 0 {
+		languageName, toolchainName = tcr.modulesLanguageNames(), tcr.modulesToolchainNames()
+	}
 	return SessionInfo{
 		BaseDir:           tcr.sourceTree.GetBaseDir(),
 		WorkDir:           toolchain.GetWorkDir(),
-		LanguageName:      tcr.language.GetName(),
-		ToolchainName:     tcr.toolchain.GetName(),
+		LanguageName:      languageName,
+		ToolchainName:     toolchainName,
 		VCSName:           tcr.vcs.Name(),
 		VCSSessionSummary: tcr.vcs.SessionSummary(),
 		GitAutoPush:       tcr.vcs.IsAutoPushEnabled(),
@@ -701,19 +1389,22 @@ func (tcr *TCREngine) GetSessionInfo() SessionInfo {
 
 func (tcr *TCREngine) initTimer() {
 	if settings.EnableMobTimer {
-		tcr.mobTimer = timer.NewMobTurnCountdown(tcr.mode, tcr.mobTurnDuration)
+		tcr.mobTimer = timer.NewMobTurnCountdown(tcr.mode, tcr.mobTurnDuration, tcr.announceNextDriver)
 	}
 }
 
 func (tcr *TCREngine) startTimer() {
 	if settings.EnableMobTimer && tcr.mobTimer != nil {
 		tcr.mobTimer.Start()
+		tcr.record(journal.Entry{Type: journal.TimerStart, Duration: tcr.mobTurnDuration})
 	}
 }
 
 func (tcr *TCREngine) stopTimer() {
 	if settings.EnableMobTimer && tcr.mobTimer != nil {
+		tcr.rotateDriver()
 		tcr.mobTimer.Stop()
+		tcr.record(journal.Entry{Type: journal.TimerStop, Duration: tcr.mobTimer.GetElapsedTime()})
 		tcr.mobTimer = nil
 	}
 }
@@ -728,6 +1419,11 @@ func (tcr *TCREngine) SetRunMode(m runmode.RunMode) {
 	tcr.mode = m
 }
 
+// GetRunMode returns the run mode of the TCR engine
+func (tcr *TCREngine) GetRunMode() runmode.RunMode {
+	return tcr.mode
+}
+
 // Quit is the exit point for TCR application
 func (*TCREngine) Quit() {
 	report.PostInfo("That's All Folks!")
@@ -775,13 +1471,23 @@ func (tcr *TCREngine) vcsPushAuto() error {
 
 // reportFileStats traces summary information about the source and test files and directories
 func (tcr *TCREngine) reportFileStats() {
-	srcFileCount := countFiles("source", tcr.language.AllSrcFiles)
-	testFileCount := countFiles("test", tcr.language.AllTestFiles)
+	if len(tcr.modules) == 0 {
+		reportLanguageFileStats(tcr.language, "")
+		return
+	}
+	for _, m := range tcr.modules {
+		reportLanguageFileStats(m.Language, " in module "+m.Name)
+	}
+}
+
+func reportLanguageFileStats(lang language.LangInterface, location string) {
+	srcFileCount := countFiles("source", lang.AllSrcFiles)
+	testFileCount := countFiles("test", lang.AllTestFiles)
 	if srcFileCount+testFileCount == 0 {
-		report.PostWarning("No matching ", tcr.language.GetName(), " file found")
+		report.PostWarning("No matching ", lang.GetName(), " file found", location)
 	} else {
 		report.PostInfo("Found ", srcFileCount, " source and ",
-			testFileCount, " test file(s) for ", tcr.language.GetName(), " language")
+			testFileCount, " test file(s) for ", lang.GetName(), " language", location)
 	}
 }
 
@@ -25,11 +25,13 @@ package engine
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/journal"
 	"github.com/murex/tcr/language"
 	"github.com/murex/tcr/params"
 	"github.com/murex/tcr/report"
@@ -51,6 +53,7 @@ const (
 	passedCommitMessage   = "✅ [TCR - PASSED] tests passing" //nolint:gosec
 	failedCommitMessage   = "❌ [TCR - FAILED] tests failing"
 	revertedCommitMessage = "⏪ [TCR - REVERTED] revert changes"
+	shelvedCommitMessage  = "📦 [TCR - SHELVED] tests failing"
 )
 
 func Test_tcr_command_end_state(t *testing.T) {
@@ -64,7 +67,7 @@ func Test_tcr_command_end_state(t *testing.T) {
 			"build with no failure",
 			func() command.Result {
 				tcr, _ := initTCREngineWithFakes(nil, nil, nil, nil)
-				return tcr.build()
+				return tcr.build(nil)
 			},
 			command.StatusPass, status.Ok,
 		},
@@ -72,7 +75,7 @@ func Test_tcr_command_end_state(t *testing.T) {
 			"build with failure",
 			func() command.Result {
 				tcr, _ := initTCREngineWithFakes(nil, toolchain.Operations{toolchain.BuildOperation}, nil, nil)
-				return tcr.build()
+				return tcr.build(nil)
 			},
 			command.StatusFail, status.BuildFailed,
 		},
@@ -80,7 +83,7 @@ func Test_tcr_command_end_state(t *testing.T) {
 			"test with no failure",
 			func() command.Result {
 				tcr, _ := initTCREngineWithFakes(nil, nil, nil, nil)
-				result := tcr.test()
+				result := tcr.test(nil, nil)
 				return result.Result
 			},
 			command.StatusPass, status.Ok,
@@ -89,7 +92,7 @@ func Test_tcr_command_end_state(t *testing.T) {
 			"test with failure",
 			func() command.Result {
 				tcr, _ := initTCREngineWithFakes(nil, toolchain.Operations{toolchain.TestOperation}, nil, nil)
-				result := tcr.test()
+				result := tcr.test(nil, nil)
 				return result.Result
 			},
 			command.StatusFail, status.TestFailed,
@@ -162,8 +165,8 @@ func Test_tcr_reports_and_emphasises(t *testing.T) {
 			sniffer := report.NewSniffer(tt.isExpectedMessage)
 
 			tcr, _ := initTCREngineWithFakes(nil, toolchain.Operations{tt.failAt}, nil, nil)
-			tcr.build()
-			tcr.test()
+			tcr.build(nil)
+			tcr.test(nil, nil)
 			sniffer.Stop()
 
 			assert.Equal(t, 1, sniffer.GetMatchCount())
@@ -344,6 +347,233 @@ func Test_introspective_variant(t *testing.T) {
 	}, vcsFake.GetLastCommands(4))
 }
 
+func Test_shelve_variant(t *testing.T) {
+	testFlags := []struct {
+		desc             string
+		fileDiffs        vcs.FileDiffs
+		expectedCommands []fake.Command
+		expectedMessage  string
+	}{
+		{
+			desc: "source files are shelved",
+			fileDiffs: vcs.FileDiffs{
+				vcs.NewFileDiff("fake-src", 1, 1),
+				vcs.NewFileDiff("fake-test", 1, 1),
+			},
+			expectedCommands: []fake.Command{fake.DiffCommand, fake.ShelveCommand},
+			expectedMessage:  "1 file(s) reverted (shelved changes can be recovered with \"tcr recover\")",
+		},
+		{
+			desc: "nothing is shelved when only test files changed",
+			fileDiffs: vcs.FileDiffs{
+				vcs.NewFileDiff("fake-test", 1, 1),
+			},
+			expectedCommands: []fake.Command{fake.DiffCommand},
+			expectedMessage:  "No file reverted (only test files were updated since last commit)",
+		},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			sniffer := report.NewSniffer(
+				func(msg report.Message) bool {
+					return msg.Payload.ToString() == tt.expectedMessage
+				},
+			)
+			tcr, vcsFake := initTCREngineWithFakesWithFileDiffs(
+				params.AParamSet(params.WithVariant(variant.Shelve.Name())),
+				nil, nil, nil, tt.fileDiffs)
+			tcr.revert(*events.ATcrEvent())
+			sniffer.Stop()
+			assert.Equal(t, tt.expectedCommands, vcsFake.GetLastCommands(len(tt.expectedCommands)))
+			assert.Equal(t, 1, sniffer.GetMatchCount())
+		})
+	}
+}
+
+func Test_isolated_runs_activation(t *testing.T) {
+	testFlags := []struct {
+		desc              string
+		isolatedRuns      bool
+		variant           variant.Variant
+		supportsSnapshots bool
+		expected          bool
+	}{
+		{"isolated runs off", false, variant.Relaxed, true, false},
+		{"isolated runs on with relaxed variant", true, variant.Relaxed, true, true},
+		{"isolated runs on with btcr variant", true, variant.BTCR, true, true},
+		{"isolated runs on with introspective variant", true, variant.Introspective, true, false},
+		{"isolated runs on with shelve variant", true, variant.Shelve, true, false},
+		{"isolated runs on with vcs not supporting snapshots", true, variant.Relaxed, false, false},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, vcsFake := initTCREngineWithFakes(
+				params.AParamSet(params.WithVariant(tt.variant.Name())),
+				nil, nil, nil)
+			vcsFake.SetSupportsSnapshots(tt.supportsSnapshots)
+			tcr.setIsolatedRuns(tt.isolatedRuns)
+			assert.Equal(t, tt.expected, tcr.isolatedRuns)
+		})
+	}
+}
+
+func Test_changing_variant_updates_isolated_runs(t *testing.T) {
+	tcr, vcsFake := initTCREngineWithFakes(
+		params.AParamSet(params.WithVariant(variant.Relaxed.Name())),
+		nil, nil, nil)
+	vcsFake.SetSupportsSnapshots(true)
+	tcr.setIsolatedRuns(true)
+	assert.True(t, tcr.isolatedRuns)
+
+	tcr.SetVariant(variant.Shelve.Name())
+	assert.False(t, tcr.isolatedRuns)
+
+	tcr.SetVariant(variant.BTCR.Name())
+	assert.True(t, tcr.isolatedRuns)
+}
+
+func Test_changing_variant_waits_for_ongoing_cycle(t *testing.T) {
+	tcr, _ := initTCREngineWithFakes(
+		params.AParamSet(params.WithVariant(variant.Relaxed.Name())),
+		nil, nil, nil)
+	tcr.cycleMutex.Lock()
+	done := make(chan bool)
+	go func() {
+		tcr.SetVariant(variant.BTCR.Name())
+		done <- true
+	}()
+	select {
+	case <-done:
+		t.Fatal("variant changed while a cycle was ongoing")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, variant.Relaxed, *tcr.variant)
+	tcr.cycleMutex.Unlock()
+	<-done
+	assert.Equal(t, variant.BTCR, *tcr.variant)
+}
+
+func Test_isolated_tcr_cycle(t *testing.T) {
+	testFlags := []struct {
+		desc              string
+		toolchainFailures toolchain.Operations
+		vcsFailures       fake.Commands
+		expectedCommands  []fake.Command
+		expectedStatus    status.Status
+	}{
+		{
+			"with no failure",
+			nil, nil,
+			[]fake.Command{
+				fake.TakeSnapshotCommand,
+				fake.CommitSnapshotCommand,
+				fake.PushCommand,
+				fake.DiffCommand,
+				fake.DropSnapshotCommand,
+			},
+			status.Ok,
+		},
+		{
+			"with build failure",
+			toolchain.Operations{toolchain.BuildOperation}, nil,
+			[]fake.Command{
+				fake.TakeSnapshotCommand,
+				fake.DiffCommand,
+				fake.DropSnapshotCommand,
+			},
+			status.BuildFailed,
+		},
+		{
+			"with test failure",
+			toolchain.Operations{toolchain.TestOperation}, nil,
+			[]fake.Command{
+				fake.TakeSnapshotCommand,
+				fake.RevertLocalCommand,
+				fake.DiffCommand,
+				fake.DropSnapshotCommand,
+			},
+			status.Ok,
+		},
+		{
+			"with VCS take snapshot failure",
+			nil, fake.Commands{fake.TakeSnapshotCommand},
+			[]fake.Command{
+				fake.TakeSnapshotCommand,
+			},
+			status.VCSError,
+		},
+		{
+			"with VCS commit snapshot failure",
+			nil, fake.Commands{fake.CommitSnapshotCommand},
+			[]fake.Command{
+				fake.TakeSnapshotCommand,
+				fake.CommitSnapshotCommand,
+				fake.DiffCommand,
+				fake.DropSnapshotCommand,
+			},
+			status.VCSError,
+		},
+		{
+			"with VCS drop snapshot failure",
+			nil, fake.Commands{fake.DropSnapshotCommand},
+			[]fake.Command{
+				fake.TakeSnapshotCommand,
+				fake.CommitSnapshotCommand,
+				fake.PushCommand,
+				fake.DiffCommand,
+				fake.DropSnapshotCommand,
+			},
+			status.Ok,
+		},
+		{
+			"with test and VCS revert local failure",
+			toolchain.Operations{toolchain.TestOperation}, fake.Commands{fake.RevertLocalCommand},
+			[]fake.Command{
+				fake.TakeSnapshotCommand,
+				fake.RevertLocalCommand,
+				fake.DiffCommand,
+				fake.DropSnapshotCommand,
+			},
+			status.VCSError,
+		},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			status.RecordState(status.Ok)
+			tcr, vcsFake := initTCREngineWithFakes(
+				params.AParamSet(params.WithAutoPush(true), params.WithIsolatedRuns(true)),
+				tt.toolchainFailures, tt.vcsFailures, nil)
+			tcr.RunTCRCycle()
+			assert.Equal(t, tt.expectedCommands, vcsFake.GetLastCommands(len(tt.expectedCommands)))
+			assert.Equal(t, tt.expectedStatus, status.GetCurrentState())
+		})
+	}
+}
+
+func Test_isolated_revert_keeps_files_edited_during_the_cycle(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "fake-src")
+	assert.NoError(t, os.WriteFile(src, []byte("tested"), 0600))
+	tcr, vcsFake := initTCREngineWithFakesWithFileDiffs(
+		params.AParamSet(params.WithIsolatedRuns(true)),
+		nil, nil, nil, vcs.FileDiffs{vcs.NewFileDiff(src, 1, 1)})
+	snapshot, _ := vcsFake.TakeSnapshot()
+
+	// Simulate an edit done while tests were running
+	assert.NoError(t, os.WriteFile(src, []byte("edited"), 0600))
+	sniffer := report.NewSniffer(
+		func(msg report.Message) bool {
+			return msg.Payload.ToString() == "1 file(s) edited while tests were running kept for the next cycle"
+		},
+	)
+	assert.NoError(t, tcr.revertSnapshot(snapshot))
+	sniffer.Stop()
+	assert.Equal(t, 1, sniffer.GetMatchCount())
+	assert.Equal(t, fake.TakeSnapshotCommand, vcsFake.GetLastCommand())
+}
+
 func Test_tcr_cycle_end_state(t *testing.T) {
 	testFlags := []struct {
 		desc              string
@@ -412,6 +642,28 @@ func initTCREngineWithFakesWithFileDiffs(
 	vcsFailures fake.Commands,
 	logItems vcs.LogItems,
 	fileDiffs vcs.FileDiffs,
+) (*TCREngine, *fake.VCSFake) {
+	return initTCREngineWithFakeSettings(p, toolchainFailures, fake.Settings{
+		FailingCommands: vcsFailures,
+		ChangedFiles:    fileDiffs,
+		Logs:            logItems,
+	})
+}
+
+func initTCREngineWithFakesWithShelvedItems(
+	p *params.Params,
+	shelvedItems vcs.ShelfItems,
+) (*TCREngine, *fake.VCSFake) {
+	return initTCREngineWithFakeSettings(p, nil, fake.Settings{
+		ChangedFiles: vcs.FileDiffs{vcs.NewFileDiff("fake-src", 1, 1)},
+		ShelvedItems: shelvedItems,
+	})
+}
+
+func initTCREngineWithFakeSettings(
+	p *params.Params,
+	toolchainFailures toolchain.Operations,
+	fakeSettings fake.Settings,
 ) (*TCREngine, *fake.VCSFake) {
 	tchn := registerFakeToolchain(toolchainFailures)
 	lang := registerFakeLanguage(tchn)
@@ -431,12 +683,17 @@ func initTCREngineWithFakesWithFileDiffs(
 			params.WithToolchain(tchn),
 			params.WithMobTimerDuration(p.MobTurnDuration),
 			params.WithAutoPush(p.AutoPush),
+			params.WithIsolatedRuns(p.IsolatedRuns),
 			params.WithVariant(p.Variant),
 			params.WithPollingPeriod(p.PollingPeriod),
 			params.WithRunMode(p.Mode),
 			params.WithVCS(p.VCS),
 			params.WithGitRemote(p.GitRemote),
 			params.WithMessageSuffix(p.MessageSuffix),
+			params.WithMessageTemplate(p.MessageTemplate),
+			params.WithMembers(p.Members...),
+			params.WithHandover(p.Handover),
+			params.WithHooks(p.Hooks),
 		)
 	}
 
@@ -444,11 +701,6 @@ func initTCREngineWithFakesWithFileDiffs(
 	// Replace VCS factory initializer in order to use a VCS fake instead of the real thing
 	var vcsFake *fake.VCSFake
 	factory.InitVCS = func(_ string, _ string, _ string) (vcs.Interface, error) {
-		fakeSettings := fake.Settings{
-			FailingCommands: vcsFailures,
-			ChangedFiles:    fileDiffs,
-			Logs:            logItems,
-		}
 		vcsFake = fake.NewVCSFake(fakeSettings)
 		return vcsFake, nil
 	}
@@ -592,6 +844,18 @@ func Test_variant_commit_subjects(t *testing.T) {
 			toolchain.Operations{toolchain.TestOperation},
 			[]string{},
 		},
+		{
+			"Shelve with tests passing.",
+			variant.Shelve,
+			toolchain.Operations{},
+			[]string{passedCommitMessage},
+		},
+		{
+			"Shelve with tests failing.",
+			variant.Shelve,
+			toolchain.Operations{toolchain.TestOperation},
+			[]string{},
+		},
 	}
 
 	for _, tt := range testFlags {
@@ -807,6 +1071,354 @@ func Test_tcr_print_log(t *testing.T) {
 	}
 }
 
+func Test_tcr_export_log_and_stats(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	logItems := vcs.LogItems{
+		vcs.NewLogItem("1111", now, passedCommitMessage),
+		vcs.NewLogItem("2222", now, "other commit message"),
+	}
+	testFlags := []struct {
+		desc     string
+		mode     runmode.RunMode
+		format   string
+		expected string
+	}{
+		{"log in json format", runmode.Log{}, "json", `"hash": "1111"`},
+		{"log in csv format", runmode.Log{}, "csv", "1111,2026-10-18T09:30:00Z," + passedCommitMessage + ",pass"},
+		{"stats in yaml format", runmode.Stats{}, "yaml", "commits: 1"},
+		{"stats in csv format", runmode.Stats{}, "csv", "passing-commits,1"},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "output")
+			p := params.AParamSet(params.WithRunMode(tt.mode),
+				params.WithFormat(tt.format), params.WithOutputFile(path))
+			tcr, _ := initTCREngineWithFakes(p, nil, nil, logItems)
+			if tt.mode == (runmode.Log{}) {
+				tcr.PrintLog(*p)
+			} else {
+				tcr.PrintStats(*p)
+			}
+			data, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			assert.Contains(t, string(data), tt.expected)
+			assert.NotContains(t, string(data), "2222")
+		})
+	}
+}
+
+func Test_tcr_generate_retro(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	logItems := vcs.LogItems{vcs.NewLogItem("1111", now, passedCommitMessage)}
+	customTemplate := filepath.Join(t.TempDir(), "custom.md")
+	assert.NoError(t, os.WriteFile(customTemplate, []byte("Commits: {{.Commits}}"), 0600))
+	testFlags := []struct {
+		desc     string
+		format   string
+		template string
+		expected string
+	}{
+		{"markdown format", "", "", "# Quick Retrospective"},
+		{"html format", "html", "", "<h1>Quick Retrospective</h1>"},
+		{"custom template", "markdown", customTemplate, "Commits: 1"},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "retro")
+			p := params.AParamSet(params.WithRunMode(runmode.Retro{}),
+				params.WithFormat(tt.format), params.WithTemplate(tt.template), params.WithOutputFile(path))
+			tcr, _ := initTCREngineWithFakes(p, nil, nil, logItems)
+			tcr.GenerateRetro(*p)
+			data, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			assert.Contains(t, string(data), tt.expected)
+		})
+	}
+}
+
+func Test_tcr_history_filter(t *testing.T) {
+	p := params.AParamSet(
+		params.WithRunMode(runmode.Log{}),
+		params.WithSince("2026-10-01"),
+		params.WithUntil("2026-10-02T12:00"),
+		params.WithAuthors("alice", "bob"),
+		params.WithBranches("main", "feature"),
+	)
+	tcr, _ := initTCREngineWithFakes(p, nil, nil, nil)
+	tcr.PrintLog(*p)
+	// In log mode, VCS is initialized only when querying history
+	vcsFake := tcr.vcs.(*fake.VCSFake)
+	assert.Equal(t, vcs.LogFilter{
+		Since:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local),
+		Until:    time.Date(2026, 10, 2, 12, 0, 0, 0, time.Local),
+		Authors:  []string{"alice", "bob"},
+		Branches: []string{"main", "feature"},
+	}, vcsFake.GetLastLogFilter())
+}
+
+func Test_tcr_history_filter_on_journal(t *testing.T) {
+	initJournalForTest(t)
+	tcr, _ := initTCREngineWithFakes(nil, nil, nil, nil)
+	tcr.RunTCRCycle()
+
+	testFlags := []struct {
+		desc            string
+		since           string
+		expectedEntries int
+	}{
+		{"entries since yesterday are kept", "yesterday", 3},
+		{"entries before a future date are dropped", time.Now().AddDate(0, 0, 1).Format(time.DateOnly), 0},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			sniffer := report.NewSniffer(func(msg report.Message) bool {
+				return msg.Type.Category == report.Title &&
+					strings.Index(msg.Payload.ToString(), "entry:     ") == 0
+			})
+			p := params.AParamSet(params.WithRunMode(runmode.Log{}),
+				params.WithFromJournal(true), params.WithSince(tt.since))
+			tcr, _ := initTCREngineWithFakes(p, nil, nil, nil)
+			tcr.PrintLog(*p)
+			sniffer.Stop()
+			assert.Equal(t, tt.expectedEntries, sniffer.GetMatchCount())
+		})
+	}
+}
+
+func Test_tcr_recover(t *testing.T) {
+	now := time.Now()
+	sampleItems := vcs.ShelfItems{
+		vcs.NewShelfItem("stash@{0}", now, shelvedCommitMessage+"\n\n"+events.ATcrEvent(
+			events.WithModifiedSrcLines(3),
+			events.WithTestsFailed(2),
+		).ToYAML()),
+		vcs.NewShelfItem("stash@{1}", now, "other stash message"),
+	}
+	testFlags := []struct {
+		desc             string
+		shelfID          string
+		filter           func(msg report.Message) bool
+		shelvedItems     vcs.ShelfItems
+		expectedMatches  int
+		expectedCommands []fake.Command
+	}{
+		{
+			desc: "TCR shelf entries are listed",
+			filter: func(msg report.Message) bool {
+				return msg.Type.Category == report.Title &&
+					msg.Payload.ToString() == "shelf:     stash@{0}"
+			},
+			shelvedItems:     sampleItems,
+			expectedMatches:  1,
+			expectedCommands: []fake.Command{fake.ListShelvedCommand},
+		},
+		{
+			desc: "non-TCR shelf entries are dropped",
+			filter: func(msg report.Message) bool {
+				return msg.Type.Category == report.Title &&
+					msg.Payload.ToString() == "shelf:     stash@{1}"
+			},
+			shelvedItems:     sampleItems,
+			expectedMatches:  0,
+			expectedCommands: []fake.Command{fake.ListShelvedCommand},
+		},
+		{
+			desc: "shelved changes info is printed",
+			filter: func(msg report.Message) bool {
+				return msg.Type.Category == report.Info &&
+					msg.Payload.ToString() == "changes:   3 src line(s), 0 test line(s)"
+			},
+			shelvedItems:     sampleItems,
+			expectedMatches:  1,
+			expectedCommands: []fake.Command{fake.ListShelvedCommand},
+		},
+		{
+			desc: "warning when no shelf entry found",
+			filter: func(msg report.Message) bool {
+				return msg.Type.Category == report.Warning &&
+					strings.Index(msg.Payload.ToString(), "no shelved changes found in ") == 0
+			},
+			shelvedItems:     nil,
+			expectedMatches:  1,
+			expectedCommands: []fake.Command{fake.ListShelvedCommand},
+		},
+		{
+			desc:    "shelf entry is re-applied when an ID is provided",
+			shelfID: "stash@{0}",
+			filter: func(msg report.Message) bool {
+				return msg.Type.Category == report.Info &&
+					strings.Index(msg.Payload.ToString(), "Recovering shelved changes stash@{0}") == 0
+			},
+			shelvedItems:     sampleItems,
+			expectedMatches:  1,
+			expectedCommands: []fake.Command{fake.UnshelveCommand},
+		},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			sniffer := report.NewSniffer(tt.filter)
+			p := params.AParamSet(params.WithRunMode(runmode.Recover{}), params.WithShelfID(tt.shelfID))
+			tcr, _ := initTCREngineWithFakesWithShelvedItems(p, tt.shelvedItems)
+			tcr.Recover(*p)
+			sniffer.Stop()
+			assert.Equal(t, tt.expectedMatches, sniffer.GetMatchCount())
+			// VCS is initialized only when calling Recover in this run mode
+			vcsFake, _ := tcr.vcs.(*fake.VCSFake)
+			assert.Equal(t, tt.expectedCommands, vcsFake.GetLastCommands(1))
+		})
+	}
+}
+
+func Test_tcr_cycle_journal_entries(t *testing.T) {
+	testFlags := []struct {
+		desc              string
+		variant           variant.Variant
+		toolchainFailures toolchain.Operations
+		expected          []journal.EntryType
+	}{
+		{
+			"with no failure",
+			variant.Relaxed, nil,
+			[]journal.EntryType{journal.Build, journal.Test, journal.Commit},
+		},
+		{
+			"with build failure",
+			variant.Relaxed, toolchain.Operations{toolchain.BuildOperation},
+			[]journal.EntryType{journal.Build},
+		},
+		{
+			"with test failure",
+			variant.Relaxed, toolchain.Operations{toolchain.TestOperation},
+			[]journal.EntryType{journal.Build, journal.Test, journal.Revert},
+		},
+		{
+			"with test failure in introspective variant",
+			variant.Introspective, toolchain.Operations{toolchain.TestOperation},
+			[]journal.EntryType{journal.Build, journal.Test, journal.Revert},
+		},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			initJournalForTest(t)
+			tcr, _ := initTCREngineWithFakes(
+				params.AParamSet(params.WithVariant(tt.variant.Name())),
+				tt.toolchainFailures, nil, nil)
+			tcr.RunTCRCycle()
+			assert.Equal(t, tt.expected, journalEntryTypes(t, tcr))
+		})
+	}
+}
+
+func Test_role_changes_are_recorded_in_journal(t *testing.T) {
+	initJournalForTest(t)
+	tcr, _ := initTCREngineWithFakes(nil, nil, nil, nil)
+	tcr.setCurrentRole(role.Driver{})
+	tcr.resetCurrentRole()
+
+	entries := readJournalForTest(t, tcr)
+	assert.Equal(t, []journal.EntryType{journal.RoleStart, journal.RoleEnd}, journalEntryTypes(t, tcr))
+	assert.Equal(t, role.Driver{}.Name(), entries[0].Role)
+}
+
+func Test_journal_is_not_written_when_disabled(t *testing.T) {
+	journal.InitConfig("")
+	tcr, _ := initTCREngineWithFakes(nil, nil, nil, nil)
+	assert.NotPanics(t, tcr.RunTCRCycle)
+}
+
+func Test_tcr_print_log_from_journal(t *testing.T) {
+	testFlags := []struct {
+		desc            string
+		filter          func(msg report.Message) bool
+		runCycle        bool
+		expectedMatches int
+	}{
+		{
+			desc: "journal entry types are printed",
+			filter: func(msg report.Message) bool {
+				return msg.Type.Category == report.Title &&
+					strings.Index(msg.Payload.ToString(), "entry:     ") == 0
+			},
+			runCycle:        true,
+			expectedMatches: 3,
+		},
+		{
+			desc: "journal entry details are printed",
+			filter: func(msg report.Message) bool {
+				return msg.Type.Category == report.Info &&
+					msg.Payload.ToString() == "details:   build pass"
+			},
+			runCycle:        true,
+			expectedMatches: 1,
+		},
+		{
+			desc: "warning when no entry found",
+			filter: func(msg report.Message) bool {
+				return msg.Type.Category == report.Warning &&
+					strings.Index(msg.Payload.ToString(), "no TCR journal entry found for ") == 0
+			},
+			runCycle:        false,
+			expectedMatches: 1,
+		},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			initJournalForTest(t)
+			if tt.runCycle {
+				tcr, _ := initTCREngineWithFakes(nil, nil, nil, nil)
+				tcr.RunTCRCycle()
+			}
+			sniffer := report.NewSniffer(tt.filter)
+			p := params.AParamSet(params.WithRunMode(runmode.Log{}), params.WithFromJournal(true))
+			tcr, _ := initTCREngineWithFakes(p, nil, nil, nil)
+			tcr.PrintLog(*p)
+			sniffer.Stop()
+			assert.Equal(t, tt.expectedMatches, sniffer.GetMatchCount())
+		})
+	}
+}
+
+func Test_tcr_print_stats_from_journal(t *testing.T) {
+	initJournalForTest(t)
+	tcr, _ := initTCREngineWithFakes(nil, toolchain.Operations{toolchain.BuildOperation}, nil, nil)
+	tcr.RunTCRCycle()
+
+	sniffer := report.NewSniffer(func(msg report.Message) bool {
+		return msg.Type.Category == report.Info &&
+			strings.Contains(msg.Payload.ToString(), "Failing builds:")
+	})
+	p := params.AParamSet(params.WithRunMode(runmode.Stats{}), params.WithFromJournal(true))
+	tcr, _ = initTCREngineWithFakes(p, nil, nil, nil)
+	tcr.PrintStats(*p)
+	sniffer.Stop()
+	assert.Equal(t, 1, sniffer.GetMatchCount())
+	assert.Contains(t, sniffer.GetAllMatches()[0].Payload.ToString(), "1 (100%)")
+}
+
+func initJournalForTest(t *testing.T) {
+	t.Helper()
+	journal.InitConfig(t.TempDir())
+	t.Cleanup(func() { journal.InitConfig("") })
+}
+
+func readJournalForTest(t *testing.T, tcr *TCREngine) journal.Entries {
+	t.Helper()
+	entries, err := journal.Read(journal.GetFilePath(), tcr.sourceTree.GetBaseDir(), tcr.vcs.GetWorkingBranch())
+	assert.NoError(t, err)
+	return entries
+}
+
+func journalEntryTypes(t *testing.T, tcr *TCREngine) (types []journal.EntryType) {
+	t.Helper()
+	for _, entry := range readJournalForTest(t, tcr) {
+		types = append(types, entry.Type)
+	}
+	return types
+}
+
 func Test_parse_commit_message_type_tag(t *testing.T) {
 	testFlags := []struct {
 		desc           string
@@ -828,6 +1440,21 @@ func Test_parse_commit_message_type_tag(t *testing.T) {
 			commitMessage:  messageFailed.Tag,
 			expectedStatus: events.StatusFail,
 		},
+		{
+			desc:           "red commit",
+			commitMessage:  messageRed.Tag,
+			expectedStatus: events.StatusFail,
+		},
+		{
+			desc:           "passing commit with status trailer",
+			commitMessage:  "feat(tcr): add login\n\n" + statusTrailer(messagePassed),
+			expectedStatus: events.StatusPass,
+		},
+		{
+			desc:           "failing commit with status trailer",
+			commitMessage:  "test(tcr): tests failing\n\n" + statusTrailer(messageFailed),
+			expectedStatus: events.StatusFail,
+		},
 		{
 			desc:           "revert commit are unknown status",
 			commitMessage:  messageReverted.Tag,
@@ -1006,6 +1633,43 @@ func Test_adding_suffix_to_tcr_commit_messages(t *testing.T) {
 	}
 }
 
+func Test_building_commit_messages_from_template(t *testing.T) {
+	event := events.ATcrEvent(events.WithModifiedSrcLines(2))
+	tests := []struct {
+		desc     string
+		template string
+		intent   string
+		header   CommitMessage
+		expected []string
+	}{
+		{
+			"default template", "default", "", messagePassed,
+			[]string{messagePassed.toString(true), event.ToYAML()},
+		},
+		{
+			"conventional preset", "conventional", "", messagePassed,
+			[]string{"feat(tcr): tests passing", event.ToYAML(), "TCR-Status: passed"},
+		},
+		{
+			"conventional preset with intent", "conventional", "add login", messageFailed,
+			[]string{"test(tcr): add login", event.ToYAML(), "TCR-Status: failed"},
+		},
+		{
+			"custom template", "{{.Kind}} on {{.Branch}}: {{.Event.Changes.Src}} src line(s)", "", messagePassed,
+			[]string{"passed on vcs-fake-working-branch: 2 src line(s)", event.ToYAML(), "TCR-Status: passed"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := params.AParamSet(params.WithRunMode(runmode.OneShot{}), params.WithMessageTemplate(test.template))
+			tcr, _ := initTCREngineWithFakes(p, nil, nil, nil)
+			tcr.SetCommitIntent(test.intent)
+			result := tcr.wrapCommitMessages(test.header, event)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
 func Test_building_the_commit_message_depending_on_the_vcs(t *testing.T) {
 	tests := []struct {
 		desc          string
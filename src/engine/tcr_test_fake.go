@@ -25,8 +25,12 @@ SOFTWARE.
 package engine
 
 import (
+	"time"
+
+	"github.com/murex/tcr/export"
 	"github.com/murex/tcr/params"
 	"github.com/murex/tcr/role"
+	"github.com/murex/tcr/roster"
 	"github.com/murex/tcr/status"
 	"github.com/murex/tcr/timer"
 	"github.com/murex/tcr/ui"
@@ -46,6 +50,7 @@ const (
 	TCRCallStop              TCRCall = "stop"
 	TCRCallAbortCommand      TCRCall = "abort-command"
 	TCRCallGetMobTimerStatus TCRCall = "get-mob-timer-status"
+	TCRCallGetRoster         TCRCall = "get-roster"
 	TCRCallRunTcrCycle       TCRCall = "run-tcr-cycle"
 	TCRCallRunCheck          TCRCall = "run-check"
 	TCRCallPrintLog          TCRCall = "print-log"
@@ -53,6 +58,15 @@ const (
 	TCRCallVCSPull           TCRCall = "vcs-pull"
 	TCRCallVCSPush           TCRCall = "vcs-push"
 	TCRCallGenerateRetro     TCRCall = "generate-retro"
+	TCRCallRecover           TCRCall = "recover"
+	TCRCallRunMutationTests  TCRCall = "run-mutation-tests"
+	TCRCallSetCommitIntent   TCRCall = "set-commit-intent"
+	TCRCallSetAutoPush       TCRCall = "set-auto-push"
+	TCRCallSetVariant        TCRCall = "set-variant"
+	TCRCallSetMobTimer       TCRCall = "set-mob-timer-duration"
+	TCRCallGetLog            TCRCall = "get-log"
+	TCRCallGetStats          TCRCall = "get-stats"
+	TCRCallGetRetro          TCRCall = "get-retro"
 )
 
 var NoTCRCall []TCRCall
@@ -62,9 +76,11 @@ var NoTCRCall []TCRCall
 type FakeTCREngine struct {
 	TCREngine
 	timerStatus timer.CurrentState
+	mobRoster   *roster.Roster
 	callRecord  []TCRCall
 	returnCode  int
 	info        *SessionInfo
+	historyErr  error
 }
 
 // NewFakeTCREngine creates a FakeToolchain instance
@@ -72,6 +88,7 @@ func NewFakeTCREngine() *FakeTCREngine {
 	return &FakeTCREngine{
 		returnCode:  0,
 		timerStatus: timer.CurrentState{State: timer.StateOff, Timeout: 0, Elapsed: 0, Remaining: 0},
+		mobRoster:   roster.New(),
 		info: &SessionInfo{
 			BaseDir:           "fake",
 			WorkDir:           "fake",
@@ -123,6 +140,24 @@ func (fake *FakeTCREngine) ToggleAutoPush() {
 	fake.recordCall(TCRCallToggleAutoPush)
 }
 
+// SetAutoPush sets VCS auto-push state
+func (fake *FakeTCREngine) SetAutoPush(flag bool) {
+	fake.info.GitAutoPush = flag
+	fake.recordCall(TCRCallSetAutoPush)
+}
+
+// SetVariant sets the TCR variant
+func (fake *FakeTCREngine) SetVariant(name string) {
+	fake.info.Variant = name
+	fake.recordCall(TCRCallSetVariant)
+}
+
+// SetMobTimerDuration sets the duration of driver turns
+func (fake *FakeTCREngine) SetMobTimerDuration(duration time.Duration) {
+	fake.timerStatus.Timeout = duration
+	fake.recordCall(TCRCallSetMobTimer)
+}
+
 // RunAsDriver tells TCR engine to start running with driver role
 func (fake *FakeTCREngine) RunAsDriver() {
 	fake.currentRole = role.Driver{}
@@ -152,11 +187,27 @@ func (fake *FakeTCREngine) SetMobTimerStatus(state timer.CurrentState) {
 	fake.timerStatus = state
 }
 
+// GetRoster returns the mob roster
+func (fake *FakeTCREngine) GetRoster() *roster.Roster {
+	fake.recordCall(TCRCallGetRoster)
+	return fake.mobRoster
+}
+
+// SetRoster sets the mob roster
+func (fake *FakeTCREngine) SetRoster(r *roster.Roster) {
+	fake.mobRoster = r
+}
+
 // AbortCommand triggers interruption of an ongoing TCR cycle operation
 func (fake *FakeTCREngine) AbortCommand() {
 	fake.recordCall(TCRCallAbortCommand)
 }
 
+// SetCommitIntent sets the intent made available to commit message templates
+func (fake *FakeTCREngine) SetCommitIntent(_ string) {
+	fake.recordCall(TCRCallSetCommitIntent)
+}
+
 // RunTCRCycle is the core of TCR engine: e.g. it runs one test && commit || revert cycle
 func (fake *FakeTCREngine) RunTCRCycle() {
 	fake.recordCall(TCRCallRunTcrCycle)
@@ -191,3 +242,36 @@ func (fake *FakeTCREngine) VCSPush() {
 func (fake *FakeTCREngine) GenerateRetro(_ params.Params) {
 	fake.recordCall(TCRCallGenerateRetro)
 }
+
+// Recover lists or re-applies fake shelved changes
+func (fake *FakeTCREngine) Recover(_ params.Params) {
+	fake.recordCall(TCRCallRecover)
+}
+
+// RunMutationTests runs fake mutation tests
+func (fake *FakeTCREngine) RunMutationTests(_ params.Params) {
+	fake.recordCall(TCRCallRunMutationTests)
+}
+
+// SetHistoryError sets the error returned by history queries
+func (fake *FakeTCREngine) SetHistoryError(err error) {
+	fake.historyErr = err
+}
+
+// GetLog returns an empty fake TCR commit history
+func (fake *FakeTCREngine) GetLog(_ HistoryQuery) (export.Exportable, error) {
+	fake.recordCall(TCRCallGetLog)
+	return export.Log{}, fake.historyErr
+}
+
+// GetStats returns fake TCR execution stats
+func (fake *FakeTCREngine) GetStats(_ HistoryQuery) (export.Stats, error) {
+	fake.recordCall(TCRCallGetStats)
+	return export.Stats{Branch: "fake"}, fake.historyErr
+}
+
+// GetRetro returns fake retrospective contents
+func (fake *FakeTCREngine) GetRetro(_ HistoryQuery, format string) (string, error) {
+	fake.recordCall(TCRCallGetRetro)
+	return "fake " + format + " retrospective", fake.historyErr
+}
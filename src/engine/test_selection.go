@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/murex/tcr/language"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/vcs"
+)
+
+// setTestSelection turns on test selection when requested and supported by the toolchain.
+// All tests are run during the first TCR cycle, then every fullTestRunPeriod cycles
+func (tcr *TCREngine) setTestSelection(flag bool, fullTestRunPeriod int) {
+	tcr.testSelection = false
+	if !flag || !tcr.supportedWithModules("Test selection") {
+		return
+	}
+	if !tcr.toolchain.SupportsTestSelection() {
+		report.PostWarning("Test selection is not supported with ", tcr.toolchain.GetName(),
+			" toolchain: all tests will be run")
+		return
+	}
+	tcr.testSelection = true
+	tcr.fullTestRunPeriod = fullTestRunPeriod
+	tcr.affectedTestRuns = fullTestRunPeriod
+	report.PostInfo("Only affected tests will be run, with all tests run every ", fullTestRunPeriod, " cycle(s)")
+}
+
+// selectTests returns the test files to be run for the provided changes.
+// Returns nil when all tests should be run
+func (tcr *TCREngine) selectTests(diffs vcs.FileDiffs) []string {
+	if !tcr.testSelection {
+		return nil
+	}
+	if tcr.affectedTestRuns >= tcr.fullTestRunPeriod-1 {
+		tcr.affectedTestRuns = 0
+		return nil
+	}
+	tests, ok := affectedTests(tcr.language, diffs)
+	if !ok {
+		tcr.affectedTestRuns = 0
+		return nil
+	}
+	tcr.affectedTestRuns++
+	return tests
+}
+
+// affectedTests returns the test files affected by the provided changes, based on language
+// source and test file filters. A changed test file is affected by its own changes. A changed
+// source file affects the test files located in the same directory or having its name as part
+// of their name. This is a filename heuristic rather than a dependency analysis: tests of other
+// packages depending on a changed source file are not selected. Returns false when the affected
+// test files cannot be determined reliably, meaning that all tests should be run
+func affectedTests(lang language.LangInterface, diffs vcs.FileDiffs) (tests []string, ok bool) {
+	var allTests []string
+	add := func(test string) {
+		if !slices.Contains(tests, test) {
+			tests = append(tests, test)
+		}
+	}
+	for _, diff := range diffs {
+		switch {
+		case lang.IsTestFile(diff.Path):
+			// Deleted test files cannot be run
+			if _, err := os.Stat(diff.Path); err == nil {
+				add(diff.Path)
+			}
+		case lang.IsSrcFile(diff.Path):
+			if allTests == nil {
+				var err error
+				if allTests, err = lang.AllTestFiles(); err != nil {
+					return nil, false
+				}
+			}
+			related := relatedTests(diff.Path, allTests)
+			if !slices.ContainsFunc(related, func(test string) bool {
+				return lang.PackageDir(test) == lang.PackageDir(diff.Path)
+			}) {
+				// No test in the source file's package: we cannot tell which tests depend on it
+				return nil, false
+			}
+			for _, test := range related {
+				add(test)
+			}
+		default:
+			// Changes in other files (such as build files) may affect any test
+			return nil, false
+		}
+	}
+	return tests, len(tests) > 0
+}
+
+// relatedTests returns the test files related to the provided source file
+func relatedTests(srcFile string, allTests []string) (related []string) {
+	srcDir := filepath.Dir(srcFile)
+	srcName := baseNameWithoutExt(srcFile)
+	for _, test := range allTests {
+		if filepath.Dir(test) == srcDir || strings.Contains(baseNameWithoutExt(test), srcName) {
+			related = append(related, test)
+		}
+	}
+	return related
+}
+
+func baseNameWithoutExt(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
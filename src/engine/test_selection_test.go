@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/murex/tcr/language"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/toolchain"
+	"github.com/murex/tcr/vcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_affected_tests(t *testing.T) {
+	baseDir := t.TempDir()
+	path := func(elem ...string) string {
+		return filepath.Join(append([]string{baseDir}, elem...)...)
+	}
+	for _, f := range []string{
+		path("src", "foo.py"),
+		path("src", "untested.py"),
+		path("src", "sub", "bar.py"),
+		path("tests", "test_foo.py"),
+		path("tests", "test_bar.py"),
+		path("tests", "test_baz.py"),
+	} {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(f), 0750))
+		assert.NoError(t, os.WriteFile(f, []byte{}, 0600))
+	}
+	lang := language.ALanguage(
+		language.WithBaseDir(baseDir),
+		language.WithSrcFiles(language.AFileTreeFilter(
+			language.WithDirectory("src"), language.WithPattern(`.*\.py$`))),
+		language.WithTestFiles(language.AFileTreeFilter(
+			language.WithDirectory("tests"), language.WithPattern(`.*test_.*\.py$`))),
+	)
+
+	testFlags := []struct {
+		desc          string
+		changedFiles  []string
+		expectedTests []string
+		expectedOk    bool
+	}{
+		{
+			"no change",
+			nil,
+			nil, false,
+		},
+		{
+			"changed test file",
+			[]string{path("tests", "test_baz.py")},
+			[]string{path("tests", "test_baz.py")}, true,
+		},
+		{
+			"deleted test file",
+			[]string{path("tests", "test_deleted.py")},
+			nil, false,
+		},
+		{
+			"changed source files with matching test names",
+			[]string{path("src", "foo.py"), path("tests", "test_foo.py"), path("tests", "test_baz.py")},
+			[]string{path("tests", "test_foo.py"), path("tests", "test_baz.py")}, true,
+		},
+		{
+			"changed source file with matching test name in another package",
+			[]string{path("src", "foo.py"), path("src", "sub", "bar.py")},
+			nil, false,
+		},
+		{
+			"changed source file with no related test",
+			[]string{path("src", "foo.py"), path("src", "untested.py")},
+			nil, false,
+		},
+		{
+			"changed file that is neither a source nor a test file",
+			[]string{path("tests", "test_foo.py"), path("setup.cfg")},
+			nil, false,
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var diffs vcs.FileDiffs
+			for _, f := range tt.changedFiles {
+				diffs = append(diffs, vcs.NewFileDiff(f, 1, 0))
+			}
+			tests, ok := affectedTests(lang, diffs)
+			assert.ElementsMatch(t, tt.expectedTests, tests)
+			assert.Equal(t, tt.expectedOk, ok)
+		})
+	}
+}
+
+func Test_test_selection_activation(t *testing.T) {
+	testFlags := []struct {
+		desc                string
+		testSelection       bool
+		toolchainSupportsIt bool
+		expected            bool
+	}{
+		{"test selection off", false, true, false},
+		{"test selection on with toolchain supporting it", true, true, true},
+		{"test selection on with toolchain not supporting it", true, false, false},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			tcr, _ := initTCREngineWithFakes(nil, nil, nil, nil)
+			tcr.toolchain.(*toolchain.FakeToolchain).WithTestSelection(tt.toolchainSupportsIt)
+			tcr.setTestSelection(tt.testSelection, 3)
+			assert.Equal(t, tt.expected, tcr.testSelection)
+		})
+	}
+}
+
+func Test_tcr_cycle_with_test_selection_runs_all_tests_periodically(t *testing.T) {
+	// Fake language recognizes any relative path as a test file
+	t.Chdir(t.TempDir())
+	testFile := "fake-test-file"
+	assert.NoError(t, os.WriteFile(testFile, []byte{}, 0600))
+	tcr, _ := initTCREngineWithFakesWithFileDiffs(
+		params.AParamSet(params.WithTestSelection(true), params.WithFullTestRunPeriod(3)),
+		nil, nil, nil, vcs.FileDiffs{vcs.NewFileDiff(testFile, 1, 1)})
+	fakeToolchain := tcr.toolchain.(*toolchain.FakeToolchain)
+	fakeToolchain.WithTestSelection(true)
+	tcr.setTestSelection(true, 3)
+
+	affected := []string{testFile}
+	expected := [][]string{nil, affected, affected, nil, affected}
+	for i, expectedTests := range expected {
+		tcr.RunTCRCycle()
+		assert.Equal(t, expectedTests, fakeToolchain.GetLastAffectedTests(), "cycle %d", i+1)
+	}
+}
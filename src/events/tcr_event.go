@@ -30,27 +30,40 @@ type (
 	// CommandStatus is the status of a test command
 	CommandStatus string
 
+	// Phase is the red-green-refactor phase associated to a TCR event.
+	// It is only set when running with red-green-refactor variant
+	Phase string
+
 	// ChangedLines is the structure containing info related to the lines changes in src and test
 	ChangedLines struct {
-		Src  int
-		Test int
+		Src  int `json:"src"`
+		Test int `json:"test"`
 	}
 
 	// TestStats is the structure containing info related to the tests execution
 	TestStats struct {
-		Run      int
-		Passed   int
-		Failed   int
-		Skipped  int
-		Error    int
-		Duration time.Duration
+		Run      int           `json:"run"`
+		Passed   int           `json:"passed"`
+		Failed   int           `json:"failed"`
+		Skipped  int           `json:"skipped"`
+		Error    int           `json:"error"`
+		Duration time.Duration `json:"duration"`
+	}
+
+	// LineCoverage is the structure containing info related to the lines covered by tests
+	LineCoverage struct {
+		Covered int `json:"covered"`
+		Total   int `json:"total"`
 	}
 
 	// TCREvent is the structure containing information related to a TCR event
 	TCREvent struct {
-		Status  CommandStatus
-		Changes ChangedLines
-		Tests   TestStats
+		Status  CommandStatus `json:"status"`
+		Changes ChangedLines  `json:"changes"`
+		Tests   TestStats     `json:"tests"`
+		Phase   Phase         `json:"phase,omitempty"`
+		// Coverage is only set when the toolchain provides a coverage report
+		Coverage *LineCoverage `json:"coverage,omitempty"`
 	}
 )
 
@@ -66,6 +79,14 @@ const (
 	StatusUnknown CommandStatus = "unknown"
 )
 
+// Possible values for Phase
+const (
+	PhaseNone     Phase = ""
+	PhaseRed      Phase = "red"
+	PhaseGreen    Phase = "green"
+	PhaseRefactor Phase = "refactor"
+)
+
 // NewTCREvent creates a new TCREvent instance
 func NewTCREvent(status CommandStatus, changes ChangedLines, stats TestStats) TCREvent {
 	return TCREvent{
@@ -95,6 +116,22 @@ func NewChangedLines(srcLines, testLines int) ChangedLines {
 	}
 }
 
+// NewLineCoverage creates a new LineCoverage instance
+func NewLineCoverage(covered, total int) *LineCoverage {
+	return &LineCoverage{
+		Covered: covered,
+		Total:   total,
+	}
+}
+
+// Percent returns the percentage of covered lines. Returns 100 when there is no line to cover
+func (c LineCoverage) Percent() float64 {
+	if c.Total == 0 {
+		return 100
+	}
+	return 100 * float64(c.Covered) / float64(c.Total)
+}
+
 // ToYAML converts a TCREvent to a YAML string
 func (event TCREvent) ToYAML() string {
 	return tcrEventToYAML(event)
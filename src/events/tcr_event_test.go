@@ -56,6 +56,11 @@ func Test_yaml_conversion_on_sample_tcr_event(t *testing.T) {
 	assert.Equal(t, *event, FromYAML(yaml))
 }
 
+func Test_line_coverage_percent(t *testing.T) {
+	assert.InDelta(t, 80.0, NewLineCoverage(8, 10).Percent(), 1e-9)
+	assert.InDelta(t, 100.0, NewLineCoverage(0, 0).Percent(), 1e-9)
+}
+
 func Test_ChangedLines_can_sum_its_total_line_changes(t *testing.T) {
 	changedLines := ChangedLines{1, 2}
 
@@ -102,3 +102,17 @@ func WithTestsDuration(duration time.Duration) func(filter *TCREvent) {
 		tcrEvent.Tests.Duration = duration
 	}
 }
+
+// WithPhase sets the red-green-refactor phase to TCR event test data builder
+func WithPhase(phase Phase) func(filter *TCREvent) {
+	return func(tcrEvent *TCREvent) {
+		tcrEvent.Phase = phase
+	}
+}
+
+// WithLineCoverage sets the number of covered lines out of the total number of lines
+func WithLineCoverage(covered, total int) func(filter *TCREvent) {
+	return func(tcrEvent *TCREvent) {
+		tcrEvent.Coverage = NewLineCoverage(covered, total)
+	}
+}
@@ -62,3 +62,12 @@ func (ivr IntValueAndRatio) Value() any {
 func (ivr IntValueAndRatio) Percentage() int {
 	return ivr.percentage
 }
+
+// NewIntValueAndRatio creates an IntValueAndRatio instance with
+// the percentage of value vs the provided total
+func NewIntValueAndRatio(value int, total int) IntValueAndRatio {
+	return IntValueAndRatio{
+		value:      value,
+		percentage: asPercentage(value, total),
+	}
+}
@@ -57,3 +57,22 @@ func Test_value_and_ratio_getters(t *testing.T) {
 		})
 	}
 }
+
+func Test_new_int_value_and_ratio(t *testing.T) {
+	testFlags := []struct {
+		desc     string
+		value    int
+		total    int
+		expected IntValueAndRatio
+	}{
+		{"zero total", 0, 0, IntValueAndRatio{0, 0}},
+		{"round down", 1, 3, IntValueAndRatio{1, 33}},
+		{"round up", 2, 3, IntValueAndRatio{2, 67}},
+	}
+
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NewIntValueAndRatio(tt.value, tt.total))
+		})
+	}
+}
@@ -47,10 +47,19 @@ type (
 		Duration time.Duration `yaml:"duration"`
 	}
 
+	// LineCoverageYAML provides the YAML structure containing info related to the lines covered by tests
+	LineCoverageYAML struct {
+		Covered int `yaml:"covered"`
+		Total   int `yaml:"total"`
+	}
+
 	// TCREventYAML provides the YAML structure containing information related to a TCR event
 	TCREventYAML struct {
 		Changes ChangedLinesYAML `yaml:"changed-lines"`
 		Tests   TestStatsYAML    `yaml:"test-stats"`
+		Phase   Phase            `yaml:"phase,omitempty"`
+		// Coverage is omitted when the toolchain provides no coverage report
+		Coverage *LineCoverageYAML `yaml:"line-coverage,omitempty"`
 	}
 )
 
@@ -63,14 +72,24 @@ func yamlToTCREvent(yaml string) TCREvent {
 }
 
 func newTCREventYAML(event TCREvent) TCREventYAML {
-	return TCREventYAML{
+	e := TCREventYAML{
 		Changes: ChangedLinesYAML(event.Changes),
 		Tests:   TestStatsYAML(event.Tests),
+		Phase:   event.Phase,
 	}
+	if event.Coverage != nil {
+		e.Coverage = (*LineCoverageYAML)(event.Coverage)
+	}
+	return e
 }
 
 func (event TCREventYAML) toTCREvent() TCREvent {
-	return NewTCREvent(StatusUnknown, ChangedLines(event.Changes), TestStats(event.Tests))
+	e := NewTCREvent(StatusUnknown, ChangedLines(event.Changes), TestStats(event.Tests))
+	e.Phase = event.Phase
+	if event.Coverage != nil {
+		e.Coverage = (*LineCoverage)(event.Coverage)
+	}
+	return e
 }
 
 func (event TCREventYAML) marshal() string {
@@ -75,6 +75,16 @@ func Test_convert_a_yaml_string_to_a_tcr_event(t *testing.T) {
 			buildYAMLString("0", "0", "0", "0", "0", "0", "0", "20s"),
 			*ATcrEvent(WithTestsDuration(20 * time.Second)),
 		},
+		{
+			"red-green-refactor phase",
+			buildYAMLString("0", "0", "0", "0", "0", "0", "0", "0s") + "phase: red\n",
+			*ATcrEvent(WithPhase(PhaseRed)),
+		},
+		{
+			"line coverage",
+			buildYAMLString("0", "0", "0", "0", "0", "0", "0", "0s") + "line-coverage:\n    covered: 8\n    total: 10\n",
+			*ATcrEvent(WithLineCoverage(8, 10)),
+		},
 		{
 			"empty yaml string",
 			"",
@@ -151,6 +161,16 @@ func Test_convert_a_tcr_event_to_a_yaml_string(t *testing.T) {
 			*ATcrEvent(WithTestsDuration(20 * time.Second)),
 			buildYAMLString("0", "0", "0", "0", "0", "0", "0", "20s"),
 		},
+		{
+			"red-green-refactor phase",
+			*ATcrEvent(WithPhase(PhaseGreen)),
+			buildYAMLString("0", "0", "0", "0", "0", "0", "0", "0s") + "phase: green\n",
+		},
+		{
+			"line coverage",
+			*ATcrEvent(WithLineCoverage(8, 10)),
+			buildYAMLString("0", "0", "0", "0", "0", "0", "0", "0s") + "line-coverage:\n    covered: 8\n    total: 10\n",
+		},
 		{
 			"empty TCR event",
 			*ATcrEvent(),
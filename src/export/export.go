@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnsupportedFormatError is returned when the provided output format name is not supported.
+type UnsupportedFormatError struct {
+	formatName string
+}
+
+// Error returns the error description
+func (e *UnsupportedFormatError) Error() string {
+	return fmt.Sprintf("output format not supported: \"%s\"", e.formatName)
+}
+
+// Format is the format used for exporting TCR data
+type Format string
+
+// List of possible values for Format
+const (
+	Text Format = "text"
+	JSON Format = "json"
+	CSV  Format = "csv"
+	YAML Format = "yaml"
+)
+
+var recognized = []Format{Text, JSON, CSV, YAML}
+
+// Select returns the output format with the provided name.
+// An empty name selects the text format.
+// It returns an UnsupportedFormatError if the name is not recognized.
+func Select(name string) (Format, error) {
+	if name == "" {
+		return Text, nil
+	}
+	for _, f := range recognized {
+		if strings.EqualFold(name, string(f)) {
+			return f, nil
+		}
+	}
+	return "", &UnsupportedFormatError{name}
+}
+
+// IsMachineReadable indicates if the format is intended to be processed by other tools
+func IsMachineReadable(name string) bool {
+	f, err := Select(name)
+	return err == nil && f != Text
+}
+
+// Exportable is implemented by all data that can be exported
+type Exportable interface {
+	csvRecords() [][]string
+}
+
+// Write writes the provided data to w using the provided format.
+// Text format is not handled here, as it is printed through TCR reporter
+func Write(w io.Writer, f Format, data Exportable) error {
+	switch f {
+	case JSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(data)
+	case YAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(data); err != nil {
+			return err
+		}
+		return encoder.Close()
+	case CSV:
+		writer := csv.NewWriter(w)
+		return writer.WriteAll(data.csvRecords())
+	default:
+		return &UnsupportedFormatError{string(f)}
+	}
+}
+
+// WriteToFile writes the provided data to the file at the provided path using
+// the provided format. Data is written to the standard output when path is empty
+func WriteToFile(path string, f Format, data Exportable) (err error) {
+	if path == "" {
+		return Write(os.Stdout, f, data)
+	}
+	file, err := os.Create(path) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	return Write(file, f, data)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package export
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/vcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_select_format(t *testing.T) {
+	testFlags := []struct {
+		name     string
+		expected Format
+	}{
+		{"", Text},
+		{"text", Text},
+		{"json", JSON},
+		{"CSV", CSV},
+		{"yaml", YAML},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Select(tt.name)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, f)
+		})
+	}
+}
+
+func Test_select_unsupported_format(t *testing.T) {
+	_, err := Select("xml")
+	assert.Equal(t, &UnsupportedFormatError{"xml"}, err)
+	assert.Equal(t, "output format not supported: \"xml\"", err.Error())
+}
+
+func Test_is_machine_readable(t *testing.T) {
+	assert.False(t, IsMachineReadable(""))
+	assert.False(t, IsMachineReadable("text"))
+	assert.False(t, IsMachineReadable("xml"))
+	assert.True(t, IsMachineReadable("json"))
+	assert.True(t, IsMachineReadable("csv"))
+	assert.True(t, IsMachineReadable("yaml"))
+}
+
+func sampleLog() Log {
+	timestamp := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	return Log{NewCommit(
+		vcs.NewLogItem("1111", timestamp, "✅ [TCR - PASSED] tests passing\n\nsome details"),
+		events.NewTCREvent(events.StatusPass,
+			events.NewChangedLines(3, 2),
+			events.NewTestStats(5, 4, 0, 1, 0, 1500*time.Millisecond)),
+	)}
+}
+
+func Test_write_log(t *testing.T) {
+	testFlags := []struct {
+		format   Format
+		expected string
+	}{
+		{JSON, `[
+  {
+    "hash": "1111",
+    "timestamp": "2026-10-18T09:30:00Z",
+    "message": "✅ [TCR - PASSED] tests passing",
+    "status": "pass",
+    "changes": {
+      "src": 3,
+      "test": 2
+    },
+    "tests": {
+      "run": 5,
+      "passed": 4,
+      "failed": 0,
+      "skipped": 1,
+      "error": 0,
+      "duration": 1.5
+    }
+  }
+]
+`},
+		{YAML, `- hash: "1111"
+  timestamp: 2026-10-18T09:30:00Z
+  message: ✅ [TCR - PASSED] tests passing
+  status: pass
+  changes:
+    src: 3
+    test: 2
+  tests:
+    run: 5
+    passed: 4
+    failed: 0
+    skipped: 1
+    error: 0
+    duration: 1.5
+`},
+		{CSV, `hash,timestamp,message,status,src-changes,test-changes,tests-run,tests-passed,tests-failed,tests-skipped,tests-error,tests-duration
+1111,2026-10-18T09:30:00Z,✅ [TCR - PASSED] tests passing,pass,3,2,5,4,0,1,0,1.5
+`},
+	}
+	for _, tt := range testFlags {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var b bytes.Buffer
+			assert.NoError(t, Write(&b, tt.format, sampleLog()))
+			assert.Equal(t, tt.expected, b.String())
+		})
+	}
+}
+
+func Test_write_in_text_format_is_not_supported(t *testing.T) {
+	var b bytes.Buffer
+	assert.Error(t, Write(&b, Text, sampleLog()))
+}
+
+func Test_write_to_file(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log.json")
+	assert.NoError(t, WriteToFile(path, JSON, sampleLog()))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"hash": "1111"`)
+}
+
+func Test_write_to_file_in_missing_dir(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "log.json")
+	assert.Error(t, WriteToFile(path, JSON, sampleLog()))
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package export
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/journal"
+	"github.com/murex/tcr/vcs"
+)
+
+type (
+	// ChangedLines contains the number of lines changed in src and test files
+	ChangedLines struct {
+		Src  int `json:"src" yaml:"src"`
+		Test int `json:"test" yaml:"test"`
+	}
+
+	// TestStats contains info related to tests execution. Duration is expressed in seconds
+	TestStats struct {
+		Run      int     `json:"run" yaml:"run"`
+		Passed   int     `json:"passed" yaml:"passed"`
+		Failed   int     `json:"failed" yaml:"failed"`
+		Skipped  int     `json:"skipped" yaml:"skipped"`
+		Error    int     `json:"error" yaml:"error"`
+		Duration float64 `json:"duration" yaml:"duration"`
+	}
+
+	// Commit contains the exported information of a TCR commit
+	Commit struct {
+		Hash      string               `json:"hash" yaml:"hash"`
+		Timestamp time.Time            `json:"timestamp" yaml:"timestamp"`
+		Message   string               `json:"message" yaml:"message"`
+		Status    events.CommandStatus `json:"status" yaml:"status"`
+		Changes   ChangedLines         `json:"changes" yaml:"changes"`
+		Tests     TestStats            `json:"tests" yaml:"tests"`
+		Phase     events.Phase         `json:"phase,omitempty" yaml:"phase,omitempty"`
+	}
+
+	// Log is the exported TCR commit history
+	Log []Commit
+
+	// JournalEntry contains the exported information of a session journal entry.
+	// Duration is expressed in seconds
+	JournalEntry struct {
+		Timestamp time.Time            `json:"timestamp" yaml:"timestamp"`
+		Type      journal.EntryType    `json:"type" yaml:"type"`
+		Role      string               `json:"role,omitempty" yaml:"role,omitempty"`
+		Status    events.CommandStatus `json:"status,omitempty" yaml:"status,omitempty"`
+		Duration  float64              `json:"duration,omitempty" yaml:"duration,omitempty"`
+		Files     int                  `json:"files,omitempty" yaml:"files,omitempty"`
+		Changes   *ChangedLines        `json:"changes,omitempty" yaml:"changes,omitempty"`
+		Tests     *TestStats           `json:"tests,omitempty" yaml:"tests,omitempty"`
+		Phase     events.Phase         `json:"phase,omitempty" yaml:"phase,omitempty"`
+	}
+
+	// Journal is the exported session journal
+	Journal []JournalEntry
+)
+
+func newChangedLines(changes events.ChangedLines) ChangedLines {
+	return ChangedLines(changes)
+}
+
+func newTestStats(stats events.TestStats) TestStats {
+	return TestStats{
+		Run:      stats.Run,
+		Passed:   stats.Passed,
+		Failed:   stats.Failed,
+		Skipped:  stats.Skipped,
+		Error:    stats.Error,
+		Duration: stats.Duration.Seconds(),
+	}
+}
+
+// NewCommit creates the exported information of a TCR commit from its
+// VCS log item and the TCR event retrieved from its commit message
+func NewCommit(item vcs.LogItem, event events.TCREvent) Commit {
+	header, _, _ := strings.Cut(item.Message, "\n")
+	return Commit{
+		Hash:      item.Hash,
+		Timestamp: item.Timestamp,
+		Message:   header,
+		Status:    event.Status,
+		Changes:   newChangedLines(event.Changes),
+		Tests:     newTestStats(event.Tests),
+		Phase:     event.Phase,
+	}
+}
+
+// NewJournal creates the exported session journal from the provided journal entries
+func NewJournal(entries journal.Entries) Journal {
+	exported := make(Journal, 0, len(entries))
+	for _, e := range entries {
+		entry := JournalEntry{
+			Timestamp: e.Timestamp,
+			Type:      e.Type,
+			Role:      e.Role,
+			Status:    e.Status,
+			Duration:  e.Duration.Seconds(),
+			Files:     e.Files,
+			Phase:     e.Phase,
+		}
+		if e.Changes != nil {
+			changes := ChangedLines(*e.Changes)
+			entry.Changes = &changes
+		}
+		if e.Tests != nil {
+			tests := newTestStats(events.TestStats(*e.Tests))
+			entry.Tests = &tests
+		}
+		exported = append(exported, entry)
+	}
+	return exported
+}
+
+var testStatsCSVHeader = []string{
+	"src-changes", "test-changes",
+	"tests-run", "tests-passed", "tests-failed", "tests-skipped", "tests-error", "tests-duration",
+}
+
+func (c ChangedLines) csvFields() []string {
+	return []string{strconv.Itoa(c.Src), strconv.Itoa(c.Test)}
+}
+
+func (s TestStats) csvFields() []string {
+	return []string{
+		strconv.Itoa(s.Run), strconv.Itoa(s.Passed), strconv.Itoa(s.Failed),
+		strconv.Itoa(s.Skipped), strconv.Itoa(s.Error), formatFloat(s.Duration),
+	}
+}
+
+func (log Log) csvRecords() [][]string {
+	records := [][]string{append([]string{"hash", "timestamp", "message", "status"}, testStatsCSVHeader...)}
+	for _, c := range log {
+		record := []string{c.Hash, formatTime(c.Timestamp), c.Message, string(c.Status)}
+		record = append(record, c.Changes.csvFields()...)
+		record = append(record, c.Tests.csvFields()...)
+		records = append(records, record)
+	}
+	return records
+}
+
+func (j Journal) csvRecords() [][]string {
+	header := []string{"timestamp", "type", "role", "status", "duration", "files"}
+	records := [][]string{append(header, testStatsCSVHeader...)}
+	for _, e := range j {
+		record := []string{
+			formatTime(e.Timestamp), string(e.Type), e.Role, string(e.Status),
+			formatFloat(e.Duration), strconv.Itoa(e.Files),
+		}
+		changes := ChangedLines{}
+		if e.Changes != nil {
+			changes = *e.Changes
+		}
+		tests := TestStats{}
+		if e.Tests != nil {
+			tests = *e.Tests
+		}
+		record = append(record, changes.csvFields()...)
+		record = append(record, tests.csvFields()...)
+		records = append(records, record)
+	}
+	return records
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
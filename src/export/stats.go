@@ -0,0 +1,223 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package export
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/journal"
+	"github.com/murex/tcr/role"
+)
+
+type (
+	// ValueAndRatio contains a value and its percentage vs the total
+	ValueAndRatio struct {
+		Value      any `json:"value" yaml:"value"`
+		Percentage int `json:"percentage" yaml:"percentage"`
+	}
+
+	// Aggregates contains min, average and max values
+	Aggregates struct {
+		Min any `json:"min" yaml:"min"`
+		Avg any `json:"avg" yaml:"avg"`
+		Max any `json:"max" yaml:"max"`
+	}
+
+	// Evolution contains the values for the first and last records
+	Evolution struct {
+		From any `json:"from" yaml:"from"`
+		To   any `json:"to" yaml:"to"`
+	}
+
+	// Event contains the exported information of a TCR event
+	Event struct {
+		Timestamp time.Time            `json:"timestamp" yaml:"timestamp"`
+		Status    events.CommandStatus `json:"status" yaml:"status"`
+		Changes   ChangedLines         `json:"changes" yaml:"changes"`
+		Tests     TestStats            `json:"tests" yaml:"tests"`
+	}
+
+	// JournalStats contains the stats that are only available from the session journal
+	JournalStats struct {
+		Builds          int           `json:"builds" yaml:"builds"`
+		FailingBuilds   ValueAndRatio `json:"failing-builds" yaml:"failing-builds"`
+		Reverts         ValueAndRatio `json:"reverts" yaml:"reverts"`
+		AbortedCommands int           `json:"aborted-commands" yaml:"aborted-commands"`
+		DriverTurns     int           `json:"driver-turns" yaml:"driver-turns"`
+	}
+
+	// Stats contains the exported TCR stats. Durations are expressed in seconds
+	Stats struct {
+		Branch                string        `json:"branch" yaml:"branch"`
+		FirstCommit           time.Time     `json:"first-commit" yaml:"first-commit"`
+		LastCommit            time.Time     `json:"last-commit" yaml:"last-commit"`
+		Commits               int           `json:"commits" yaml:"commits"`
+		PassingCommits        ValueAndRatio `json:"passing-commits" yaml:"passing-commits"`
+		FailingCommits        ValueAndRatio `json:"failing-commits" yaml:"failing-commits"`
+		TimeSpan              float64       `json:"time-span" yaml:"time-span"`
+		TimeInGreen           ValueAndRatio `json:"time-in-green" yaml:"time-in-green"`
+		TimeInRed             ValueAndRatio `json:"time-in-red" yaml:"time-in-red"`
+		TimeBetweenCommits    Aggregates    `json:"time-between-commits" yaml:"time-between-commits"`
+		ChangesPerCommit      Aggregates    `json:"changes-per-commit" yaml:"changes-per-commit"`
+		SrcChangesPerCommit   Aggregates    `json:"src-changes-per-commit" yaml:"src-changes-per-commit"`
+		TestChangesPerCommit  Aggregates    `json:"test-changes-per-commit" yaml:"test-changes-per-commit"`
+		ChangesPerGreenCommit Aggregates    `json:"changes-per-green-commit" yaml:"changes-per-green-commit"`
+		ChangesPerRedCommit   Aggregates    `json:"changes-per-red-commit" yaml:"changes-per-red-commit"`
+		PassingTests          Evolution     `json:"passing-tests" yaml:"passing-tests"`
+		FailingTests          Evolution     `json:"failing-tests" yaml:"failing-tests"`
+		SkippedTests          Evolution     `json:"skipped-tests" yaml:"skipped-tests"`
+		TestDuration          Evolution     `json:"test-duration" yaml:"test-duration"`
+		Journal               *JournalStats `json:"journal,omitempty" yaml:"journal,omitempty"`
+		Events                []Event       `json:"events" yaml:"events"`
+	}
+)
+
+// NewStats computes the exported TCR stats for the provided list of TCR events
+func NewStats(branch string, tcrEvents events.TcrEvents) Stats {
+	stats := Stats{
+		Branch:                branch,
+		FirstCommit:           tcrEvents.StartingTime(),
+		LastCommit:            tcrEvents.EndingTime(),
+		Commits:               tcrEvents.NbRecords(),
+		PassingCommits:        newValueAndRatio(tcrEvents.PassingRecords()),
+		FailingCommits:        newValueAndRatio(tcrEvents.FailingRecords()),
+		TimeSpan:              tcrEvents.TimeSpan().Seconds(),
+		TimeInGreen:           newValueAndRatio(tcrEvents.DurationInGreen()),
+		TimeInRed:             newValueAndRatio(tcrEvents.DurationInRed()),
+		TimeBetweenCommits:    newAggregates(tcrEvents.TimeBetweenCommits()),
+		ChangesPerCommit:      newAggregates(tcrEvents.AllLineChangesPerCommit()),
+		SrcChangesPerCommit:   newAggregates(tcrEvents.SrcLineChangesPerCommit()),
+		TestChangesPerCommit:  newAggregates(tcrEvents.TestLineChangesPerCommit()),
+		ChangesPerGreenCommit: newAggregates(tcrEvents.AllLineChangesPerGreenCommit()),
+		ChangesPerRedCommit:   newAggregates(tcrEvents.AllLineChangesPerRedCommit()),
+		PassingTests:          newEvolution(tcrEvents.PassingTestsEvolution()),
+		FailingTests:          newEvolution(tcrEvents.FailingTestsEvolution()),
+		SkippedTests:          newEvolution(tcrEvents.SkippedTestsEvolution()),
+		TestDuration:          newEvolution(tcrEvents.TestDurationEvolution()),
+		Events:                make([]Event, 0, len(tcrEvents)),
+	}
+	for _, e := range tcrEvents {
+		stats.Events = append(stats.Events, Event{
+			Timestamp: e.Timestamp,
+			Status:    e.Event.Status,
+			Changes:   newChangedLines(e.Event.Changes),
+			Tests:     newTestStats(e.Event.Tests),
+		})
+	}
+	return stats
+}
+
+// NewJournalStats computes the exported TCR stats for the provided session journal entries,
+// including the ones that cannot be retrieved from VCS history
+func NewJournalStats(branch string, entries journal.Entries) Stats {
+	stats := NewStats(branch, entries.ToTcrEvents())
+	builds := entries.Count(journal.Build)
+	stats.Journal = &JournalStats{
+		Builds: builds,
+		FailingBuilds: newValueAndRatio(
+			events.NewIntValueAndRatio(entries.Count(journal.Build, events.StatusFail), builds)),
+		Reverts: newValueAndRatio(
+			events.NewIntValueAndRatio(entries.Count(journal.Revert), entries.Count(journal.Test))),
+		AbortedCommands: entries.Count(journal.Abort),
+		DriverTurns:     entries.CountRoleStarts(role.Driver{}.Name()),
+	}
+	return stats
+}
+
+func newValueAndRatio(v events.ValueAndRatio) ValueAndRatio {
+	return ValueAndRatio{Value: exportValue(v.Value()), Percentage: v.Percentage()}
+}
+
+func newAggregates(a events.Aggregates) Aggregates {
+	return Aggregates{Min: exportValue(a.Min()), Avg: exportValue(a.Avg()), Max: exportValue(a.Max())}
+}
+
+func newEvolution(e events.ValueEvolution) Evolution {
+	return Evolution{From: exportValue(e.From()), To: exportValue(e.To())}
+}
+
+// exportValue converts durations into seconds so that all exported durations
+// can be processed as numbers
+func exportValue(v any) any {
+	if d, ok := v.(time.Duration); ok {
+		return d.Seconds()
+	}
+	return v
+}
+
+func (s Stats) csvRecords() [][]string {
+	records := [][]string{{"metric", "value"}}
+	add := func(name string, value any) {
+		records = append(records, []string{name, formatValue(value)})
+	}
+	addValueAndRatio := func(name string, v ValueAndRatio) {
+		add(name, v.Value)
+		add(name+"-percentage", v.Percentage)
+	}
+	addAggregates := func(name string, a Aggregates) {
+		add(name+"-min", a.Min)
+		add(name+"-avg", a.Avg)
+		add(name+"-max", a.Max)
+	}
+	addEvolution := func(name string, e Evolution) {
+		add(name+"-from", e.From)
+		add(name+"-to", e.To)
+	}
+
+	add("branch", s.Branch)
+	add("first-commit", formatTime(s.FirstCommit))
+	add("last-commit", formatTime(s.LastCommit))
+	add("commits", s.Commits)
+	addValueAndRatio("passing-commits", s.PassingCommits)
+	addValueAndRatio("failing-commits", s.FailingCommits)
+	add("time-span", s.TimeSpan)
+	addValueAndRatio("time-in-green", s.TimeInGreen)
+	addValueAndRatio("time-in-red", s.TimeInRed)
+	addAggregates("time-between-commits", s.TimeBetweenCommits)
+	addAggregates("changes-per-commit", s.ChangesPerCommit)
+	addAggregates("src-changes-per-commit", s.SrcChangesPerCommit)
+	addAggregates("test-changes-per-commit", s.TestChangesPerCommit)
+	addAggregates("changes-per-green-commit", s.ChangesPerGreenCommit)
+	addAggregates("changes-per-red-commit", s.ChangesPerRedCommit)
+	addEvolution("passing-tests", s.PassingTests)
+	addEvolution("failing-tests", s.FailingTests)
+	addEvolution("skipped-tests", s.SkippedTests)
+	addEvolution("test-duration", s.TestDuration)
+	if s.Journal != nil {
+		add("builds", s.Journal.Builds)
+		addValueAndRatio("failing-builds", s.Journal.FailingBuilds)
+		addValueAndRatio("reverts", s.Journal.Reverts)
+		add("aborted-commands", s.Journal.AbortedCommands)
+		add("driver-turns", s.Journal.DriverTurns)
+	}
+	return records
+}
+
+func formatValue(v any) string {
+	if f, ok := v.(float64); ok {
+		return formatFloat(f)
+	}
+	return fmt.Sprint(v)
+}
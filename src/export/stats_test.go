@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/journal"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_new_stats(t *testing.T) {
+	t1 := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	t2 := t1.Add(90 * time.Second)
+	tcrEvents := events.TcrEvents{
+		events.NewDatedTcrEvent(t1, events.NewTCREvent(events.StatusFail,
+			events.NewChangedLines(1, 0), events.NewTestStats(2, 1, 1, 0, 0, time.Second))),
+		events.NewDatedTcrEvent(t2, events.NewTCREvent(events.StatusPass,
+			events.NewChangedLines(3, 2), events.NewTestStats(2, 2, 0, 0, 0, 2*time.Second))),
+	}
+	stats := NewStats("some-branch", tcrEvents)
+
+	assert.Equal(t, "some-branch", stats.Branch)
+	assert.Equal(t, t1, stats.FirstCommit)
+	assert.Equal(t, t2, stats.LastCommit)
+	assert.Equal(t, 2, stats.Commits)
+	assert.Equal(t, ValueAndRatio{Value: 1, Percentage: 50}, stats.PassingCommits)
+	assert.Equal(t, 90.0, stats.TimeSpan)
+	assert.Equal(t, Aggregates{Min: 90.0, Avg: 90.0, Max: 90.0}, stats.TimeBetweenCommits)
+	assert.Equal(t, Aggregates{Min: 1, Avg: 3.0, Max: 5}, stats.ChangesPerCommit)
+	assert.Equal(t, Evolution{From: 1, To: 2}, stats.PassingTests)
+	assert.Equal(t, Evolution{From: 1.0, To: 2.0}, stats.TestDuration)
+	assert.Nil(t, stats.Journal)
+	assert.Len(t, stats.Events, 2)
+	assert.Equal(t, Event{
+		Timestamp: t2,
+		Status:    events.StatusPass,
+		Changes:   ChangedLines{Src: 3, Test: 2},
+		Tests:     TestStats{Run: 2, Passed: 2, Duration: 2},
+	}, stats.Events[1])
+}
+
+func Test_new_journal_stats(t *testing.T) {
+	entries := journal.Entries{
+		{Type: journal.RoleStart, Role: "driver"},
+		{Type: journal.Build, Status: events.StatusFail},
+		{Type: journal.Build, Status: events.StatusPass},
+		{Type: journal.Test, Status: events.StatusFail},
+		{Type: journal.Revert, Files: 1},
+		{Type: journal.Abort},
+	}
+	stats := NewJournalStats("some-branch", entries)
+
+	assert.Equal(t, 1, stats.Commits)
+	assert.Equal(t, &JournalStats{
+		Builds:          2,
+		FailingBuilds:   ValueAndRatio{Value: 1, Percentage: 50},
+		Reverts:         ValueAndRatio{Value: 1, Percentage: 100},
+		AbortedCommands: 1,
+		DriverTurns:     1,
+	}, stats.Journal)
+}
+
+func Test_stats_csv_records(t *testing.T) {
+	records := NewJournalStats("some-branch", journal.Entries{
+		{Type: journal.Build, Status: events.StatusFail},
+	}).csvRecords()
+
+	assert.Equal(t, []string{"metric", "value"}, records[0])
+	assert.Contains(t, records, []string{"branch", "some-branch"})
+	assert.Contains(t, records, []string{"time-between-commits-avg", "0"})
+	assert.Contains(t, records, []string{"failing-builds", "1"})
+	assert.Contains(t, records, []string{"failing-builds-percentage", "100"})
+}
+
+func Test_new_journal(t *testing.T) {
+	timestamp := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	entries := journal.Entries{
+		{Timestamp: timestamp, Type: journal.Build, Status: events.StatusPass, Duration: 500 * time.Millisecond},
+		{
+			Timestamp: timestamp, Type: journal.Test, Status: events.StatusPass,
+			Changes: &journal.ChangedLines{Src: 1, Test: 2},
+			Tests:   &journal.TestStats{Run: 1, Passed: 1, Duration: time.Second},
+		},
+	}
+	expected := Journal{
+		{Timestamp: timestamp, Type: journal.Build, Status: events.StatusPass, Duration: 0.5},
+		{
+			Timestamp: timestamp, Type: journal.Test, Status: events.StatusPass,
+			Changes: &ChangedLines{Src: 1, Test: 2},
+			Tests:   &TestStats{Run: 1, Passed: 1, Duration: 1},
+		},
+	}
+	assert.Equal(t, expected, NewJournal(entries))
+	assert.Equal(t, [][]string{
+		{"timestamp", "type", "role", "status", "duration", "files",
+			"src-changes", "test-changes", "tests-run", "tests-passed", "tests-failed",
+			"tests-skipped", "tests-error", "tests-duration"},
+		{"2026-10-18T09:30:00Z", "build", "", "pass", "0.5", "0", "0", "0", "0", "0", "0", "0", "0", "0"},
+		{"2026-10-18T09:30:00Z", "test", "", "pass", "0", "0", "1", "2", "1", "1", "0", "0", "0", "1"},
+	}, expected.csvRecords())
+}
@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package filesystem
+
+import (
+	"bufio"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+// ignoreFileNames are the names of the files listing patterns of files and directories
+// that are not watched. They follow .gitignore syntax and apply to the directory
+// containing them and its sub-directories
+var ignoreFileNames = []string{".gitignore", ".tcrignore"}
+
+// alwaysIgnored are the directories that are never watched
+var alwaysIgnored = []string{".git", ".hg", ".jj", ".svn"}
+
+// ignoreRules tells which files and directories under a base directory are not watched
+type ignoreRules struct {
+	baseDir string
+	matcher gitignore.Matcher
+}
+
+// isIgnoreFile indicates if the provided path is the one of an ignore file
+func isIgnoreFile(path string) bool {
+	return slices.Contains(ignoreFileNames, filepath.Base(path))
+}
+
+// loadIgnoreRules reads the ignore files located in baseDir and its sub-directories.
+// Sub-directories that are ignored are not searched for ignore files
+func loadIgnoreRules(baseDir string) *ignoreRules {
+	rules := &ignoreRules{baseDir: baseDir, matcher: gitignore.NewMatcher(nil)}
+	var patterns []gitignore.Pattern
+	_ = filepath.WalkDir(baseDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil
+		}
+		if path != baseDir && rules.isIgnored(path, true) {
+			return filepath.SkipDir
+		}
+		domain := rules.split(path)
+		for _, name := range ignoreFileNames {
+			patterns = append(patterns, readIgnorePatterns(filepath.Join(path, name), domain)...)
+		}
+		rules.matcher = gitignore.NewMatcher(patterns)
+		return nil
+	})
+	return rules
+}
+
+// readIgnorePatterns returns the patterns listed in the provided ignore file
+func readIgnorePatterns(path string, domain []string) (patterns []gitignore.Pattern) {
+	f, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, domain))
+	}
+	return patterns
+}
+
+// split returns the path elements of the provided path relative to the base directory,
+// or nil if the path is located outside the base directory
+func (r *ignoreRules) split(path string) []string {
+	rel, err := filepath.Rel(r.baseDir, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil
+	}
+	return strings.Split(rel, string(filepath.Separator))
+}
+
+// isIgnored indicates if the provided file or directory is not to be watched
+func (r *ignoreRules) isIgnored(path string, isDir bool) bool {
+	elements := r.split(path)
+	if len(elements) == 0 {
+		return false
+	}
+	for _, element := range elements[:len(elements)-1] {
+		if slices.Contains(alwaysIgnored, element) {
+			return true
+		}
+	}
+	if isDir && slices.Contains(alwaysIgnored, elements[len(elements)-1]) {
+		return true
+	}
+	return r.matcher.Match(elements, isDir)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package filesystem
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ignore_rules(t *testing.T) {
+	baseDir := t.TempDir()
+	_ = os.MkdirAll(filepath.Join(baseDir, "frontend"), 0700)
+	_ = os.WriteFile(filepath.Join(baseDir, ".gitignore"), []byte("# comment\n*.log\nbuild/\n"), 0600)
+	_ = os.WriteFile(filepath.Join(baseDir, ".tcrignore"), []byte("generated/\n"), 0600)
+	_ = os.WriteFile(filepath.Join(baseDir, "frontend", ".gitignore"), []byte("node_modules/\n"), 0600)
+	rules := loadIgnoreRules(baseDir)
+
+	testFlags := []struct {
+		desc     string
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		{"regular file", "src/Main.java", false, false},
+		{"file matching a pattern", "src/debug.log", false, true},
+		{"ignored directory", "build", true, true},
+		{"file in an ignored directory", "build/Main.class", false, true},
+		{"directory ignored in .tcrignore", "src/generated", true, true},
+		{"directory ignored in a sub-directory", "frontend/node_modules", true, true},
+		{"directory with same name outside sub-directory", "node_modules", true, false},
+		{"vcs directory", ".git", true, true},
+		{"file in vcs directory", ".git/index", false, true},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rules.isIgnored(filepath.Join(baseDir, filepath.FromSlash(tt.path)), tt.isDir))
+		})
+	}
+}
+
+func Test_files_outside_base_directory_are_not_ignored(t *testing.T) {
+	baseDir := t.TempDir()
+	_ = os.WriteFile(filepath.Join(baseDir, ".gitignore"), []byte("*.log\n"), 0600)
+	rules := loadIgnoreRules(filepath.Join(baseDir, "project"))
+	assert.False(t, rules.isIgnored(filepath.Join(baseDir, "debug.log"), false))
+}
+
+func Test_is_ignore_file(t *testing.T) {
+	assert.True(t, isIgnoreFile(filepath.Join("src", ".gitignore")))
+	assert.True(t, isIgnoreFile(filepath.Join("src", ".tcrignore")))
+	assert.False(t, isIgnoreFile(filepath.Join("src", "Main.java")))
+}
@@ -27,6 +27,8 @@ package filesystem
 type SourceTree interface {
 	GetBaseDir() string
 	IsValid() bool
+	SetWatchSettings(settings WatchSettings)
+	Rewrite(operation func())
 	Watch(
 		dirList []string,
 		filenameMatcher func(filename string) bool,
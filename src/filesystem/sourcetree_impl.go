@@ -24,8 +24,10 @@ package filesystem
 
 import (
 	"errors"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/murex/tcr/report"
@@ -33,16 +35,25 @@ import (
 
 // SourceTreeImpl is the implementation of Source Tree interface
 type SourceTreeImpl struct {
-	baseDir string
-	valid   bool
+	baseDir  string
+	valid    bool
+	settings WatchSettings
+	// watcher is created on first watch, then kept for the rest of the session
 	watcher *fsnotify.Watcher
 	matcher func(filename string) bool
+	ignore  *ignoreRules
+	// dirList contains the directories watched by the last watch
+	dirList []string
+	// states contains the state of watched files when the last watch reported changes,
+	// updated with the changes made by TCR itself since then. Changes made in the
+	// meantime by anyone else are reported as soon as the next watch starts
+	states map[string]fileState
 }
 
 // New creates a new instance of source tree implementation with a root directory set as dir.
 // The method returns an error if the root directory does not exist or cannot be accessed.
 func New(dir string) (SourceTree, error) {
-	var impl = &SourceTreeImpl{}
+	var impl = &SourceTreeImpl{settings: DefaultWatchSettings()}
 	var err error
 	impl.baseDir, err = checkDir(dir)
 	if err != nil {
@@ -78,76 +89,197 @@ func (st *SourceTreeImpl) GetBaseDir() string {
 	return st.baseDir
 }
 
+// SetWatchSettings sets how the source tree is watched for changes
+func (st *SourceTreeImpl) SetWatchSettings(settings WatchSettings) {
+	st.settings = settings
+}
+
+// Rewrite runs an operation rewriting source tree files on TCR's behalf, such as
+// formatting or reverting changes. The files changed by the operation are not
+// reported by the next watch, unless they are changed again in the meantime
+func (st *SourceTreeImpl) Rewrite(operation func()) {
+	if st.states == nil {
+		operation()
+		return
+	}
+	before := st.scan(st.dirList, false)
+	operation()
+	after := st.scan(st.dirList, false)
+	for _, path := range changedFiles(before, after) {
+		if state, found := after[path]; found {
+			st.states[path] = state
+		} else {
+			delete(st.states, path)
+		}
+	}
+}
+
 // Watch starts watching for changes on a list of directories. The files under watch are the ones
-// satisfying filenameMatcher() function. The watch lasts until either a watched file has been modified,
-// or if an interruption is sent through the interrupt channel
+// satisfying filenameMatcher() function and not excluded by ignore files. The watch lasts until
+// either watched files have been modified and no other change occurred during the debounce
+// period, or if an interruption is sent through the interrupt channel.
+// Changes made since the previous watch, other than those made through Rewrite, are reported
+// right away
 func (st *SourceTreeImpl) Watch(
 	dirList []string,
 	filenameMatcher func(filename string) bool,
 	interrupt <-chan bool,
-) bool {
-	// The file watcher
-	st.watcher, _ = fsnotify.NewWatcher()
-	defer func(watcher *fsnotify.Watcher) {
-		err := watcher.Close()
-		if err != nil {
-			report.PostError(err)
-		}
-	}(st.watcher)
-
+) (changed bool) {
 	// The filename matcher ensures that we watch only interesting files
 	st.matcher = filenameMatcher
+	if st.ignore == nil {
+		st.ignore = loadIgnoreRules(st.baseDir)
+	}
+	st.dirList = dirList
+	defer func() {
+		// Keep track of the state of watched files when changes are reported
+		st.states = nil
+		if changed {
+			st.states = st.scan(dirList, false)
+		}
+	}()
 
-	// Used to notify if changes were detected on relevant files
-	changesDetected := make(chan bool)
+	if st.settings.PollingPeriod <= 0 {
+		if err := st.startWatcher(); err != nil {
+			report.PostWarning("Cannot watch filesystem notifications (", err, "): falling back to polling")
+			st.settings.PollingPeriod = defaultWatchPollingPeriod
+		}
+	}
+	if st.settings.PollingPeriod > 0 {
+		return st.poll(dirList, interrupt)
+	}
 
 	// We recursively watch all subdirectories for all the provided directories
 	for _, dir := range dirList {
 		report.PostText("- watching ", dir)
-		_ = filepath.Walk(dir, st.watchFile)
+		st.watchDir(dir)
+	}
+	// Pending events are superseded by the comparison with the state of
+	// watched files at the end of the previous watch
+	st.drainEvents()
+	pending, found := st.pendingChange(dirList)
+	return st.waitForChanges(pending, found, interrupt)
+}
+
+// pendingChange returns the first watched file changed since the previous watch
+// reported changes, other than through Rewrite
+func (st *SourceTreeImpl) pendingChange(dirList []string) (string, bool) {
+	if st.states == nil {
+		return "", false
+	}
+	return firstChange(st.states, st.scan(dirList, false))
+}
+
+// startWatcher creates the filesystem watcher if it does not exist yet
+func (st *SourceTreeImpl) startWatcher() (err error) {
+	if st.watcher == nil {
+		st.watcher, err = fsnotify.NewBufferedWatcher(watcherBufferSize)
+	}
+	return err
+}
+
+// watchDir adds the provided directory and its sub-directories to the watcher,
+// except for ignored ones. Returns true if the directory contains matching files
+func (st *SourceTreeImpl) watchDir(dir string) (matchingFiles bool) {
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			report.PostWarning(err)
+			return err
+		}
+		if !d.IsDir() {
+			matchingFiles = matchingFiles || st.isWatched(path, false)
+			return nil
+		}
+		if path != dir && st.ignore.isIgnored(path, true) {
+			return filepath.SkipDir
+		}
+		if err := st.watcher.Add(path); err != nil {
+			report.PostError(err)
+			return err
+		}
+		return nil
+	})
+	return matchingFiles
+}
+
+// isWatched indicates if changes on the provided file or directory are relevant
+func (st *SourceTreeImpl) isWatched(path string, isDir bool) bool {
+	return !st.ignore.isIgnored(path, isDir) && (isDir || st.matcher(path))
+}
+
+// drainEvents discards pending filesystem events
+func (st *SourceTreeImpl) drainEvents() {
+	for {
+		select {
+		case <-st.watcher.Events:
+		case <-st.watcher.Errors:
+		default:
+			return
+		}
 	}
+}
 
-	// Event handling goroutine
-	go func() {
-		for {
-			select {
-			case event := <-st.watcher.Events:
+// waitForChanges waits until relevant changes are followed by a quiet period
+// lasting at least the debounce duration. Pending changes found beforehand, if any,
+// are reported right away
+func (st *SourceTreeImpl) waitForChanges(pending string, found bool, interrupt <-chan bool) bool {
+	var quiet <-chan time.Time
+	if found {
+		report.PostText("-> ", pending)
+		quiet = time.After(st.settings.Debounce)
+	}
+	for {
+		select {
+		case event, ok := <-st.watcher.Events:
+			if !ok {
+				return false
+			}
+			if st.isRelevant(event) && !st.isStale(event.Name) {
 				report.PostText("-> ", event.Name)
-				changesDetected <- true
-				return
-			case err := <-st.watcher.Errors:
+				quiet = time.After(st.settings.Debounce)
+			}
+		case err, ok := <-st.watcher.Errors:
+			if ok {
 				report.PostWarning(err)
-				changesDetected <- false
-				return
-			case <-interrupt:
-				changesDetected <- false
-				return
 			}
+			return false
+		case <-quiet:
+			return true
+		case <-interrupt:
+			return false
 		}
-	}()
-
-	return <-changesDetected
+	}
 }
 
-// watchFile gets run as a walk func, searching for files to watch
-func (st *SourceTreeImpl) watchFile(path string, fi os.FileInfo, err error) error {
+// isStale indicates if the file concerned by a filesystem event is still in the state
+// known at the end of the previous watch, which happens when the event is delivered
+// late for a change already accounted for, such as a change made through Rewrite
+func (st *SourceTreeImpl) isStale(path string) bool {
+	if st.states == nil {
+		return false
+	}
+	known, wasKnown := st.states[path]
+	info, err := os.Stat(path)
 	if err != nil {
-		report.PostWarning(err)
-		return err
+		return !wasKnown
 	}
+	return wasKnown && !info.IsDir() && known == fileState{modTime: info.ModTime(), size: info.Size()}
+}
 
-	// We don't watch directories themselves
-	if fi.IsDir() {
-		return nil
+// isRelevant indicates if the provided filesystem event corresponds to a change on a watched
+// file. Newly created directories are added to the watcher, and ignore rules are reloaded
+// when an ignore file changes
+func (st *SourceTreeImpl) isRelevant(event fsnotify.Event) bool {
+	if event.Op == fsnotify.Chmod {
+		return false
 	}
-
-	// If the filename matches our filter, we add it to the watching list
-	if st.matcher(path) {
-		err2 := st.watcher.Add(path)
-		if err2 != nil {
-			report.PostError(err2)
+	if isIgnoreFile(event.Name) {
+		st.ignore = loadIgnoreRules(st.baseDir)
+	}
+	if event.Has(fsnotify.Create) {
+		if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+			return !st.ignore.isIgnored(event.Name, true) && st.watchDir(event.Name)
 		}
-		return err2
 	}
-	return nil
+	return st.isWatched(event.Name, false)
 }
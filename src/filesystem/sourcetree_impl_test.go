@@ -185,3 +185,167 @@ func Test_watch_reports_a_warning_on_missing_directory(t *testing.T) {
 		return msg.Type.Category == report.Warning
 	})
 }
+
+// watchInBackground starts watching srcDir for changes on any file, using the provided
+// settings. The returned channel reports the outcome of the watch
+func watchInBackground(tree SourceTree, settings WatchSettings, srcDir string, stop <-chan bool) <-chan bool {
+	tree.SetWatchSettings(settings)
+	changeDetected := make(chan bool)
+	go func() {
+		changeDetected <- tree.Watch([]string{srcDir}, func(_ string) bool { return true }, stop)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	return changeDetected
+}
+
+func initWatchTestDir(t *testing.T, files ...string) (baseDir string, srcDir string) {
+	t.Helper()
+	baseDir = t.TempDir()
+	srcDir = filepath.Join(baseDir, "src")
+	_ = os.Mkdir(srcDir, 0700)
+	for _, file := range files {
+		path := filepath.Join(baseDir, filepath.FromSlash(file))
+		_ = os.MkdirAll(filepath.Dir(path), 0700)
+		_ = os.WriteFile(path, []byte("some contents\n"), 0600)
+	}
+	return baseDir, srcDir
+}
+
+func Test_watch_coalesces_bursts_of_changes(t *testing.T) {
+	helpers.SkipOnWindows(t)
+	baseDir, srcDir := initWatchTestDir(t, "src/file1.txt", "src/file2.txt")
+	tree, _ := New(baseDir)
+	stop := make(chan bool)
+	const debounce = 200 * time.Millisecond
+
+	changeDetected := watchInBackground(tree, WatchSettings{Debounce: debounce}, srcDir, stop)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_ = os.WriteFile(filepath.Join(srcDir, "file1.txt"), []byte("burst\n"), 0600)
+		_ = os.WriteFile(filepath.Join(srcDir, "file2.txt"), []byte("burst\n"), 0600)
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.True(t, <-changeDetected)
+	assert.GreaterOrEqual(t, time.Since(start), debounce)
+
+	// The burst must not trigger another notification
+	changeDetected = watchInBackground(tree, WatchSettings{Debounce: debounce}, srcDir, stop)
+	time.Sleep(2 * debounce)
+	stop <- true
+	assert.False(t, <-changeDetected)
+}
+
+func Test_watch_detects_changes_in_new_directories(t *testing.T) {
+	helpers.SkipOnWindows(t)
+	baseDir, srcDir := initWatchTestDir(t)
+	tree, _ := New(baseDir)
+	stop := make(chan bool)
+
+	changeDetected := watchInBackground(tree, WatchSettings{}, srcDir, stop)
+	newDir := filepath.Join(srcDir, "new-package")
+	_ = os.Mkdir(newDir, 0700)
+	time.Sleep(50 * time.Millisecond)
+	_ = os.WriteFile(filepath.Join(newDir, "file.txt"), []byte("some contents\n"), 0600)
+	assert.True(t, <-changeDetected)
+}
+
+func Test_watch_ignores_changes_on_files_listed_in_ignore_files(t *testing.T) {
+	for _, ignoreFile := range ignoreFileNames {
+		t.Run(ignoreFile, func(t *testing.T) {
+			baseDir, srcDir := initWatchTestDir(t, "src/generated/file.txt")
+			_ = os.WriteFile(filepath.Join(baseDir, ignoreFile), []byte("generated/\n"), 0600)
+			tree, _ := New(baseDir)
+			stop := make(chan bool)
+
+			changeDetected := watchInBackground(tree, WatchSettings{}, srcDir, stop)
+			_ = os.WriteFile(filepath.Join(srcDir, "generated", "file.txt"), []byte("other contents\n"), 0600)
+			time.Sleep(100 * time.Millisecond)
+			stop <- true
+			assert.False(t, <-changeDetected)
+		})
+	}
+}
+
+func Test_watch_can_detect_changes_when_polling(t *testing.T) {
+	baseDir, srcDir := initWatchTestDir(t, "src/file.txt")
+	tree, _ := New(baseDir)
+	stop := make(chan bool)
+
+	changeDetected := watchInBackground(tree, WatchSettings{PollingPeriod: 10 * time.Millisecond}, srcDir, stop)
+	_ = os.WriteFile(filepath.Join(srcDir, "file.txt"), []byte("some longer contents\n"), 0600)
+	assert.True(t, <-changeDetected)
+}
+
+func Test_watch_polling_can_be_stopped_on_request(t *testing.T) {
+	baseDir, srcDir := initWatchTestDir(t, "src/file.txt")
+	tree, _ := New(baseDir)
+	stop := make(chan bool)
+
+	changeDetected := watchInBackground(tree, WatchSettings{PollingPeriod: 10 * time.Millisecond}, srcDir, stop)
+	stop <- true
+	assert.False(t, <-changeDetected)
+}
+
+func Test_watch_reports_changes_saved_between_two_watches(t *testing.T) {
+	tests := []struct {
+		desc     string
+		settings WatchSettings
+	}{
+		{"with file system notifications", WatchSettings{}},
+		{"when polling", WatchSettings{PollingPeriod: 10 * time.Millisecond}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			helpers.SkipOnWindows(t)
+			baseDir, srcDir := initWatchTestDir(t, "src/file.txt")
+			tree, _ := New(baseDir)
+			stop := make(chan bool)
+
+			changeDetected := watchInBackground(tree, tt.settings, srcDir, stop)
+			_ = os.WriteFile(filepath.Join(srcDir, "file.txt"), []byte("first save\n"), 0600)
+			assert.True(t, <-changeDetected)
+
+			// Saved while TCR runs build and tests, i.e. when nobody is watching
+			_ = os.WriteFile(filepath.Join(srcDir, "file.txt"), []byte("second save\n"), 0600)
+			changeDetected = watchInBackground(tree, tt.settings, srcDir, stop)
+			select {
+			case detected := <-changeDetected:
+				assert.True(t, detected)
+			case <-time.After(time.Second):
+				stop <- true
+				<-changeDetected
+				t.Error("change saved between two watches was not reported")
+			}
+		})
+	}
+}
+
+func Test_watch_ignores_changes_made_through_rewrite(t *testing.T) {
+	tests := []struct {
+		desc     string
+		settings WatchSettings
+	}{
+		{"with file system notifications", WatchSettings{}},
+		{"when polling", WatchSettings{PollingPeriod: 10 * time.Millisecond}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			helpers.SkipOnWindows(t)
+			baseDir, srcDir := initWatchTestDir(t, "src/file.txt")
+			tree, _ := New(baseDir)
+			stop := make(chan bool)
+
+			changeDetected := watchInBackground(tree, tt.settings, srcDir, stop)
+			_ = os.WriteFile(filepath.Join(srcDir, "file.txt"), []byte("first save\n"), 0600)
+			assert.True(t, <-changeDetected)
+
+			tree.Rewrite(func() {
+				_ = os.WriteFile(filepath.Join(srcDir, "file.txt"), []byte("reverted contents\n"), 0600)
+			})
+			changeDetected = watchInBackground(tree, tt.settings, srcDir, stop)
+			time.Sleep(200 * time.Millisecond)
+			stop <- true
+			assert.False(t, <-changeDetected)
+		})
+	}
+}
@@ -44,6 +44,15 @@ func (fst FakeSourceTree) IsValid() bool {
 	return true
 }
 
+// SetWatchSettings does nothing
+func (FakeSourceTree) SetWatchSettings(_ WatchSettings) {
+}
+
+// Rewrite runs the provided operation
+func (FakeSourceTree) Rewrite(operation func()) {
+	operation()
+}
+
 // Watch is a fake implementation of Watch command (not usable as is)
 func (fst FakeSourceTree) Watch(_ []string, _ func(filename string) bool, _ <-chan bool) bool {
 	fakeChannel := make(chan bool)
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package filesystem
+
+import (
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/murex/tcr/report"
+)
+
+const (
+	// DefaultWatchDebounce is the default quiet period following the last change
+	// before changes are reported
+	DefaultWatchDebounce = 300 * time.Millisecond
+	// defaultWatchPollingPeriod is the polling period used when filesystem
+	// notifications are not available
+	defaultWatchPollingPeriod = time.Second
+	// watcherBufferSize is the number of filesystem events that can be queued
+	// while no watch is ongoing
+	watcherBufferSize = 1024
+)
+
+// WatchSettings tells how a source tree is watched for changes
+type WatchSettings struct {
+	// Debounce is the quiet period following the last change before changes are
+	// reported, so that bursts of changes trigger a single notification
+	Debounce time.Duration
+	// PollingPeriod is the period between two scans of the watched directories. Filesystem
+	// notifications are used instead of polling when it's zero
+	PollingPeriod time.Duration
+}
+
+// DefaultWatchSettings returns the settings used when none are provided
+func DefaultWatchSettings() WatchSettings {
+	return WatchSettings{Debounce: DefaultWatchDebounce}
+}
+
+// fileState is the state of a file used for detecting changes when polling
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// poll scans the watched directories periodically until changes are detected
+// and the debounce period has elapsed since the last one. The first scan is compared
+// with the state of watched files at the end of the previous watch
+func (st *SourceTreeImpl) poll(dirList []string, interrupt <-chan bool) bool {
+	for _, dir := range dirList {
+		report.PostText("- watching ", dir, " (polling every ", st.settings.PollingPeriod, ")")
+	}
+	ticker := time.NewTicker(st.settings.PollingPeriod)
+	defer ticker.Stop()
+
+	previous := st.scan(dirList, true)
+	var lastChange time.Time
+	if st.states != nil {
+		if changed, found := firstChange(st.states, previous); found {
+			report.PostText("-> ", changed)
+			lastChange = time.Now()
+		}
+	}
+	for {
+		select {
+		case <-ticker.C:
+			current := st.scan(dirList, false)
+			if changed, found := firstChange(previous, current); found {
+				report.PostText("-> ", changed)
+				previous = current
+				lastChange = time.Now()
+			} else if !lastChange.IsZero() && time.Since(lastChange) >= st.settings.Debounce {
+				return true
+			}
+		case <-interrupt:
+			return false
+		}
+	}
+}
+
+// scan returns the state of the watched files located in the provided directories
+func (st *SourceTreeImpl) scan(dirList []string, reportErrors bool) map[string]fileState {
+	files := make(map[string]fileState)
+	for _, dir := range dirList {
+		_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if reportErrors {
+					report.PostWarning(err)
+				}
+				return err
+			}
+			if d.IsDir() {
+				if path != dir && st.ignore.isIgnored(path, true) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !st.isWatched(path, false) {
+				return nil
+			}
+			if info, err := d.Info(); err == nil {
+				files[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+			}
+			return nil
+		})
+	}
+	return files
+}
+
+// firstChange returns the first file found to be created, modified or removed
+// between the previous and current scans
+func firstChange(previous, current map[string]fileState) (string, bool) {
+	if changed := changedFiles(previous, current); len(changed) > 0 {
+		return changed[0], true
+	}
+	return "", false
+}
+
+// changedFiles returns the files created, modified or removed between the previous
+// and current scans, sorted by path
+func changedFiles(previous, current map[string]fileState) (changed []string) {
+	for path, state := range current {
+		if before, found := previous[path]; !found || before != state {
+			changed = append(changed, path)
+		}
+	}
+	for path := range previous {
+		if _, found := current[path]; !found {
+			changed = append(changed, path)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package hook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/murex/tcr/events"
+)
+
+// Name is the name of a TCR lifecycle hook
+type Name string
+
+// List of supported lifecycle hooks
+const (
+	PreBuild     Name = "pre-build"
+	PostTest     Name = "post-test"
+	PreCommit    Name = "pre-commit"
+	PostCommit   Name = "post-commit"
+	PostRevert   Name = "post-revert"
+	OnRoleChange Name = "on-role-change"
+)
+
+// DefaultTimeout is the maximum time given to a hook command for completing
+const DefaultTimeout = 5 * time.Minute
+
+// Names returns the list of supported lifecycle hooks, in the order they are
+// triggered during a TCR cycle
+func Names() []Name {
+	return []Name{PreBuild, PostTest, PreCommit, PostCommit, PostRevert, OnRoleChange}
+}
+
+// Runner runs the shell commands associated to lifecycle hooks.
+// A nil Runner has no hook set
+type Runner struct {
+	commands map[Name]string
+	dir      string
+	timeout  time.Duration
+}
+
+// NewRunner creates a runner for the provided hook commands, indexed by hook name.
+// Hook commands are run from the provided directory
+func NewRunner(commands map[string]string, dir string) *Runner {
+	r := Runner{commands: make(map[Name]string), dir: dir, timeout: DefaultTimeout}
+	for name, cmd := range commands {
+		if strings.TrimSpace(cmd) != "" {
+			r.commands[Name(name)] = cmd
+		}
+	}
+	return &r
+}
+
+// IsSet indicates if a command is associated to the provided hook
+func (r *Runner) IsSet(name Name) bool {
+	return r != nil && r.commands[name] != ""
+}
+
+// Run runs the command associated to the provided hook, if any. The TCR event is passed
+// to the command as JSON on its standard input. The hook name and the provided
+// environment variables (KEY=value) are added to the command's environment.
+// Returns the command output, and an error if the command fails or exits with a non-zero code
+func (r *Runner) Run(name Name, event events.TCREvent, env ...string) (string, error) {
+	if !r.IsSet(name) {
+		return "", nil
+	}
+	input, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	shell, args := shellCommand(runtime.GOOS, r.commands[name])
+	cmd := exec.CommandContext(ctx, shell, args...) //nolint:gosec // hook commands are set by the user
+	cmd.Dir = r.dir
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Env = append(append(os.Environ(), "TCR_HOOK="+string(name)), env...)
+	// Do not wait for processes spawned by the hook command once it is killed
+	cmd.WaitDelay = time.Second
+	output, err := cmd.CombinedOutput()
+	out := strings.TrimSpace(string(output))
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return out, fmt.Errorf("%s hook timed out after %v", name, r.timeout)
+	}
+	if err != nil {
+		return out, fmt.Errorf("%s hook failed: %w", name, err)
+	}
+	return out, nil
+}
+
+// shellCommand returns the shell and arguments used to run a hook command on the provided OS
+func shellCommand(goos string, command string) (string, []string) {
+	if goos == "windows" {
+		return "cmd", []string{"/C", command}
+	}
+	return "sh", []string{"-c", command}
+}
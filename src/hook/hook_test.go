@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package hook
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func skipOnWindows(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a POSIX shell")
+	}
+}
+
+func Test_hook_names(t *testing.T) {
+	assert.Equal(t, []Name{
+		"pre-build", "post-test", "pre-commit", "post-commit", "post-revert", "on-role-change",
+	}, Names())
+}
+
+func Test_hook_is_set(t *testing.T) {
+	var nilRunner *Runner
+	r := NewRunner(map[string]string{"pre-commit": "gofmt -l .", "post-commit": "  "}, "")
+	assert.True(t, r.IsSet(PreCommit))
+	assert.False(t, r.IsSet(PostCommit))
+	assert.False(t, r.IsSet(PreBuild))
+	assert.False(t, nilRunner.IsSet(PreCommit))
+}
+
+func Test_run_hook_not_set(t *testing.T) {
+	var nilRunner *Runner
+	output, err := nilRunner.Run(PreCommit, events.TCREvent{})
+	assert.NoError(t, err)
+	assert.Empty(t, output)
+}
+
+func Test_run_hook_passes_event_and_environment(t *testing.T) {
+	skipOnWindows(t)
+	dir := t.TempDir()
+	r := NewRunner(map[string]string{
+		"post-test": `echo "$TCR_HOOK $TCR_EXTRA" > env.txt; cat > event.json; echo done`,
+	}, dir)
+	event := events.NewTCREvent(events.StatusPass, events.NewChangedLines(3, 2),
+		events.NewTestStats(5, 5, 0, 0, 0, time.Second))
+
+	output, err := r.Run(PostTest, event, "TCR_EXTRA=extra")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "done", output)
+	env, _ := os.ReadFile(filepath.Join(dir, "env.txt"))
+	assert.Equal(t, "post-test extra", strings.TrimSpace(string(env)))
+	data, _ := os.ReadFile(filepath.Join(dir, "event.json"))
+	var received events.TCREvent
+	assert.NoError(t, json.Unmarshal(data, &received))
+	assert.Equal(t, event, received)
+}
+
+func Test_run_hook_with_non_zero_exit_code(t *testing.T) {
+	r := NewRunner(map[string]string{"pre-commit": "echo lint error&& exit 3"}, t.TempDir())
+	output, err := r.Run(PreCommit, events.TCREvent{})
+	assert.ErrorContains(t, err, "pre-commit hook failed")
+	assert.Equal(t, "lint error", output)
+}
+
+func Test_run_hook_timeout(t *testing.T) {
+	skipOnWindows(t)
+	r := NewRunner(map[string]string{"pre-build": "sleep 5"}, t.TempDir())
+	r.timeout = 50 * time.Millisecond
+	_, err := r.Run(PreBuild, events.TCREvent{})
+	assert.ErrorContains(t, err, "timed out")
+}
+
+func Test_shell_command(t *testing.T) {
+	shell, args := shellCommand("linux", "make lint")
+	assert.Equal(t, "sh", shell)
+	assert.Equal(t, []string{"-c", "make lint"}, args)
+	shell, args = shellCommand("windows", "make lint")
+	assert.Equal(t, "cmd", shell)
+	assert.Equal(t, []string{"/C", "make lint"}, args)
+}
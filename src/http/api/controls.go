@@ -26,13 +26,26 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/engine"
 	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/role"
 )
 
 const (
-	controlAbortCommand = "abort-command"
+	controlAbortCommand   = "abort-command"
+	controlRunCycle       = "run-cycle"
+	controlVCSPull        = "vcs-pull"
+	controlVCSPush        = "vcs-push"
+	controlToggleAutoPush = "toggle-auto-push"
 )
 
+// runInBackground runs operations that may take a while, so that HTTP
+// clients do not have to wait for them. Their outcome is sent over websocket.
+// Can be overridden for testing purpose
+var runInBackground = func(f func()) {
+	go f()
+}
+
 // ControlsPostHandler handles HTTP POST requests for triggering various TCR actions
 func ControlsPostHandler(c *gin.Context) {
 	tcr := getTCRInstance(c)
@@ -41,8 +54,39 @@ func ControlsPostHandler(c *gin.Context) {
 	case controlAbortCommand:
 		tcr.AbortCommand()
 		c.Status(http.StatusAccepted)
+	case controlRunCycle:
+		if code := checkCanRunCycle(tcr); code != http.StatusOK {
+			c.Status(code)
+			return
+		}
+		runInBackground(tcr.RunTCRCycle)
+		c.Status(http.StatusAccepted)
+	case controlVCSPull:
+		tcr.VCSPull()
+		c.Status(http.StatusAccepted)
+	case controlVCSPush:
+		tcr.VCSPush()
+		c.Status(http.StatusAccepted)
+	case controlToggleAutoPush:
+		tcr.ToggleAutoPush()
+		c.Status(http.StatusAccepted)
 	default:
 		report.PostWarning("unrecognized control: ", name)
 		c.Status(http.StatusBadRequest)
 	}
 }
+
+// checkCanRunCycle returns http.StatusOK when a TCR cycle can be triggered, i.e.
+// when TCR runs in an active mode with driver role. Returns the HTTP status code
+// to send back otherwise
+func checkCanRunCycle(tcr engine.TCRInterface) int {
+	if mode := tcr.GetRunMode(); mode == nil || !mode.IsActive() {
+		report.PostWarning("TCR cycles cannot be triggered in current run mode")
+		return http.StatusForbidden
+	}
+	if tcr.GetCurrentRole() != (role.Driver{}) {
+		report.PostWarning("TCR cycles can only be triggered with driver role")
+		return http.StatusConflict
+	}
+	return http.StatusOK
+}
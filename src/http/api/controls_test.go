@@ -30,12 +30,21 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/murex/tcr/engine"
+	"github.com/murex/tcr/runmode"
 	"github.com/stretchr/testify/assert"
 )
 
 func Test_controls_post_handler(t *testing.T) {
+	asDriver := func(mode runmode.RunMode) func(tcr *engine.FakeTCREngine) {
+		return func(tcr *engine.FakeTCREngine) {
+			tcr.SetRunMode(mode)
+			tcr.RunAsDriver()
+		}
+	}
 	tests := []struct {
+		desc                 string
 		control              string
+		setup                func(tcr *engine.FakeTCREngine)
 		expectedHTTPResponse int
 		expectedCalls        []engine.TCRCall
 	}{
@@ -44,6 +53,52 @@ func Test_controls_post_handler(t *testing.T) {
 			expectedHTTPResponse: http.StatusAccepted,
 			expectedCalls:        []engine.TCRCall{engine.TCRCallAbortCommand},
 		},
+		{
+			desc:                 "as driver in mob mode",
+			control:              controlRunCycle,
+			setup:                asDriver(runmode.Mob{}),
+			expectedHTTPResponse: http.StatusAccepted,
+			expectedCalls:        []engine.TCRCall{engine.TCRCallRunAsDriver, engine.TCRCallRunTcrCycle},
+		},
+		{
+			desc:    "as navigator in mob mode",
+			control: controlRunCycle,
+			setup: func(tcr *engine.FakeTCREngine) {
+				tcr.SetRunMode(runmode.Mob{})
+				tcr.RunAsNavigator()
+			},
+			expectedHTTPResponse: http.StatusConflict,
+			expectedCalls:        []engine.TCRCall{engine.TCRCallRunAsNavigator},
+		},
+		{
+			desc:                 "without role in mob mode",
+			control:              controlRunCycle,
+			setup:                func(tcr *engine.FakeTCREngine) { tcr.SetRunMode(runmode.Mob{}) },
+			expectedHTTPResponse: http.StatusConflict,
+			expectedCalls:        nil,
+		},
+		{
+			desc:                 "in check mode",
+			control:              controlRunCycle,
+			setup:                asDriver(runmode.Check{}),
+			expectedHTTPResponse: http.StatusForbidden,
+			expectedCalls:        []engine.TCRCall{engine.TCRCallRunAsDriver},
+		},
+		{
+			control:              controlVCSPull,
+			expectedHTTPResponse: http.StatusAccepted,
+			expectedCalls:        []engine.TCRCall{engine.TCRCallVCSPull},
+		},
+		{
+			control:              controlVCSPush,
+			expectedHTTPResponse: http.StatusAccepted,
+			expectedCalls:        []engine.TCRCall{engine.TCRCallVCSPush},
+		},
+		{
+			control:              controlToggleAutoPush,
+			expectedHTTPResponse: http.StatusAccepted,
+			expectedCalls:        []engine.TCRCall{engine.TCRCallToggleAutoPush},
+		},
 		{
 			control:              "unrecognized-control",
 			expectedHTTPResponse: http.StatusBadRequest,
@@ -51,13 +106,20 @@ func Test_controls_post_handler(t *testing.T) {
 		},
 	}
 
+	// Run background operations synchronously so that we can check their calls
+	runInBackground = func(f func()) { f() }
+	t.Cleanup(func() { runInBackground = func(f func()) { go f() } })
+
 	for _, test := range tests {
 		subPath := test.control
-		t.Run(subPath, func(t *testing.T) {
+		t.Run(strings.TrimSpace(subPath+" "+test.desc), func(t *testing.T) {
 			// Setup the router
 			router := gin.Default()
 			// Plug it to a fake TCR engine
 			tcr := engine.NewFakeTCREngine()
+			if test.setup != nil {
+				test.setup(tcr)
+			}
 			router.Use(TCREngineMiddleware(tcr))
 			// Add the route
 			rPath := "/api/controls/:name"
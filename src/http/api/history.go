@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/engine"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/retro"
+)
+
+type retroData struct {
+	Format   string `json:"format"`
+	Contents string `json:"contents"`
+}
+
+// newHistoryQuery builds a history query from the request query parameters:
+// since, until, author (repeatable), branch (repeatable) and from-journal
+func newHistoryQuery(c *gin.Context) (engine.HistoryQuery, error) {
+	q := engine.HistoryQuery{
+		Since:    c.Query("since"),
+		Until:    c.Query("until"),
+		Authors:  c.QueryArray("author"),
+		Branches: c.QueryArray("branch"),
+	}
+	if value, ok := c.GetQuery("from-journal"); ok {
+		fromJournal, err := strconv.ParseBool(value)
+		if err != nil {
+			return q, err
+		}
+		q.FromJournal = fromJournal
+	}
+	return q, nil
+}
+
+// LogGetHandler handles HTTP GET requests on TCR commit history, or on
+// session journal entries when from-journal query parameter is set
+func LogGetHandler(c *gin.Context) {
+	q, err := newHistoryQuery(c)
+	if err != nil {
+		report.PostWarning("invalid history query: ", err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	data, err := getTCRInstance(c).GetLog(q)
+	if err != nil {
+		report.PostWarning(err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+// StatsGetHandler handles HTTP GET requests on TCR execution stats
+func StatsGetHandler(c *gin.Context) {
+	q, err := newHistoryQuery(c)
+	if err != nil {
+		report.PostWarning("invalid history query: ", err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	data, err := getTCRInstance(c).GetStats(q)
+	if err != nil {
+		report.PostWarning(err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+// RetroGetHandler handles HTTP GET requests on retrospective contents.
+// The format query parameter can be either markdown (default) or html
+func RetroGetHandler(c *gin.Context) {
+	q, err := newHistoryQuery(c)
+	if err != nil {
+		report.PostWarning("invalid history query: ", err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	format, err := retro.SelectFormat(c.Query("format"))
+	if err != nil {
+		report.PostWarning(err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	contents, err := getTCRInstance(c).GetRetro(q, string(format))
+	if err != nil {
+		report.PostWarning(err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, retroData{Format: string(format), Contents: contents})
+}
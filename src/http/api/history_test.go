@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/engine"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_new_history_query(t *testing.T) {
+	tests := []struct {
+		desc        string
+		query       string
+		expected    engine.HistoryQuery
+		expectError bool
+	}{
+		{
+			desc:     "no query parameter",
+			query:    "",
+			expected: engine.HistoryQuery{},
+		},
+		{
+			desc:  "all query parameters",
+			query: "?since=2h&until=today&author=a&author=b&branch=main&from-journal=true",
+			expected: engine.HistoryQuery{
+				Since:       "2h",
+				Until:       "today",
+				Authors:     []string{"a", "b"},
+				Branches:    []string{"main"},
+				FromJournal: true,
+			},
+		},
+		{
+			desc:        "invalid from-journal value",
+			query:       "?from-journal=maybe",
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/api/log"+test.query, nil)
+
+			q, err := newHistoryQuery(c)
+
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, q)
+		})
+	}
+}
+
+func Test_history_get_handlers(t *testing.T) {
+	tests := []struct {
+		desc                 string
+		path                 string
+		handler              gin.HandlerFunc
+		query                string
+		historyErr           error
+		expectedHTTPResponse int
+		expectedCalls        []engine.TCRCall
+	}{
+		{"log", "/api/log", LogGetHandler, "", nil,
+			http.StatusOK, []engine.TCRCall{engine.TCRCallGetLog}},
+		{"log with invalid query", "/api/log", LogGetHandler, "?from-journal=maybe", nil,
+			http.StatusBadRequest, nil},
+		{"log with history error", "/api/log", LogGetHandler, "", errors.New("invalid since"),
+			http.StatusBadRequest, []engine.TCRCall{engine.TCRCallGetLog}},
+		{"stats", "/api/stats", StatsGetHandler, "", nil,
+			http.StatusOK, []engine.TCRCall{engine.TCRCallGetStats}},
+		{"stats with invalid query", "/api/stats", StatsGetHandler, "?from-journal=maybe", nil,
+			http.StatusBadRequest, nil},
+		{"stats with history error", "/api/stats", StatsGetHandler, "", errors.New("invalid since"),
+			http.StatusBadRequest, []engine.TCRCall{engine.TCRCallGetStats}},
+		{"retro", "/api/retro", RetroGetHandler, "?format=html", nil,
+			http.StatusOK, []engine.TCRCall{engine.TCRCallGetRetro}},
+		{"retro with invalid format", "/api/retro", RetroGetHandler, "?format=pdf", nil,
+			http.StatusBadRequest, nil},
+		{"retro with history error", "/api/retro", RetroGetHandler, "", errors.New("invalid since"),
+			http.StatusBadRequest, []engine.TCRCall{engine.TCRCallGetRetro}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			// Setup the router
+			router := gin.Default()
+			// Plug it to a fake TCR engine
+			tcr := engine.NewFakeTCREngine()
+			tcr.SetHistoryError(test.historyErr)
+			router.Use(TCREngineMiddleware(tcr))
+			// Add the route
+			router.GET(test.path, test.handler)
+
+			// Prepare the request, send it and capture the response
+			req, _ := http.NewRequest(http.MethodGet, test.path+test.query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify HTTP response code
+			assert.Equal(t, test.expectedHTTPResponse, w.Code)
+			// Verify TCR call history
+			assert.Equal(t, test.expectedCalls, tcr.GetCallHistory())
+		})
+	}
+}
+
+func Test_retro_get_handler_response(t *testing.T) {
+	router := gin.Default()
+	router.Use(TCREngineMiddleware(engine.NewFakeTCREngine()))
+	router.GET("/api/retro", RetroGetHandler)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/retro", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var data retroData
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &data))
+	assert.Equal(t, retroData{Format: "markdown", Contents: "fake markdown retrospective"}, data)
+}
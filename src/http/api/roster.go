@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/roster"
+)
+
+type memberData struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Skipped bool   `json:"skipped"`
+	Driver  bool   `json:"driver"`
+	Next    bool   `json:"next"`
+}
+
+const (
+	driveMemberAction  string = "drive"
+	skipMemberAction   string = "skip"
+	unskipMemberAction string = "unskip"
+)
+
+// RosterGetHandler handles HTTP GET requests on the mob roster
+func RosterGetHandler(c *gin.Context) {
+	c.IndentedJSON(http.StatusOK, newRosterData(getTCRInstance(c).GetRoster()))
+}
+
+// RosterPostHandler handles HTTP POST requests reordering the mob roster.
+// The request body is the list of member names in their new rotation order
+func RosterPostHandler(c *gin.Context) {
+	r := getTCRInstance(c).GetRoster()
+	var names []string
+	if err := c.ShouldBindJSON(&names); err != nil {
+		report.PostWarning("invalid roster order: ", err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	if err := r.Reorder(names); err != nil {
+		report.PostWarning(err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	c.IndentedJSON(http.StatusAccepted, newRosterData(r))
+}
+
+// RosterMemberPostHandler handles HTTP POST requests on a mob roster member
+func RosterMemberPostHandler(c *gin.Context) {
+	r := getTCRInstance(c).GetRoster()
+	name := c.Param("name")
+	var err error
+	switch action := c.Param("action"); action {
+	case driveMemberAction:
+		err = r.SetDriver(name)
+	case skipMemberAction:
+		err = r.Skip(name, true)
+	case unskipMemberAction:
+		err = r.Skip(name, false)
+	default:
+		report.PostWarning("unrecognized action: ", action)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		report.PostWarning(err)
+		c.Status(http.StatusNotFound)
+		return
+	}
+	c.IndentedJSON(http.StatusAccepted, newRosterData(r))
+}
+
+func newRosterData(r *roster.Roster) []memberData {
+	driver, _ := r.Driver()
+	next, _ := r.NextDriver()
+	data := []memberData{}
+	for _, m := range r.Members() {
+		data = append(data, memberData{
+			Name:    m.Name,
+			Email:   m.Email,
+			Skipped: m.Skipped,
+			Driver:  m.Name == driver.Name,
+			Next:    m.Name == next.Name,
+		})
+	}
+	return data
+}
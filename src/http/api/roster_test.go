@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/engine"
+	"github.com/murex/tcr/roster"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_roster_get_handler(t *testing.T) {
+	// Setup the router
+	rPath := "/api/roster"
+	router := gin.Default()
+	tcr := engine.NewFakeTCREngine()
+	tcr.SetRoster(roster.New("alice <alice@example.com>", "bob"))
+	router.Use(TCREngineMiddleware(tcr))
+	router.GET(rPath, RosterGetHandler)
+
+	// Prepare the request, send it and capture the response
+	req, _ := http.NewRequest(http.MethodGet, rPath, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// Verify the response's code, header and body
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	expected := []memberData{
+		{Name: "alice", Email: "alice@example.com", Driver: true},
+		{Name: "bob", Next: true},
+	}
+	var actual []memberData
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &actual))
+	assert.Equal(t, expected, actual)
+}
+
+func Test_roster_post_handler(t *testing.T) {
+	tests := []struct {
+		desc                 string
+		body                 string
+		expectedHTTPResponse int
+		expectedOrder        []string
+	}{
+		{
+			desc:                 "valid order",
+			body:                 `["carol","alice","bob"]`,
+			expectedHTTPResponse: http.StatusAccepted,
+			expectedOrder:        []string{"carol", "alice", "bob"},
+		},
+		{
+			desc:                 "unknown member",
+			body:                 `["carol","alice","dave"]`,
+			expectedHTTPResponse: http.StatusBadRequest,
+			expectedOrder:        []string{"alice", "bob", "carol"},
+		},
+		{
+			desc:                 "invalid body",
+			body:                 `{"name":"alice"}`,
+			expectedHTTPResponse: http.StatusBadRequest,
+			expectedOrder:        []string{"alice", "bob", "carol"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			// Setup the router
+			rPath := "/api/roster"
+			router := gin.Default()
+			tcr := engine.NewFakeTCREngine()
+			tcr.SetRoster(roster.New("alice", "bob", "carol"))
+			router.Use(TCREngineMiddleware(tcr))
+			router.POST(rPath, RosterPostHandler)
+
+			// Prepare the request, send it and capture the response
+			req, _ := http.NewRequest(http.MethodPost, rPath, strings.NewReader(test.body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify HTTP response code and roster order
+			assert.Equal(t, test.expectedHTTPResponse, w.Code)
+			var order []string
+			for _, m := range tcr.GetRoster().Members() {
+				order = append(order, m.Name)
+			}
+			assert.Equal(t, test.expectedOrder, order)
+		})
+	}
+}
+
+func Test_roster_member_post_handler(t *testing.T) {
+	tests := []struct {
+		member               string
+		action               string
+		expectedHTTPResponse int
+		expectedDriver       string
+		expectedSkipped      bool
+	}{
+		{"bob", driveMemberAction, http.StatusAccepted, "bob", false},
+		{"bob", skipMemberAction, http.StatusAccepted, "alice", true},
+		{"bob", unskipMemberAction, http.StatusAccepted, "alice", false},
+		{"dave", driveMemberAction, http.StatusNotFound, "alice", false},
+		{"bob", "unrecognized-action", http.StatusBadRequest, "alice", false},
+	}
+
+	for _, test := range tests {
+		subPath := test.member + "/" + test.action
+		t.Run(subPath, func(t *testing.T) {
+			// Setup the router
+			router := gin.Default()
+			tcr := engine.NewFakeTCREngine()
+			tcr.SetRoster(roster.New("alice", "bob"))
+			router.Use(TCREngineMiddleware(tcr))
+			rPath := "/api/roster/:name/:action"
+			router.POST(rPath, RosterMemberPostHandler)
+
+			// Prepare the request, send it and capture the response
+			req, _ := http.NewRequest(http.MethodPost, "/api/roster/"+subPath, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify HTTP response code and roster state
+			assert.Equal(t, test.expectedHTTPResponse, w.Code)
+			driver, _ := tcr.GetRoster().Driver()
+			assert.Equal(t, test.expectedDriver, driver.Name)
+			assert.Equal(t, test.expectedSkipped, tcr.GetRoster().Members()[1].Skipped)
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/engine"
 )
 
 type sessionInfo struct {
@@ -43,9 +44,11 @@ type sessionInfo struct {
 
 // SessionInfoGetHandler handles HTTP GET requests on TCR settings information
 func SessionInfoGetHandler(c *gin.Context) {
-	tcr := getTCRInstance(c)
-	info := tcr.GetSessionInfo()
-	data := sessionInfo{
+	c.IndentedJSON(http.StatusOK, newSessionInfo(getTCRInstance(c).GetSessionInfo()))
+}
+
+func newSessionInfo(info engine.SessionInfo) sessionInfo {
+	return sessionInfo{
 		BaseDir:           info.BaseDir,
 		WorkDir:           info.WorkDir,
 		LanguageName:      info.LanguageName,
@@ -56,5 +59,4 @@ func SessionInfoGetHandler(c *gin.Context) {
 		GitAutoPush:       info.GitAutoPush,
 		MessageSuffix:     info.MessageSuffix,
 	}
-	c.IndentedJSON(http.StatusOK, data)
 }
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/variant"
+)
+
+type autoPushSetting struct {
+	Enabled *bool `json:"enabled"`
+}
+
+type variantSetting struct {
+	Name string `json:"name"`
+}
+
+type mobTimerSetting struct {
+	Duration string `json:"duration"`
+}
+
+// AutoPushPostHandler handles HTTP POST requests turning VCS auto-push on or off
+func AutoPushPostHandler(c *gin.Context) {
+	tcr := getTCRInstance(c)
+	var data autoPushSetting
+	if err := c.ShouldBindJSON(&data); err != nil || data.Enabled == nil {
+		report.PostWarning("invalid auto-push setting: ", err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	tcr.SetAutoPush(*data.Enabled)
+	c.IndentedJSON(http.StatusAccepted, newSessionInfo(tcr.GetSessionInfo()))
+}
+
+// VariantPostHandler handles HTTP POST requests changing TCR variant
+func VariantPostHandler(c *gin.Context) {
+	tcr := getTCRInstance(c)
+	var data variantSetting
+	if err := c.ShouldBindJSON(&data); err != nil {
+		report.PostWarning("invalid variant setting: ", err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	// TCR engine treats unsupported variants as fatal errors: we check it beforehand
+	if _, err := variant.Select(data.Name); err != nil {
+		report.PostWarning(err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	tcr.SetVariant(data.Name)
+	c.IndentedJSON(http.StatusAccepted, newSessionInfo(tcr.GetSessionInfo()))
+}
+
+// MobTimerPostHandler handles HTTP POST requests changing the duration of driver turns.
+// The duration is provided in Go duration format (ex: "5m30s")
+func MobTimerPostHandler(c *gin.Context) {
+	tcr := getTCRInstance(c)
+	var data mobTimerSetting
+	if err := c.ShouldBindJSON(&data); err != nil {
+		report.PostWarning("invalid mob timer setting: ", err)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	duration, err := time.ParseDuration(data.Duration)
+	if err != nil || duration <= 0 {
+		report.PostWarning("invalid mob timer duration: ", data.Duration)
+		c.Status(http.StatusBadRequest)
+		return
+	}
+	tcr.SetMobTimerDuration(duration)
+	c.IndentedJSON(http.StatusAccepted, mobTimerSetting{Duration: duration.String()})
+}
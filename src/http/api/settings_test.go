@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/engine"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_settings_post_handlers(t *testing.T) {
+	tests := []struct {
+		desc                 string
+		path                 string
+		handler              gin.HandlerFunc
+		body                 string
+		expectedHTTPResponse int
+		expectedCalls        []engine.TCRCall
+		asserter             func(t *testing.T, tcr *engine.FakeTCREngine)
+	}{
+		{
+			desc:                 "turn auto-push on",
+			path:                 "/api/settings/auto-push",
+			handler:              AutoPushPostHandler,
+			body:                 `{"enabled": true}`,
+			expectedHTTPResponse: http.StatusAccepted,
+			expectedCalls:        []engine.TCRCall{engine.TCRCallSetAutoPush, engine.TCRCallGetSessionInfo},
+			asserter: func(t *testing.T, tcr *engine.FakeTCREngine) {
+				assert.True(t, tcr.GetSessionInfo().GitAutoPush)
+			},
+		},
+		{
+			desc:                 "auto-push without value",
+			path:                 "/api/settings/auto-push",
+			handler:              AutoPushPostHandler,
+			body:                 `{}`,
+			expectedHTTPResponse: http.StatusBadRequest,
+		},
+		{
+			desc:                 "auto-push with invalid body",
+			path:                 "/api/settings/auto-push",
+			handler:              AutoPushPostHandler,
+			body:                 `on`,
+			expectedHTTPResponse: http.StatusBadRequest,
+		},
+		{
+			desc:                 "change variant",
+			path:                 "/api/settings/variant",
+			handler:              VariantPostHandler,
+			body:                 `{"name": "btcr"}`,
+			expectedHTTPResponse: http.StatusAccepted,
+			expectedCalls:        []engine.TCRCall{engine.TCRCallSetVariant, engine.TCRCallGetSessionInfo},
+			asserter: func(t *testing.T, tcr *engine.FakeTCREngine) {
+				assert.Equal(t, "btcr", tcr.GetSessionInfo().Variant)
+			},
+		},
+		{
+			desc:                 "unsupported variant",
+			path:                 "/api/settings/variant",
+			handler:              VariantPostHandler,
+			body:                 `{"name": "unknown"}`,
+			expectedHTTPResponse: http.StatusBadRequest,
+		},
+		{
+			desc:                 "change mob timer duration",
+			path:                 "/api/settings/mob-timer",
+			handler:              MobTimerPostHandler,
+			body:                 `{"duration": "10m"}`,
+			expectedHTTPResponse: http.StatusAccepted,
+			expectedCalls:        []engine.TCRCall{engine.TCRCallSetMobTimer},
+			asserter: func(t *testing.T, tcr *engine.FakeTCREngine) {
+				assert.Equal(t, 10*time.Minute, tcr.GetMobTimerStatus().Timeout)
+			},
+		},
+		{
+			desc:                 "invalid mob timer duration",
+			path:                 "/api/settings/mob-timer",
+			handler:              MobTimerPostHandler,
+			body:                 `{"duration": "ten minutes"}`,
+			expectedHTTPResponse: http.StatusBadRequest,
+		},
+		{
+			desc:                 "negative mob timer duration",
+			path:                 "/api/settings/mob-timer",
+			handler:              MobTimerPostHandler,
+			body:                 `{"duration": "-1m"}`,
+			expectedHTTPResponse: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			// Setup the router
+			router := gin.Default()
+			// Plug it to a fake TCR engine
+			tcr := engine.NewFakeTCREngine()
+			router.Use(TCREngineMiddleware(tcr))
+			// Add the route
+			router.POST(test.path, test.handler)
+
+			// Prepare the request, send it and capture the response
+			req, _ := http.NewRequest(http.MethodPost, test.path, strings.NewReader(test.body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Verify HTTP response code
+			assert.Equal(t, test.expectedHTTPResponse, w.Code)
+			// Verify TCR call history
+			assert.Equal(t, test.expectedCalls, tcr.GetCallHistory())
+			if test.asserter != nil {
+				test.asserter(t, tcr)
+			}
+		})
+	}
+}
+
+func Test_mob_timer_post_handler_response(t *testing.T) {
+	router := gin.Default()
+	router.Use(TCREngineMiddleware(engine.NewFakeTCREngine()))
+	router.POST("/api/settings/mob-timer", MobTimerPostHandler)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/settings/mob-timer", strings.NewReader(`{"duration": "5m30s"}`))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var data mobTimerSetting
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &data))
+	assert.Equal(t, "5m30s", data.Duration)
+}
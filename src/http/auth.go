@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package http
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/http/ws"
+	"github.com/murex/tcr/params"
+	"github.com/murex/tcr/report"
+)
+
+// tokenQueryParam is the name of the query parameter that can be used
+// instead of the Authorization header to provide the session token
+const tokenQueryParam = "token"
+
+// tokenCookieName is the name of the cookie holding the session token once a
+// browser has been authenticated through the token query parameter
+const tokenCookieName = "tcr-token"
+
+// newSessionToken generates a random token for authenticating HTTP clients
+func newSessionToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// InitSessionToken sets the token that HTTP clients must provide during the session.
+// A token is only needed when web authentication is turned on or when the server
+// can be reached from remote hosts. The mob token is reused when provided
+func InitSessionToken(p *params.Params) {
+	if p.WebToken != "" || !requiresAuth(*p) {
+		return
+	}
+	p.WebToken = p.MobToken
+	if p.WebToken == "" {
+		p.WebToken = newSessionToken()
+	}
+}
+
+// requiresAuth indicates if HTTP clients must authenticate with the provided parameters
+func requiresAuth(p params.Params) bool {
+	return p.WebAuth || p.MobHost || !isLoopbackAddress(bindAddress(p))
+}
+
+// isLoopbackAddress indicates if the provided IP address can only be reached from the local host
+func isLoopbackAddress(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsLoopback()
+}
+
+// providedToken returns the token provided with the request, and indicates
+// if it was provided through the query parameter.
+// Supported sources are the bearer token, the query parameter and the cookie
+func providedToken(r *http.Request) (token string, fromQuery bool) {
+	if token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); found {
+		return token, false
+	}
+	if token := r.URL.Query().Get(tokenQueryParam); token != "" {
+		return token, true
+	}
+	if cookie, err := r.Cookie(tokenCookieName); err == nil {
+		return cookie.Value, false
+	}
+	return "", false
+}
+
+// isValidToken compares the provided token with the expected one in constant time
+func isValidToken(provided string, expected string) bool {
+	return provided != "" && subtle.ConstantTimeCompare([]byte(provided), []byte(expected)) == 1
+}
+
+// authMiddleware rejects requests without the session token, including those sent
+// from the local host: once the server can be reached from other hosts or accepts mob
+// guests, local requests may come from other users of the machine or from a proxy.
+// A browser providing the token as a query parameter receives it back as a cookie
+// so that subsequent API and websocket requests are authenticated too.
+// Requests carrying a valid token are marked as authenticated
+func authMiddleware(token string, secure bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		provided, fromQuery := providedToken(c.Request)
+		if !isValidToken(provided, token) {
+			report.PostWarning("client not authorized: ", c.Request.RemoteAddr)
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		ws.MarkAuthenticated(c)
+		if fromQuery {
+			c.SetSameSite(http.SameSiteStrictMode)
+			c.SetCookie(tokenCookieName, token, 0, "/", "", secure, true)
+		}
+		c.Next()
+	}
+}
+
+// mobGuestAuthMiddleware rejects requests without the mob token, including
+// those sent from the local host
+func mobGuestAuthMiddleware(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		provided, _ := providedToken(c.Request)
+		if isValidToken(provided, token) {
+			ws.MarkAuthenticated(c)
+			c.Next()
+			return
+		}
+		report.PostWarning("mob guest not authorized: ", c.Request.RemoteAddr)
+		c.AbortWithStatus(http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/engine"
+	"github.com/murex/tcr/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_session_token(t *testing.T) {
+	tests := []struct {
+		desc     string
+		p        params.Params
+		asserter func(t *testing.T, token string)
+	}{
+		{
+			desc: "local server without authentication",
+			p:    *params.AParamSet(params.WithMobHost(false), params.WithMobToken("abc")),
+			asserter: func(t *testing.T, token string) {
+				assert.Empty(t, token)
+			},
+		},
+		{
+			desc: "mob host with token",
+			p:    *params.AParamSet(params.WithMobHost(true), params.WithMobToken("abc")),
+			asserter: func(t *testing.T, token string) {
+				assert.Equal(t, "abc", token)
+			},
+		},
+		{
+			desc: "mob host without token",
+			p:    *params.AParamSet(params.WithMobHost(true), params.WithMobToken("")),
+			asserter: func(t *testing.T, token string) {
+				assert.Len(t, token, 32)
+			},
+		},
+		{
+			desc: "local server with authentication",
+			p:    *params.AParamSet(params.WithWebAuth(true)),
+			asserter: func(t *testing.T, token string) {
+				assert.Len(t, token, 32)
+			},
+		},
+		{
+			desc: "server listening on all network interfaces",
+			p:    *params.AParamSet(params.WithBindAddress("0.0.0.0")),
+			asserter: func(t *testing.T, token string) {
+				assert.Len(t, token, 32)
+			},
+		},
+		{
+			desc: "server listening on a loopback address",
+			p:    *params.AParamSet(params.WithBindAddress("::1")),
+			asserter: func(t *testing.T, token string) {
+				assert.Empty(t, token)
+			},
+		},
+		{
+			desc: "token already initialized",
+			p:    *params.AParamSet(params.WithWebAuth(true), params.WithWebToken("xyz")),
+			asserter: func(t *testing.T, token string) {
+				assert.Equal(t, "xyz", token)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			wuis := New(test.p, engine.NewFakeTCREngine())
+			test.asserter(t, wuis.token)
+			assert.Equal(t, wuis.token != "", wuis.RequiresAuth())
+			assert.Equal(t, test.p.MobHost, wuis.IsMobHost())
+		})
+	}
+}
+
+func Test_init_session_token_is_kept_by_server(t *testing.T) {
+	p := *params.AParamSet(params.WithWebAuth(true))
+	InitSessionToken(&p)
+	wuis := New(p, engine.NewFakeTCREngine())
+	assert.NotEmpty(t, p.WebToken)
+	assert.Equal(t, p.WebToken, wuis.token)
+}
+
+func Test_server_addresses(t *testing.T) {
+	tests := []struct {
+		desc            string
+		mobHost         bool
+		bindAddress     string
+		expectedListen  string
+		expectedAddress string
+	}{
+		{"not a mob host", false, "", "127.0.0.1:8483", "127.0.0.1:8483"},
+		{"mob host", true, "", "0.0.0.0:8483", "127.0.0.1:8483"},
+		{"mob host with bind address", true, "127.0.0.1", "127.0.0.1:8483", "127.0.0.1:8483"},
+		{"all IPv4 interfaces", false, "0.0.0.0", "0.0.0.0:8483", "127.0.0.1:8483"},
+		{"all IPv6 interfaces", false, "::", "[::]:8483", "127.0.0.1:8483"},
+		{"specific IP address", false, "192.168.1.2", "192.168.1.2:8483", "192.168.1.2:8483"},
+		{"IPv6 loopback address", false, "::1", "[::1]:8483", "[::1]:8483"},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p := *params.AParamSet(params.WithPortNumber(8483),
+				params.WithMobHost(test.mobHost), params.WithBindAddress(test.bindAddress))
+			wuis := New(p, engine.NewFakeTCREngine())
+			assert.Equal(t, test.expectedListen, wuis.getListenAddress())
+			assert.Equal(t, test.expectedAddress, wuis.GetServerAddress())
+		})
+	}
+}
+
+func Test_server_url(t *testing.T) {
+	tests := []struct {
+		desc     string
+		p        params.Params
+		expected string
+	}{
+		{"without authentication", *params.AParamSet(), "http://127.0.0.1:8483/"},
+		{"with authentication", *params.AParamSet(params.WithWebAuth(true), params.WithWebToken("abc")), "http://127.0.0.1:8483/?token=abc"},
+		{"with TLS", *params.AParamSet(params.WithTLS(true)), "https://127.0.0.1:8483/"},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			test.p.PortNumber = 8483
+			wuis := New(test.p, engine.NewFakeTCREngine())
+			assert.Equal(t, test.expected, wuis.GetURL())
+		})
+	}
+}
+
+func Test_auth_middlewares(t *testing.T) {
+	const token = "s3cr3t"
+	tests := []struct {
+		desc          string
+		remoteAddr    string
+		authorization string
+		query         string
+		expectedHost  int
+		expectedGuest int
+	}{
+		{"local request without token", "127.0.0.1:50000", "", "", http.StatusUnauthorized, http.StatusUnauthorized},
+		{"local IPv6 request without token", "[::1]:50000", "", "", http.StatusUnauthorized, http.StatusUnauthorized},
+		{"local request with token", "127.0.0.1:50000", "Bearer " + token, "", http.StatusOK, http.StatusOK},
+		{"remote request without token", "192.168.1.2:50000", "", "", http.StatusUnauthorized, http.StatusUnauthorized},
+		{"remote request with wrong token", "192.168.1.2:50000", "Bearer wrong", "", http.StatusUnauthorized, http.StatusUnauthorized},
+		{"remote request with bearer token", "192.168.1.2:50000", "Bearer " + token, "", http.StatusOK, http.StatusOK},
+		{"remote request with query token", "192.168.1.2:50000", "", "?token=" + token, http.StatusOK, http.StatusOK},
+		{"remote request with empty query token", "192.168.1.2:50000", "", "?token=", http.StatusUnauthorized, http.StatusUnauthorized},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/api/test", authMiddleware(token, false), func(c *gin.Context) { c.Status(http.StatusOK) })
+			router.GET("/mob/test", mobGuestAuthMiddleware(token), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			for path, expected := range map[string]int{
+				"/api/test": test.expectedHost,
+				"/mob/test": test.expectedGuest,
+			} {
+				req, _ := http.NewRequest(http.MethodGet, path+test.query, nil)
+				req.RemoteAddr = test.remoteAddr
+				if test.authorization != "" {
+					req.Header.Set("Authorization", test.authorization)
+				}
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+				assert.Equal(t, expected, rr.Code, path)
+			}
+		})
+	}
+}
+
+func Test_auth_middleware_token_cookie(t *testing.T) {
+	const token = "s3cr3t"
+	tests := []struct {
+		desc           string
+		secure         bool
+		query          string
+		cookie         string
+		expectedStatus int
+		expectedCookie bool
+	}{
+		{"query token", false, "?token=" + token, "", http.StatusOK, true},
+		{"query token over TLS", true, "?token=" + token, "", http.StatusOK, true},
+		{"wrong query token", false, "?token=wrong", "", http.StatusUnauthorized, false},
+		{"cookie token", false, "", token, http.StatusOK, false},
+		{"wrong cookie token", false, "", "wrong", http.StatusUnauthorized, false},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/test", authMiddleware(token, test.secure), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req, _ := http.NewRequest(http.MethodGet, "/test"+test.query, nil)
+			req.RemoteAddr = "127.0.0.1:50000"
+			if test.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: tokenCookieName, Value: test.cookie})
+			}
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			assert.Equal(t, test.expectedStatus, rr.Code)
+
+			cookies := rr.Result().Cookies()
+			if !test.expectedCookie {
+				assert.Empty(t, cookies)
+				return
+			}
+			if assert.Len(t, cookies, 1) {
+				assert.Equal(t, tokenCookieName, cookies[0].Name)
+				assert.Equal(t, token, cookies[0].Value)
+				assert.True(t, cookies[0].HttpOnly)
+				assert.Equal(t, http.SameSiteStrictMode, cookies[0].SameSite)
+				assert.Equal(t, test.secure, cookies[0].Secure)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package http
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	certFileName     = "cert.pem"
+	keyFileName      = "key.pem"
+	certValidity     = 365 * 24 * time.Hour
+	certRenewalDelay = 24 * time.Hour
+)
+
+// loadOrCreateCertificate returns a self-signed certificate covering the provided hosts.
+// The certificate is stored in dir so that browsers only need to accept it once.
+// A new certificate is generated when none is found, when it is about to expire,
+// or when it does not cover all the hosts. When dir is empty, the certificate
+// is only kept in memory
+func loadOrCreateCertificate(dir string, hosts []string) (tls.Certificate, error) {
+	certFile := filepath.Join(dir, certFileName)
+	keyFile := filepath.Join(dir, keyFileName)
+	if dir != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err == nil && coversHosts(cert, hosts) {
+			return cert, nil
+		}
+	}
+
+	certPEM, keyPEM, err := generateSelfSignedCertificate(hosts)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	if dir != "" {
+		if err = os.MkdirAll(dir, 0750); err != nil {
+			return tls.Certificate{}, err
+		}
+		if err = os.WriteFile(certFile, certPEM, 0644); err != nil { //nolint:gosec // certificates are public
+			return tls.Certificate{}, err
+		}
+		if err = os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+			return tls.Certificate{}, err
+		}
+	}
+	return tls.X509KeyPair(certPEM, keyPEM)
+}
+
+// coversHosts indicates if the certificate is still valid for a while for all the provided hosts
+func coversHosts(cert tls.Certificate, hosts []string) bool {
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil || time.Now().Add(certRenewalDelay).After(leaf.NotAfter) {
+		return false
+	}
+	for _, host := range hosts {
+		if leaf.VerifyHostname(host) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// generateSelfSignedCertificate generates a PEM-encoded ECDSA certificate and key for the provided hosts
+func generateSelfSignedCertificate(hosts []string) (certPEM []byte, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{Organization: []string{"TCR"}, CommonName: "TCR web UI"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
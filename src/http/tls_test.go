@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package http
+
+import (
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_create_in_memory_certificate(t *testing.T) {
+	cert, err := loadOrCreateCertificate("", []string{"localhost", "127.0.0.1"})
+	assert.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	assert.NoError(t, err)
+	assert.NoError(t, leaf.VerifyHostname("localhost"))
+	assert.NoError(t, leaf.VerifyHostname("127.0.0.1"))
+	assert.Error(t, leaf.VerifyHostname("192.168.1.2"))
+}
+
+func Test_certificate_is_stored_and_reused(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "web")
+	first, err := loadOrCreateCertificate(dir, []string{"127.0.0.1"})
+	assert.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, certFileName))
+	keyInfo, err := os.Stat(filepath.Join(dir, keyFileName))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), keyInfo.Mode().Perm())
+
+	second, err := loadOrCreateCertificate(dir, []string{"127.0.0.1"})
+	assert.NoError(t, err)
+	assert.Equal(t, first.Certificate, second.Certificate)
+}
+
+func Test_certificate_is_renewed_when_a_host_is_not_covered(t *testing.T) {
+	dir := t.TempDir()
+	first, err := loadOrCreateCertificate(dir, []string{"127.0.0.1"})
+	assert.NoError(t, err)
+
+	second, err := loadOrCreateCertificate(dir, []string{"127.0.0.1", "192.168.1.2"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.Certificate, second.Certificate)
+	leaf, err := x509.ParseCertificate(second.Certificate[0])
+	assert.NoError(t, err)
+	assert.NoError(t, leaf.VerifyHostname("192.168.1.2"))
+}
+
+func Test_certificate_hosts(t *testing.T) {
+	hostname, _ := os.Hostname()
+	tests := []struct {
+		desc        string
+		bindAddress string
+		expected    []string
+	}{
+		{"loopback address", "127.0.0.1", []string{"localhost", "127.0.0.1", "::1"}},
+		{"specific IP address", "192.168.1.2", []string{"localhost", "127.0.0.1", "::1", "192.168.1.2"}},
+		{"all network interfaces", "0.0.0.0", []string{"localhost", "127.0.0.1", "::1", hostname}},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			wuis := WebUIServer{host: test.bindAddress}
+			assert.Equal(t, test.expected, wuis.certificateHosts())
+		})
+	}
+}
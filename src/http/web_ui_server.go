@@ -24,16 +24,23 @@ package http
 
 import (
 	"context"
-	"fmt"
+	"crypto/tls"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
+	"github.com/murex/tcr/config"
 	"github.com/murex/tcr/engine"
 	"github.com/murex/tcr/http/api"
 	"github.com/murex/tcr/http/ws"
+	"github.com/murex/tcr/mob"
 	"github.com/murex/tcr/params"
 	"github.com/murex/tcr/report"
 	"github.com/murex/tcr/runmode"
@@ -49,19 +56,21 @@ type WebUIServer struct {
 	router           *gin.Engine
 	httpServer       *http.Server
 	websocketTimeout time.Duration
+	token            string
 }
 
 // New creates a new instance of WebUIServer
 func New(p params.Params, tcr engine.TCRInterface) *WebUIServer {
+	InitSessionToken(&p)
 	webUIServer := WebUIServer{
-		tcr: tcr,
-		// host: "0.0.0.0", // To enable connections from a remote host
-		host:             "127.0.0.1", // To restrict connections to local host only
+		tcr:              tcr,
+		host:             bindAddress(p),
 		devMode:          p.Trace == "http",
 		router:           nil,
 		httpServer:       nil,
 		websocketTimeout: 2 * time.Hour, // default timeout value
 		params:           p,
+		token:            p.WebToken,
 	}
 	tcr.AttachUI(&webUIServer, false)
 	return &webUIServer
@@ -70,10 +79,12 @@ func New(p params.Params, tcr engine.TCRInterface) *WebUIServer {
 // Start starts TCR HTTP server
 func (webUIServer *WebUIServer) Start() {
 	report.PostInfo("Starting HTTP server on port ", webUIServer.params.PortNumber)
+	webUIServer.reportAccess()
 	webUIServer.initGinEngine()
 	webUIServer.addStaticRoutes()
 	webUIServer.addAPIRoutes()
 	webUIServer.addWebsocketRoutes()
+	webUIServer.addMobRoutes()
 	webUIServer.startGinEngine()
 }
 
@@ -92,18 +103,42 @@ func (webUIServer *WebUIServer) initGinEngine() {
 		// backend and frontend on separate ports
 		webUIServer.router.Use(corsMiddleware())
 	}
+
+	if webUIServer.RequiresAuth() {
+		webUIServer.router.Use(authMiddleware(webUIServer.token, webUIServer.params.TLS))
+	}
+	// Prevent web pages served from other origins from changing TCR state
+	webUIServer.router.Use(ws.OriginMiddleware(webUIServer))
 }
 
 func (webUIServer *WebUIServer) startGinEngine() {
 	// Create HTTP server instance
 	webUIServer.httpServer = &http.Server{ //nolint:gosec
-		Addr:    webUIServer.GetServerAddress(),
+		Addr:    webUIServer.getListenAddress(),
 		Handler: webUIServer.router,
 	}
 
+	if webUIServer.params.TLS {
+		cert, err := loadOrCreateCertificate(webUIServer.certificateDir(), webUIServer.certificateHosts())
+		if err != nil {
+			report.PostError("could not create HTTP server certificate: ", err.Error())
+			return
+		}
+		webUIServer.httpServer.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+		report.PostInfo("HTTP server certificate SHA-256 fingerprint is ", mob.CertificateFingerprint(cert.Certificate[0]))
+	}
+
 	// Start HTTP server on its own goroutine
 	go func() {
-		err := webUIServer.httpServer.ListenAndServe()
+		var err error
+		if webUIServer.params.TLS {
+			err = webUIServer.httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = webUIServer.httpServer.ListenAndServe()
+		}
 		if err != nil {
 			report.PostError("could not start HTTP server: ", err.Error())
 		}
@@ -134,13 +169,23 @@ func (webUIServer *WebUIServer) addAPIRoutes() {
 	// Setup route group for the API
 	apiRoutes := webUIServer.router.Group("/api")
 	{
+		apiRoutes.GET("/openapi.yaml", api.OpenAPIGetHandler)
 		apiRoutes.GET("/build-info", api.BuildInfoGetHandler)
 		apiRoutes.GET("/session-info", api.SessionInfoGetHandler)
 		apiRoutes.GET("/roles", api.RolesGetHandler)
 		apiRoutes.GET("/roles/:name", api.RoleGetHandler)
 		apiRoutes.POST("/roles/:name/:action", api.RolesPostHandler)
 		apiRoutes.GET("/timer", api.TimerGetHandler)
+		apiRoutes.GET("/roster", api.RosterGetHandler)
+		apiRoutes.POST("/roster", api.RosterPostHandler)
+		apiRoutes.POST("/roster/:name/:action", api.RosterMemberPostHandler)
 		apiRoutes.POST("/controls/:name", api.ControlsPostHandler)
+		apiRoutes.POST("/settings/auto-push", api.AutoPushPostHandler)
+		apiRoutes.POST("/settings/variant", api.VariantPostHandler)
+		apiRoutes.POST("/settings/mob-timer", api.MobTimerPostHandler)
+		apiRoutes.GET("/log", api.LogGetHandler)
+		apiRoutes.GET("/stats", api.StatsGetHandler)
+		apiRoutes.GET("/retro", api.RetroGetHandler)
 	}
 }
 
@@ -151,14 +196,104 @@ func (webUIServer *WebUIServer) addWebsocketRoutes() {
 	webUIServer.router.GET("/ws", ws.WebsocketHandler)
 }
 
+func (webUIServer *WebUIServer) addMobRoutes() {
+	if !webUIServer.IsMobHost() {
+		return
+	}
+	report.PostInfo("Accepting mob guests on port ", webUIServer.params.PortNumber,
+		" with token ", webUIServer.token)
+	webUIServer.router.GET("/mob/ws", mobGuestAuthMiddleware(webUIServer.token), ws.MobWebsocketHandler)
+}
+
+// reportAccess tells the user how to reach the web UI, and warns when the server
+// can be reached from remote hosts
+func (webUIServer *WebUIServer) reportAccess() {
+	if !webUIServer.RequiresAuth() {
+		return
+	}
+	if !isLoopbackAddress(webUIServer.host) {
+		report.PostWarning("HTTP server is listening on ", webUIServer.host,
+			": requests sent from remote hosts require the session token")
+	}
+	report.PostInfo("Web UI is available at ", webUIServer.GetURL())
+}
+
+// IsMobHost indicates if the server accepts connections from mob guests
+func (webUIServer *WebUIServer) IsMobHost() bool {
+	return webUIServer.params.MobHost
+}
+
+// RequiresAuth indicates if HTTP clients must provide the session token
+func (webUIServer *WebUIServer) RequiresAuth() bool {
+	return webUIServer.token != ""
+}
+
+// bindAddress returns the IP address that the server listens to. Unless specified
+// otherwise, mob hosts listen to all network interfaces so that guests can connect
+// to them, while other servers restrict connections to the local host only
+func bindAddress(p params.Params) string {
+	if p.BindAddress != "" {
+		return p.BindAddress
+	}
+	if p.MobHost {
+		return "0.0.0.0"
+	}
+	return "127.0.0.1"
+}
+
+// getListenAddress returns the TCP address that the server listens to.
+func (webUIServer *WebUIServer) getListenAddress() string {
+	return net.JoinHostPort(webUIServer.host, strconv.Itoa(webUIServer.params.PortNumber))
+}
+
+// GetURL returns the URL that browsers can use to open the web UI, including
+// the session token when one is required
+func (webUIServer *WebUIServer) GetURL() string {
+	u := url.URL{Scheme: "http", Host: webUIServer.GetServerAddress(), Path: "/"}
+	if webUIServer.params.TLS {
+		u.Scheme = "https"
+	}
+	if webUIServer.RequiresAuth() {
+		u.RawQuery = url.Values{tokenQueryParam: {webUIServer.token}}.Encode()
+	}
+	return u.String()
+}
+
+// certificateDir returns the directory where the server's self-signed certificate is stored
+func (*WebUIServer) certificateDir() string {
+	if config.GetConfigDirPath() == "" {
+		return ""
+	}
+	return filepath.Join(config.GetConfigDirPath(), "web")
+}
+
+// certificateHosts returns the host names and IP addresses covered by the server's certificate
+func (webUIServer *WebUIServer) certificateHosts() []string {
+	hosts := []string{"localhost", "127.0.0.1", "::1"}
+	if ip := net.ParseIP(webUIServer.host); ip != nil && ip.IsUnspecified() {
+		if hostname, err := os.Hostname(); err == nil {
+			hosts = append(hosts, hostname)
+		}
+	} else if !isLoopbackAddress(webUIServer.host) {
+		hosts = append(hosts, webUIServer.host)
+	}
+	return hosts
+}
+
 // InDevMode indicates if the server is running in dev (development) mode
 func (webUIServer *WebUIServer) InDevMode() bool {
 	return webUIServer.devMode
 }
 
-// GetServerAddress returns the TCP server address that the server is listening to.
+// GetServerAddress returns the TCP server address that browsers on the local host
+// use to reach the server. When the server listens to all network interfaces,
+// the loopback address is used
 func (webUIServer *WebUIServer) GetServerAddress() string {
-	return fmt.Sprintf("%s:%d", webUIServer.host, webUIServer.params.PortNumber)
+	host := webUIServer.host
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(host, strconv.Itoa(webUIServer.params.PortNumber))
 }
 
 // GetWebsocketTimeout returns the timeout after which inactive websocket connections
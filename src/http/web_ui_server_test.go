@@ -34,6 +34,7 @@ import (
 	"github.com/murex/tcr/engine"
 	"github.com/murex/tcr/params"
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
 )
 
 func Test_create_web_ui_server(t *testing.T) {
@@ -147,22 +148,28 @@ func Test_init_gin_engine(t *testing.T) {
 	tests := []struct {
 		desc             string
 		devMode          bool
+		mobHost          bool
 		expectedHandlers int
 	}{
 		{
 			desc:             "development mode",
 			devMode:          true,
-			expectedHandlers: 3, // gin.Recovery, gin.Logger and corsMiddleware
+			expectedHandlers: 4, // gin.Recovery, gin.Logger, corsMiddleware and OriginMiddleware
 		},
 		{
 			desc:             "production mode",
 			devMode:          false,
-			expectedHandlers: 1, // gin.Recovery only
+			expectedHandlers: 2, // gin.Recovery and OriginMiddleware
+		},
+		{
+			desc:             "mob host",
+			mobHost:          true,
+			expectedHandlers: 3, // gin.Recovery, authMiddleware and OriginMiddleware
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
-			srv := New(*params.AParamSet(), engine.NewFakeTCREngine())
+			srv := New(*params.AParamSet(params.WithMobHost(test.mobHost)), engine.NewFakeTCREngine())
 			srv.devMode = test.devMode
 			srv.initGinEngine()
 			assert.NotNil(t, srv.router)
@@ -266,10 +273,46 @@ func Test_add_api_routes(t *testing.T) {
 			path:    "/api/timer",
 			methods: []string{http.MethodGet},
 		},
+		{
+			path:    "/api/roster",
+			methods: []string{http.MethodGet, http.MethodPost},
+		},
+		{
+			path:    "/api/roster/name/action",
+			methods: []string{http.MethodPost},
+		},
 		{
 			path:    "/api/controls/name",
 			methods: []string{http.MethodPost},
 		},
+		{
+			path:    "/api/openapi.yaml",
+			methods: []string{http.MethodGet},
+		},
+		{
+			path:    "/api/settings/auto-push",
+			methods: []string{http.MethodPost},
+		},
+		{
+			path:    "/api/settings/variant",
+			methods: []string{http.MethodPost},
+		},
+		{
+			path:    "/api/settings/mob-timer",
+			methods: []string{http.MethodPost},
+		},
+		{
+			path:    "/api/log",
+			methods: []string{http.MethodGet},
+		},
+		{
+			path:    "/api/stats",
+			methods: []string{http.MethodGet},
+		},
+		{
+			path:    "/api/retro",
+			methods: []string{http.MethodGet},
+		},
 	}
 
 	// Set up the router
@@ -281,6 +324,35 @@ func Test_add_api_routes(t *testing.T) {
 	testRESTRoutes(t, wuis.router, tests)
 }
 
+func Test_api_routes_are_described_in_openapi_spec(t *testing.T) {
+	wuis := New(*params.AParamSet(), engine.NewFakeTCREngine())
+	wuis.initGinEngine()
+	wuis.addAPIRoutes()
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/openapi.yaml", nil)
+	w := httptest.NewRecorder()
+	wuis.router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var spec struct {
+		Paths map[string]map[string]any `yaml:"paths"`
+	}
+	assert.NoError(t, yaml.Unmarshal(w.Body.Bytes(), &spec))
+
+	for _, route := range wuis.router.Routes() {
+		// Convert gin path parameters (":name") to OpenAPI ones ("{name}")
+		var segments []string
+		for segment := range strings.SplitSeq(route.Path, "/") {
+			if name, found := strings.CutPrefix(segment, ":"); found {
+				segment = "{" + name + "}"
+			}
+			segments = append(segments, segment)
+		}
+		path := strings.Join(segments, "/")
+		assert.Contains(t, spec.Paths[path], strings.ToLower(route.Method), "%s %s", route.Method, path)
+	}
+}
+
 func Test_add_websocket_routes(t *testing.T) {
 	tests := []testRESTRouteParams{
 		{
@@ -302,6 +374,42 @@ func Test_add_websocket_routes(t *testing.T) {
 	testRESTRoutes(t, wuis.router, tests)
 }
 
+func Test_add_mob_routes(t *testing.T) {
+	tests := []struct {
+		desc    string
+		mobHost bool
+		routes  []testRESTRouteParams
+	}{
+		{
+			desc:    "mob host",
+			mobHost: true,
+			routes: []testRESTRouteParams{
+				{path: "/mob/ws", methods: []string{http.MethodGet}},
+				{path: "/mob/ws/any", methods: []string{""}},
+			},
+		},
+		{
+			desc:    "not a mob host",
+			mobHost: false,
+			routes: []testRESTRouteParams{
+				{path: "/mob/ws", methods: []string{""}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			// Set up the router without mob authentication middleware so that
+			// unknown routes are reported as such
+			wuis := New(*params.AParamSet(params.WithMobHost(test.mobHost)), engine.NewFakeTCREngine())
+			wuis.router = gin.New()
+			wuis.addMobRoutes()
+
+			// check every route + REST method combination
+			testRESTRoutes(t, wuis.router, test.routes)
+		})
+	}
+}
+
 func Test_start_server(t *testing.T) {
 	wuis := New(*params.AParamSet(), engine.NewFakeTCREngine())
 	wuis.Start()
@@ -40,6 +40,8 @@ type serverContextKeyType string
 
 const serverContextKey serverContextKeyType = "tcr-http-server"
 
+const authenticatedContextKey serverContextKeyType = "tcr-authenticated"
+
 // HTTPServerMiddleware adds the HTTP server instance to gin context
 // so that websocket handlers can interact with it.
 func HTTPServerMiddleware(s tcrHTTPServer) gin.HandlerFunc {
@@ -49,10 +51,22 @@ func HTTPServerMiddleware(s tcrHTTPServer) gin.HandlerFunc {
 	}
 }
 
-// requestWithGinContext inserts gin context value set for
-// HTTP server instance to the request context sent to websocket handler
+// MarkAuthenticated records in gin context that the request carried a valid session token
+func MarkAuthenticated(c *gin.Context) {
+	c.Set(string(authenticatedContextKey), true)
+}
+
+// requestWithGinContext inserts gin context values set for HTTP server
+// instance and authentication to the request context sent to websocket handler
 func requestWithGinContext(c *gin.Context) *http.Request {
 	ctx := context.WithValue(c.Request.Context(),
 		serverContextKey, c.MustGet(string(serverContextKey)).(tcrHTTPServer))
+	ctx = context.WithValue(ctx, authenticatedContextKey, c.GetBool(string(authenticatedContextKey)))
 	return c.Request.WithContext(ctx)
 }
+
+// isAuthenticatedRequest indicates if the request carried a valid session token
+func isAuthenticatedRequest(r *http.Request) bool {
+	authenticated, _ := r.Context().Value(authenticatedContextKey).(bool)
+	return authenticated
+}
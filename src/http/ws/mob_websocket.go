@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package ws
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/murex/tcr/mob"
+	"github.com/murex/tcr/report"
+)
+
+var mobUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// Mob guests are TCR instances rather than browsers. They are
+	// authenticated through the mob token instead of their origin
+	CheckOrigin: func(_ *http.Request) bool {
+		return true
+	},
+}
+
+// ReportCycle reports the outcome of a TCR cycle
+func (r *MessageReporter) ReportCycle(c mob.Cycle) {
+	msg := newMessage(messageTypeCycle, messageSeverityNormal, false, c.Summary())
+	msg.Cycle = &c
+	r.write(msg)
+}
+
+// MobWebsocketHandler is the entry point for handling websocket requests sent by mob guests
+func MobWebsocketHandler(c *gin.Context) {
+	mobWebsocketConnectionHandler(c.Writer, requestWithGinContext(c))
+}
+
+// mobWebsocketConnectionHandler opens a websocket connection with a mob guest and keeps
+// it alive until either the guest disconnects or we reach the connection timeout.
+// In addition to report messages, the guest receives the outcome of TCR cycles
+func mobWebsocketConnectionHandler(w http.ResponseWriter, r *http.Request) {
+	conn, err := mobUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		report.PostWarning("failed to upgrade to a websocket connection: ", err.Error())
+		return
+	}
+
+	server := r.Context().Value(serverContextKey).(tcrHTTPServer)
+	reporter := newMessageReporter(server, conn)
+	reporter.startReporting()
+	unsubscribe := mob.Subscribe(reporter.ReportCycle)
+
+	ctx, cancel := context.WithTimeout(r.Context(), server.GetWebsocketTimeout())
+	defer cancel()
+
+	defer reporter.closeConnection(conn)
+	defer unsubscribe()
+
+	// Guests do not send anything: reading is only used to detect disconnections
+	go func() {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	<-ctx.Done()
+}
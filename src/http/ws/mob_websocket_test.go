@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package ws
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/mob"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/vcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_mob_websocket_sends_report_messages_and_cycles(t *testing.T) {
+	// Create HTTP test server with the mob websocket connection handler.
+	s := httptest.NewUnstartedServer(http.HandlerFunc(mobWebsocketConnectionHandler))
+	s.Config.BaseContext = func(_ net.Listener) context.Context {
+		return context.WithValue(context.Background(), serverContextKey, newFakeHTTPServer(s.URL))
+	}
+	s.Start()
+	defer s.Close()
+
+	u, _ := url.Parse(s.URL)
+	u.Scheme = "ws"
+	// Mob guests are not browsers and do not send any origin header
+	ws, _, err := websocket.DefaultDialer.Dial(u.String(), nil) //nolint:bodyclose
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer func() { _ = ws.Close() }()
+
+	// Leave time for the server to subscribe to reports and cycles
+	time.Sleep(50 * time.Millisecond)
+
+	t.Run("report message", func(t *testing.T) {
+		report.PostInfo("hello guest")
+		var msg message
+		_ = ws.SetReadDeadline(time.Now().Add(500 * time.Millisecond))
+		assert.NoError(t, ws.ReadJSON(&msg))
+		assertMessagesMatch(t, newMessage(messageTypeInfo, messageSeverityNormal, false, "hello guest"), msg)
+		assert.Nil(t, msg.Cycle)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		event := events.TCREvent{Status: events.StatusPass, Changes: events.NewChangedLines(2, 1)}
+		cycle := mob.NewCycle("alice", event, vcs.FileDiffs{vcs.NewFileDiff("src.go", 2, 0)})
+		mob.Publish(cycle)
+		var msg message
+		_ = ws.SetReadDeadline(time.Now().Add(500 * time.Millisecond))
+		assert.NoError(t, ws.ReadJSON(&msg))
+		assertMessagesMatch(t, newMessage(messageTypeCycle, messageSeverityNormal, false, cycle.Summary()), msg)
+		assert.Equal(t, &cycle, msg.Cycle)
+	})
+}
@@ -25,6 +25,7 @@ package ws
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -33,6 +34,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
+	"github.com/murex/tcr/mob"
 	"github.com/murex/tcr/report"
 	"github.com/murex/tcr/report/role_event"
 	"github.com/murex/tcr/report/text"
@@ -41,11 +43,12 @@ import (
 
 // message is used to JSON-encode TCR report messages
 type message struct {
-	Type      string `json:"type"`
-	Severity  string `json:"severity"`
-	Text      string `json:"text"`
-	Emphasis  bool   `json:"emphasis"`
-	Timestamp string `json:"timestamp"`
+	Type      string     `json:"type"`
+	Severity  string     `json:"severity"`
+	Text      string     `json:"text"`
+	Emphasis  bool       `json:"emphasis"`
+	Timestamp string     `json:"timestamp"`
+	Cycle     *mob.Cycle `json:"cycle,omitempty"`
 }
 
 type messageType string
@@ -59,6 +62,7 @@ const (
 	messageTypeError   messageType = "error"
 	messageTypeRole    messageType = "role"
 	messageTypeTimer   messageType = "timer"
+	messageTypeCycle   messageType = "cycle"
 )
 
 type messageSeverity int
@@ -144,6 +148,21 @@ func (r *MessageReporter) ReportTimerEvent(emphasis bool, payload timer_event.Me
 	r.write(newMessage(messageTypeTimer, messageSeverityNormal, emphasis, payload.ToString()))
 }
 
+// closeConnection stops reporting and gracefully closes the websocket connection
+func (r *MessageReporter) closeConnection(conn *websocket.Conn) {
+	// Stop reporting first to prevent new messages
+	r.stopReporting()
+
+	// Mark connection as closed in reporter
+	r.connMutex.Lock()
+	r.conn = nil
+	r.connMutex.Unlock()
+
+	// Send close frame and close connection gracefully
+	_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	_ = conn.Close()
+}
+
 func (r *MessageReporter) write(msg message) {
 	r.connMutex.Lock()
 	defer r.connMutex.Unlock()
@@ -166,27 +185,84 @@ var upgrader = websocket.Upgrader{
 	WriteBufferSize: 1024,
 	CheckOrigin: func(r *http.Request) bool {
 		server := r.Context().Value(serverContextKey).(tcrHTTPServer)
-		if server.InDevMode() {
-			// server and client ports are different when running in devMode,
-			// so we bypass any CORS restriction in this mode
-			return true
-		}
-		origin := r.Header.Get("Origin")
-		url, err := url.Parse(origin)
-		if err != nil {
-			report.PostWarning("invalid origin: \"", origin, "\" - ", err.Error())
-			return false
+		return checkOrigin(r.Header.Get("Origin"), r.Host, server, isAuthenticatedRequest(r))
+	},
+}
+
+// OriginMiddleware rejects state-changing requests sent by web pages served from origins
+// that websocket connections are not accepted from. Requests without Origin header, such as
+// the ones sent by command line clients, are let through as browsers always provide it
+// when sending such requests
+func OriginMiddleware(s tcrHTTPServer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
 		}
-		if url.Host != server.GetServerAddress() {
-			// Note: This policy is quite restrictive:
-			// - can't use "localhost" in browser URL as server is listening on "127.0.0.1".
-			// - will not work if we allow connections from any HTTP client (eg. listening on "0.0.0.0")
-			// We may need to soften it a bit depending on intended usage
-			report.PostWarning("client host not authorized: ", url.Host)
-			return false
+		origin := c.Request.Header.Get("Origin")
+		if origin != "" && !checkOrigin(origin, c.Request.Host, s, c.GetBool(string(authenticatedContextKey))) {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
 		}
+		c.Next()
+	}
+}
+
+// checkOrigin indicates if a request sent by a web page served from origin can be accepted
+func checkOrigin(origin string, requestHost string, server tcrHTTPServer, authenticated bool) bool {
+	if server.InDevMode() {
+		// server and client ports are different when running in devMode,
+		// so we bypass any CORS restriction in this mode
 		return true
-	},
+	}
+	url, err := url.Parse(origin)
+	if err != nil {
+		report.PostWarning("invalid origin: \"", origin, "\" - ", err.Error())
+		return false
+	}
+	if !isAllowedOrigin(url.Host, requestHost, server, authenticated) {
+		report.PostWarning("client host not authorized: ", url.Host)
+		return false
+	}
+	return true
+}
+
+// isAllowedOrigin indicates if a websocket connection request sent by a web page
+// served from originHost to requestHost can be accepted. Accepted origins are:
+// - the server address, or a loopback alias of it such as "localhost" on the same port
+// - the host that the request was sent to when the request carried a valid session
+// token. Requests let through without token, such as loopback requests, do not
+// qualify as a DNS-rebinding page could then connect to a loopback-bound server
+func isAllowedOrigin(originHost string, requestHost string, server tcrHTTPServer, authenticated bool) bool {
+	if originHost == server.GetServerAddress() {
+		return true
+	}
+	if isLoopbackHost(originHost) && isLoopbackHost(server.GetServerAddress()) &&
+		samePort(originHost, server.GetServerAddress()) {
+		return true
+	}
+	return authenticated && originHost == requestHost
+}
+
+// isLoopbackHost indicates if the provided host:port address refers to the local host
+func isLoopbackHost(hostPort string) bool {
+	host, _, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// samePort indicates if both host:port addresses have the same port
+func samePort(hostPort1 string, hostPort2 string) bool {
+	_, port1, err1 := net.SplitHostPort(hostPort1)
+	_, port2, err2 := net.SplitHostPort(hostPort2)
+	return err1 == nil && err2 == nil && port1 == port2
 }
 
 // WebsocketHandler is the entry point for handling websocket requests sent to the HTTP server
@@ -212,19 +288,7 @@ func websocketConnectionHandler(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), server.GetWebsocketTimeout())
 	defer cancel()
 
-	defer func() {
-		// Stop reporting first to prevent new messages
-		reporter.stopReporting()
-
-		// Mark connection as closed in reporter
-		reporter.connMutex.Lock()
-		reporter.conn = nil
-		reporter.connMutex.Unlock()
-
-		// Send close frame and close connection gracefully
-		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
-		_ = conn.Close()
-	}()
+	defer reporter.closeConnection(conn)
 
 	// We kill the connection after a fixed period of time to avoid keeping sending
 	// messages to clients that are no longer there.
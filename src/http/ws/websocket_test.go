@@ -33,6 +33,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 	"github.com/murex/tcr/report"
 	"github.com/murex/tcr/report/role_event"
@@ -290,6 +291,86 @@ func Test_websocket_upgrader_with_invalid_request_header(t *testing.T) {
 	}
 }
 
+func Test_websocket_allowed_origins(t *testing.T) {
+	tests := []struct {
+		desc          string
+		serverAddress string
+		authenticated bool
+		originHost    string
+		requestHost   string
+		expected      bool
+	}{
+		{"server address", "127.0.0.1:8483", false, "127.0.0.1:8483", "127.0.0.1:8483", true},
+		{"localhost alias", "127.0.0.1:8483", false, "localhost:8483", "localhost:8483", true},
+		{"IPv6 loopback alias", "127.0.0.1:8483", false, "[::1]:8483", "[::1]:8483", true},
+		{"loopback alias on another port", "127.0.0.1:8483", false, "localhost:9999", "127.0.0.1:8483", false},
+		{"remote origin without authentication", "127.0.0.1:8483", false, "192.168.1.2:8483", "192.168.1.2:8483", false},
+		{"remote origin with authentication", "127.0.0.1:8483", true, "192.168.1.2:8483", "192.168.1.2:8483", true},
+		{"rebound origin without authentication", "127.0.0.1:8483", false, "evil.example.com:8483", "evil.example.com:8483", false},
+		{"cross-site origin with authentication", "127.0.0.1:8483", true, "evil.example.com", "192.168.1.2:8483", false},
+		{"loopback alias of a remote server address", "192.168.1.2:8483", false, "localhost:8483", "localhost:8483", false},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			server := &fakeHTTPServer{url: url.URL{Host: test.serverAddress}}
+			assert.Equal(t, test.expected, isAllowedOrigin(test.originHost, test.requestHost, server, test.authenticated))
+		})
+	}
+}
+
+func Test_websocket_origin_matching_request_host_requires_authentication(t *testing.T) {
+	tests := []struct {
+		desc          string
+		authenticated bool
+		expected      bool
+	}{
+		{"without token", false, false},
+		{"with valid token", true, true},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			server := &fakeHTTPServer{url: url.URL{Host: "127.0.0.1:8483"}}
+			ctx := context.WithValue(context.Background(), serverContextKey, server)
+			ctx = context.WithValue(ctx, authenticatedContextKey, test.authenticated)
+			req := httptest.NewRequest(http.MethodGet, "http://rebound.example.com:8483/ws", nil).WithContext(ctx)
+			req.Header.Set("Origin", "http://rebound.example.com:8483")
+			assert.Equal(t, test.expected, upgrader.CheckOrigin(req))
+		})
+	}
+}
+
+func Test_origin_middleware(t *testing.T) {
+	tests := []struct {
+		desc     string
+		method   string
+		origin   string
+		expected int
+	}{
+		{"POST from the web UI", http.MethodPost, "http://127.0.0.1:8483", http.StatusOK},
+		{"POST from a cross-site page", http.MethodPost, "http://evil.example.com", http.StatusForbidden},
+		{"POST from a rebound page", http.MethodPost, "http://rebound.example.com:8483", http.StatusForbidden},
+		{"POST without origin", http.MethodPost, "", http.StatusOK},
+		{"GET from a cross-site page", http.MethodGet, "http://evil.example.com", http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			server := &fakeHTTPServer{url: url.URL{Host: "127.0.0.1:8483"}}
+			router.Use(OriginMiddleware(server))
+			router.Handle(test.method, "/api/test", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(test.method, "http://rebound.example.com:8483/api/test", nil)
+			if test.origin != "" {
+				req.Header.Set("Origin", test.origin)
+			}
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			assert.Equal(t, test.expected, rr.Code)
+		})
+	}
+}
+
 // assertMessagesMatch checks that 2 message instance messages match.
 // Used in place of assert.Equal() to ignore potential timestamp variations.
 func assertMessagesMatch(t *testing.T, expected message, msg message) {
@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package journal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/murex/tcr/events"
+)
+
+// EntryType is the type of event recorded in a journal entry
+type EntryType string
+
+// List of possible values for EntryType
+const (
+	RoleStart  EntryType = "role-start"
+	RoleEnd    EntryType = "role-end"
+	TimerStart EntryType = "timer-start"
+	TimerStop  EntryType = "timer-stop"
+	Build      EntryType = "build"
+	Test       EntryType = "test"
+	Format     EntryType = "format"
+	Lint       EntryType = "lint"
+	Commit     EntryType = "commit"
+	Revert     EntryType = "revert"
+	Abort      EntryType = "abort"
+)
+
+type (
+	// ChangedLines provides the journal structure containing info related to
+	// the lines changes in src and test
+	ChangedLines struct {
+		Src  int `json:"src"`
+		Test int `json:"test"`
+	}
+
+	// TestStats provides the journal structure containing info related to the tests execution
+	TestStats struct {
+		Run      int           `json:"run"`
+		Passed   int           `json:"passed"`
+		Failed   int           `json:"failed"`
+		Skipped  int           `json:"skipped"`
+		Error    int           `json:"error"`
+		Duration time.Duration `json:"duration"`
+	}
+
+	// Entry is a journal record. Apart from Timestamp and Type,
+	// fields are set only when relevant for the entry type
+	Entry struct {
+		Timestamp time.Time            `json:"timestamp"`
+		Type      EntryType            `json:"type"`
+		BaseDir   string               `json:"base-dir"`
+		Branch    string               `json:"branch"`
+		Role      string               `json:"role,omitempty"`
+		Status    events.CommandStatus `json:"status,omitempty"`
+		Duration  time.Duration        `json:"duration,omitempty"`
+		Files     int                  `json:"files,omitempty"`
+		Changes   *ChangedLines        `json:"changes,omitempty"`
+		Tests     *TestStats           `json:"tests,omitempty"`
+		Phase     events.Phase         `json:"phase,omitempty"`
+	}
+
+	// Entries is a slice of Entry
+	Entries []Entry
+)
+
+// NewTestStats converts TCR event test stats into journal test stats
+func NewTestStats(stats events.TestStats) *TestStats {
+	s := TestStats(stats)
+	return &s
+}
+
+// NewChangedLines converts TCR event changed lines into journal changed lines
+func NewChangedLines(changes events.ChangedLines) *ChangedLines {
+	c := ChangedLines(changes)
+	return &c
+}
+
+// Summary returns a one-line description of the entry details
+func (e Entry) Summary() string {
+	switch e.Type {
+	case RoleStart, RoleEnd:
+		return fmt.Sprint(e.Role, " role")
+	case TimerStart:
+		return fmt.Sprint("timer set to ", e.Duration)
+	case TimerStop:
+		return fmt.Sprint("timer stopped after ", e.Duration)
+	case Build:
+		return fmt.Sprint("build ", e.Status)
+	case Format:
+		return fmt.Sprint("format ", e.Status)
+	case Lint:
+		return fmt.Sprint("lint ", e.Status)
+	case Test:
+		s := fmt.Sprint("tests ", e.Status)
+		if e.Tests != nil {
+			s += fmt.Sprint(", ", e.Tests.Passed, " passed, ", e.Tests.Failed, " failed out of ", e.Tests.Run)
+		}
+		return s
+	case Commit:
+		summary := "changes committed"
+		if e.Changes != nil {
+			summary = fmt.Sprint(e.Changes.Src, " src line(s), ", e.Changes.Test, " test line(s) committed")
+		}
+		if e.Phase != events.PhaseNone {
+			summary += fmt.Sprint(" (", e.Phase, " phase)")
+		}
+		return summary
+	case Revert:
+		if e.Files == 0 {
+			return "changes reverted"
+		}
+		return fmt.Sprint(e.Files, " file(s) reverted")
+	case Abort:
+		return "command aborted"
+	default:
+		return string(e.Type)
+	}
+}
+
+// Count returns the number of entries with the provided type. When one or more
+// statuses are provided, only entries with one of these statuses are counted
+func (entries Entries) Count(t EntryType, statuses ...events.CommandStatus) (count int) {
+	for _, e := range entries {
+		if e.Type != t {
+			continue
+		}
+		if len(statuses) == 0 {
+			count++
+			continue
+		}
+		for _, s := range statuses {
+			if e.Status == s {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+// CountRoleStarts returns the number of times the role with the provided name was started
+func (entries Entries) CountRoleStarts(roleName string) (count int) {
+	for _, e := range entries {
+		if e.Type == RoleStart && e.Role == roleName {
+			count++
+		}
+	}
+	return count
+}
+
+// ToTcrEvents converts the test entries into TCR events
+func (entries Entries) ToTcrEvents() (tcrEvents events.TcrEvents) {
+	tcrEvents = *events.NewTcrEvents()
+	for _, e := range entries {
+		if e.Type != Test {
+			continue
+		}
+		var changes events.ChangedLines
+		if e.Changes != nil {
+			changes = events.ChangedLines(*e.Changes)
+		}
+		var stats events.TestStats
+		if e.Tests != nil {
+			stats = events.TestStats(*e.Tests)
+		}
+		tcrEvents.Add(e.Timestamp, events.NewTCREvent(e.Status, changes, stats))
+	}
+	return tcrEvents
+}
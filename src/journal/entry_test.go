@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package journal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_entry_summary(t *testing.T) {
+	testFlags := []struct {
+		entry    Entry
+		expected string
+	}{
+		{Entry{Type: RoleStart, Role: "driver"}, "driver role"},
+		{Entry{Type: RoleEnd, Role: "navigator"}, "navigator role"},
+		{Entry{Type: TimerStart, Duration: 5 * time.Minute}, "timer set to 5m0s"},
+		{Entry{Type: TimerStop, Duration: 90 * time.Second}, "timer stopped after 1m30s"},
+		{Entry{Type: Build, Status: events.StatusFail}, "build fail"},
+		{Entry{Type: Format, Status: events.StatusPass}, "format pass"},
+		{Entry{Type: Lint, Status: events.StatusFail}, "lint fail"},
+		{Entry{Type: Test, Status: events.StatusPass}, "tests pass"},
+		{
+			Entry{Type: Test, Status: events.StatusFail, Tests: &TestStats{Run: 3, Passed: 2, Failed: 1}},
+			"tests fail, 2 passed, 1 failed out of 3",
+		},
+		{Entry{Type: Commit}, "changes committed"},
+		{Entry{Type: Commit, Changes: &ChangedLines{Src: 1, Test: 2}}, "1 src line(s), 2 test line(s) committed"},
+		{Entry{Type: Commit, Phase: events.PhaseRed}, "changes committed (red phase)"},
+		{Entry{Type: Revert, Files: 2}, "2 file(s) reverted"},
+		{Entry{Type: Revert}, "changes reverted"},
+		{Entry{Type: Abort}, "command aborted"},
+	}
+	for _, tt := range testFlags {
+		t.Run(string(tt.entry.Type), func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.entry.Summary())
+		})
+	}
+}
+
+func Test_count_entries(t *testing.T) {
+	entries := Entries{
+		{Type: Build, Status: events.StatusPass},
+		{Type: Build, Status: events.StatusFail},
+		{Type: Build, Status: events.StatusFail},
+		{Type: Revert, Files: 1},
+	}
+	assert.Equal(t, 3, entries.Count(Build))
+	assert.Equal(t, 2, entries.Count(Build, events.StatusFail))
+	assert.Equal(t, 3, entries.Count(Build, events.StatusFail, events.StatusPass))
+	assert.Equal(t, 1, entries.Count(Revert))
+	assert.Equal(t, 0, entries.Count(Abort))
+}
+
+func Test_count_role_starts(t *testing.T) {
+	entries := Entries{
+		{Type: RoleStart, Role: "driver"},
+		{Type: RoleEnd, Role: "driver"},
+		{Type: RoleStart, Role: "navigator"},
+		{Type: RoleStart, Role: "driver"},
+	}
+	assert.Equal(t, 2, entries.CountRoleStarts("driver"))
+	assert.Equal(t, 1, entries.CountRoleStarts("navigator"))
+}
+
+func Test_entries_to_tcr_events(t *testing.T) {
+	t1 := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	t2 := t1.Add(time.Minute)
+	entries := Entries{
+		{Timestamp: t1, Type: Build, Status: events.StatusPass},
+		{
+			Timestamp: t1, Type: Test, Status: events.StatusFail,
+			Changes: &ChangedLines{Src: 1, Test: 2},
+			Tests:   &TestStats{Run: 1, Failed: 1},
+		},
+		{Timestamp: t2, Type: Test, Status: events.StatusPass},
+	}
+	expected := events.TcrEvents{
+		events.NewDatedTcrEvent(t1, events.NewTCREvent(events.StatusFail,
+			events.NewChangedLines(1, 2), events.NewTestStats(1, 0, 1, 0, 0, 0))),
+		events.NewDatedTcrEvent(t2, events.NewTCREvent(events.StatusPass,
+			events.ChangedLines{}, events.TestStats{})),
+	}
+	assert.Equal(t, expected, entries.ToTcrEvents())
+}
+
+func Test_conversion_from_tcr_event_data(t *testing.T) {
+	assert.Equal(t, &ChangedLines{Src: 1, Test: 2}, NewChangedLines(events.NewChangedLines(1, 2)))
+	assert.Equal(t, &TestStats{Run: 3, Passed: 2, Failed: 1, Duration: time.Second},
+		NewTestStats(events.NewTestStats(3, 2, 1, 0, 0, time.Second)))
+}
@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const fileName = "journal.jsonl"
+
+var filePath string
+
+// InitConfig initializes the session journal location in the provided
+// configuration directory. The journal is disabled when no configuration
+// directory is provided
+func InitConfig(configDirPath string) {
+	if configDirPath == "" {
+		filePath = ""
+		return
+	}
+	filePath = filepath.Join(configDirPath, fileName)
+}
+
+// GetFilePath returns the path to the session journal file.
+// Returns an empty string when the journal location is not initialized
+func GetFilePath() string {
+	return filePath
+}
+
+// Journal is an append-only record of the events occurring during TCR sessions.
+// Entries are stored as JSON lines, one entry per line
+type Journal struct {
+	path    string
+	baseDir string
+	branch  string
+	mutex   sync.Mutex
+}
+
+// New creates a journal appending entries to the file at the provided path.
+// All recorded entries are attached to the provided base directory and branch
+func New(path string, baseDir string, branch string) *Journal {
+	return &Journal{
+		path:    path,
+		baseDir: baseDir,
+		branch:  branch,
+	}
+}
+
+// Record appends the provided entry to the journal.
+// Entry timestamp is set to the current time if not already set.
+// Recording into a nil journal does nothing
+func (j *Journal) Record(entry Entry) error {
+	if j == nil || j.path == "" {
+		return nil
+	}
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now().UTC()
+	}
+	entry.BaseDir = j.baseDir
+	entry.Branch = j.branch
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	err = os.MkdirAll(filepath.Dir(j.path), 0750)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(j.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(line, '\n'))
+	return errors.Join(err, f.Close())
+}
+
+// Read returns the entries of the journal file at the provided path that
+// were recorded for the provided base directory and branch.
+// Returns no entry if the journal file does not exist
+func Read(path string, baseDir string, branch string) (entries Entries, err error) {
+	f, err := os.Open(path) //nolint:gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry Entry
+		if err = json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, err
+		}
+		if entry.BaseDir == baseDir && entry.Branch == branch {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package journal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_init_config_sets_journal_file_path(t *testing.T) {
+	InitConfig("some-dir")
+	assert.Equal(t, filepath.Join("some-dir", "journal.jsonl"), GetFilePath())
+}
+
+func Test_journal_is_disabled_without_config_dir(t *testing.T) {
+	InitConfig("")
+	assert.Empty(t, GetFilePath())
+}
+
+func Test_recording_into_a_nil_journal_does_nothing(t *testing.T) {
+	var j *Journal
+	assert.NoError(t, j.Record(Entry{Type: Build}))
+}
+
+func Test_recording_into_a_journal_with_no_path_does_nothing(t *testing.T) {
+	assert.NoError(t, New("", "base-dir", "branch").Record(Entry{Type: Build}))
+}
+
+func Test_recorded_entries_can_be_read_back(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub-dir", "journal.jsonl")
+	timestamp := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	j := New(path, "base-dir", "branch")
+	recorded := Entries{
+		{Timestamp: timestamp, Type: RoleStart, Role: "driver"},
+		{Timestamp: timestamp, Type: Build, Status: events.StatusFail},
+		{
+			Timestamp: timestamp, Type: Test, Status: events.StatusPass,
+			Changes: &ChangedLines{Src: 1, Test: 2},
+			Tests:   &TestStats{Run: 3, Passed: 3, Duration: time.Second},
+		},
+	}
+	for _, e := range recorded {
+		assert.NoError(t, j.Record(e))
+	}
+	// Entries recorded for another base directory or branch are ignored
+	assert.NoError(t, New(path, "other-dir", "branch").Record(Entry{Type: Abort}))
+	assert.NoError(t, New(path, "base-dir", "other-branch").Record(Entry{Type: Abort}))
+
+	entries, err := Read(path, "base-dir", "branch")
+	assert.NoError(t, err)
+	for i := range recorded {
+		recorded[i].BaseDir = "base-dir"
+		recorded[i].Branch = "branch"
+	}
+	assert.Equal(t, recorded, entries)
+}
+
+func Test_recording_sets_entry_timestamp_when_not_set(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	before := time.Now()
+	assert.NoError(t, New(path, "", "").Record(Entry{Type: Abort}))
+	entries, _ := Read(path, "", "")
+	assert.Len(t, entries, 1)
+	assert.False(t, entries[0].Timestamp.Before(before.Truncate(time.Second)))
+}
+
+func Test_reading_a_missing_journal_returns_no_entry(t *testing.T) {
+	entries, err := Read(filepath.Join(t.TempDir(), "journal.jsonl"), "", "")
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func Test_reading_an_invalid_journal_returns_an_error(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	assert.NoError(t, os.WriteFile(path, []byte("not json\n"), 0600))
+	_, err := Read(path, "", "")
+	assert.Error(t, err)
+}
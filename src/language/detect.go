@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package language
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+type (
+	// Detection is a language or toolchain candidate found when inspecting a directory,
+	// along with a confidence score between 0 and 1
+	Detection struct {
+		Name       string
+		Confidence float64
+	}
+
+	// Detections is a list of detections sorted from the most to the least likely
+	Detections []Detection
+
+	// marker is a hint that a language or toolchain is being used in a directory:
+	// either a file matching pattern in this directory, or a command available on the
+	// local machine. Its weight indicates how confident we are when it is found
+	marker struct {
+		pattern string
+		command string
+		weight  float64
+	}
+)
+
+// dirNameWeight is the weight given to a base directory named after a language
+const dirNameWeight = 0.5
+
+var (
+	// lookPath allows to replace command lookup when testing
+	lookPath = exec.LookPath
+
+	languageMarkers = map[string][]marker{
+		"cpp":        {{pattern: "CMakeLists.txt", weight: 0.8}, {pattern: "*.cpp", weight: 0.4}},
+		"csharp":     {{pattern: "*.sln", weight: 0.8}, {pattern: "*.csproj", weight: 0.9}},
+		"elixir":     {{pattern: "mix.exs", weight: 0.9}},
+		"go":         {{pattern: "go.mod", weight: 0.9}, {pattern: "*.go", weight: 0.5}},
+		"haskell":    {{pattern: "stack.yaml", weight: 0.9}, {pattern: "*.cabal", weight: 0.8}},
+		"java":       {{pattern: "pom.xml", weight: 0.6}, {pattern: "build.gradle", weight: 0.4}, {pattern: "build.gradle.kts", weight: 0.3}, {pattern: "src/main/java", weight: 0.8}},
+		"javascript": {{pattern: "package.json", weight: 0.6}},
+		"kotlin":     {{pattern: "build.gradle.kts", weight: 0.4}, {pattern: "src/main/kotlin", weight: 0.8}},
+		"php":        {{pattern: "composer.json", weight: 0.9}, {pattern: "phpunit.xml", weight: 0.6}},
+		"python":     {{pattern: "pyproject.toml", weight: 0.8}, {pattern: "setup.py", weight: 0.8}, {pattern: "requirements.txt", weight: 0.6}},
+		"rust":       {{pattern: "Cargo.toml", weight: 0.9}},
+		"scala":      {{pattern: "build.sbt", weight: 0.9}, {pattern: "src/main/scala", weight: 0.8}},
+		"typescript": {{pattern: "tsconfig.json", weight: 0.8}, {pattern: "package.json", weight: 0.3}},
+	}
+
+	toolchainMarkers = map[string][]marker{
+		"bazel":          {{pattern: "MODULE.bazel", weight: 0.9}, {pattern: "WORKSPACE", weight: 0.9}, {pattern: "WORKSPACE.bazel", weight: 0.9}},
+		"cargo":          {{pattern: "Cargo.toml", weight: 0.5}},
+		"cmake":          {{pattern: "CMakeLists.txt", weight: 0.8}},
+		"dotnet":         {{pattern: "*.sln", weight: 0.8}, {pattern: "*.csproj", weight: 0.8}},
+		"go-tools":       {{pattern: "go.mod", weight: 0.5}},
+		"gotestsum":      {{pattern: "go.mod", weight: 0.5}, {command: "gotestsum", weight: 0.4}},
+		"gradle":         {{pattern: "build.gradle", weight: 0.6}, {pattern: "build.gradle.kts", weight: 0.6}},
+		"gradle-wrapper": {{pattern: "gradlew", weight: 0.9}},
+		"make":           {{pattern: "Makefile", weight: 0.4}},
+		"maven":          {{pattern: "pom.xml", weight: 0.6}},
+		"maven-wrapper":  {{pattern: "mvnw", weight: 0.9}},
+		"mix":            {{pattern: "mix.exs", weight: 0.8}},
+		"nextest":        {{pattern: ".config/nextest.toml", weight: 0.9}},
+		"phpunit":        {{pattern: "phpunit.xml", weight: 0.9}, {pattern: "composer.json", weight: 0.4}},
+		"pytest":         {{pattern: "pytest.ini", weight: 0.9}, {pattern: "conftest.py", weight: 0.7}, {pattern: "pyproject.toml", weight: 0.4}},
+		"sbt":            {{pattern: "build.sbt", weight: 0.8}},
+		"stack":          {{pattern: "stack.yaml", weight: 0.8}},
+		"yarn":           {{pattern: "yarn.lock", weight: 0.8}, {pattern: "package.json", weight: 0.4}},
+	}
+)
+
+// String returns the detection's name and confidence score
+func (d Detection) String() string {
+	return fmt.Sprintf("%s (confidence: %.0f%%)", d.Name, 100*d.Confidence)
+}
+
+// Names returns the description of each detection, with its confidence score
+func (d Detections) Names() (names []string) {
+	for _, detection := range d {
+		names = append(names, detection.String())
+	}
+	return names
+}
+
+// Best returns the most likely detection. Returns false if there is none
+func (d Detections) Best() (Detection, bool) {
+	if len(d) == 0 {
+		return Detection{}, false
+	}
+	return d[0], true
+}
+
+// DetectLanguages inspects the contents of the provided directory and returns the registered
+// languages that may be used there, from the most to the least likely.
+// A directory named after a language is also considered as a hint for this language
+func DetectLanguages(baseDir string) (detections Detections) {
+	if baseDir == "" {
+		return nil
+	}
+	dirName := strings.ToLower(filepath.Base(baseDir))
+	for name := range registered {
+		markers := languageMarkers[name]
+		if name == dirName {
+			markers = append(slices.Clone(markers), marker{weight: dirNameWeight})
+		}
+		if confidence := markersConfidence(baseDir, markers); confidence > 0 {
+			detections = append(detections, Detection{Name: registered[name].GetName(), Confidence: confidence})
+		}
+	}
+	detections.sort("")
+	return detections
+}
+
+// DetectToolchains inspects the contents of the provided directory and returns the toolchains
+// out of the provided ones that may be used there, from the most to the least likely.
+// Default toolchain comes first in case of equal confidence scores
+func DetectToolchains(toolchains Toolchains, baseDir string) (detections Detections) {
+	if baseDir == "" {
+		return nil
+	}
+	for _, name := range toolchains.Compatible {
+		if confidence := markersConfidence(baseDir, toolchainMarkers[strings.ToLower(name)]); confidence > 0 {
+			detections = append(detections, Detection{Name: name, Confidence: confidence})
+		}
+	}
+	detections.sort(toolchains.Default)
+	return detections
+}
+
+func (d Detections) sort(preferred string) {
+	slices.SortFunc(d, func(a, b Detection) int {
+		switch {
+		case a.Confidence != b.Confidence:
+			if a.Confidence > b.Confidence {
+				return -1
+			}
+			return 1
+		case a.Name == preferred:
+			return -1
+		case b.Name == preferred:
+			return 1
+		default:
+			return strings.Compare(a.Name, b.Name)
+		}
+	})
+}
+
+// markersConfidence returns the confidence resulting from the markers found in baseDir.
+// Each marker found lowers the remaining uncertainty by its weight
+func markersConfidence(baseDir string, markers []marker) float64 {
+	uncertainty := 1.0
+	for _, m := range markers {
+		if m.isFoundIn(baseDir) {
+			uncertainty *= 1 - m.weight
+		}
+	}
+	return 1 - uncertainty
+}
+
+func (m marker) isFoundIn(baseDir string) bool {
+	switch {
+	case m.pattern != "":
+		matches, err := afero.Glob(appFS, filepath.Join(baseDir, filepath.FromSlash(m.pattern)))
+		return err == nil && len(matches) > 0
+	case m.command != "":
+		_, err := lookPath(m.command)
+		return err == nil
+	default:
+		// markers with no pattern and no command are always found
+		return true
+	}
+}
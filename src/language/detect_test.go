@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package language
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+// dirWithFiles creates a temporary directory containing the provided files.
+// Files ending with a slash are created as directories
+func dirWithFiles(t *testing.T, name string, files ...string) string {
+	t.Helper()
+	appFS = afero.NewOsFs()
+	dir := filepath.Join(t.TempDir(), name)
+	for _, file := range append([]string{""}, files...) {
+		path := filepath.Join(dir, filepath.FromSlash(file))
+		if file == "" || file[len(file)-1] == '/' {
+			if err := os.MkdirAll(path, 0750); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte{}, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func Test_detect_language_from_directory_contents(t *testing.T) {
+	testFlags := []struct {
+		desc     string
+		dirName  string
+		files    []string
+		expected string
+	}{
+		{"cpp with cmake", "project", []string{"CMakeLists.txt"}, "cpp"},
+		{"csharp with project file", "project", []string{"Project.csproj"}, "csharp"},
+		{"elixir with mix", "project", []string{"mix.exs"}, "elixir"},
+		{"go with module file", "project", []string{"go.mod", "main.go"}, "go"},
+		{"haskell with stack", "project", []string{"stack.yaml"}, "haskell"},
+		{"java with maven", "project", []string{"pom.xml", "src/main/java/"}, "java"},
+		{"java with gradle kotlin dsl", "project", []string{"build.gradle.kts", "src/main/java/"}, "java"},
+		{"kotlin with gradle kotlin dsl", "project", []string{"build.gradle.kts", "src/main/kotlin/"}, "kotlin"},
+		{"javascript with package file", "project", []string{"package.json"}, "javascript"},
+		{"typescript with package and tsconfig files", "project", []string{"package.json", "tsconfig.json"}, "typescript"},
+		{"php with composer", "project", []string{"composer.json"}, "php"},
+		{"python with pyproject", "project", []string{"pyproject.toml"}, "python"},
+		{"rust with cargo", "project", []string{"Cargo.toml"}, "rust"},
+		{"scala with sbt", "project", []string{"build.sbt"}, "scala"},
+		{"directory named after a language", "java", nil, "java"},
+		{"contents prevail over directory name", "java", []string{"go.mod"}, "go"},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			best, found := DetectLanguages(dirWithFiles(t, tt.dirName, tt.files...)).Best()
+			assert.True(t, found)
+			assert.Equal(t, tt.expected, best.Name)
+		})
+	}
+}
+
+func Test_detect_no_language_in_empty_directory(t *testing.T) {
+	assert.Empty(t, DetectLanguages(dirWithFiles(t, "project")))
+}
+
+func Test_detect_no_language_without_directory(t *testing.T) {
+	assert.Empty(t, DetectLanguages(""))
+}
+
+func Test_language_detection_confidence(t *testing.T) {
+	detections := DetectLanguages(dirWithFiles(t, "project", "package.json", "tsconfig.json"))
+	assert.Equal(t, []string{
+		"typescript (confidence: 86%)",
+		"javascript (confidence: 60%)",
+	}, detections.Names())
+}
+
+func Test_get_language_detected_from_directory_contents(t *testing.T) {
+	lang, err := GetLanguage("", dirWithFiles(t, "project", "Cargo.toml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "rust", lang.GetName())
+}
+
+func Test_get_language_fails_when_nothing_is_detected(t *testing.T) {
+	_, err := GetLanguage("", dirWithFiles(t, "project"))
+	assert.Error(t, err)
+}
+
+func Test_detect_toolchain_from_directory_contents(t *testing.T) {
+	javaToolchains := Toolchains{Default: "gradle-wrapper", Compatible: []string{"gradle", "gradle-wrapper", "maven", "maven-wrapper", "bazel", "make"}}
+	goToolchains := Toolchains{Default: "go-tools", Compatible: []string{"bazel", "go-tools", "gotestsum", "make"}}
+	testFlags := []struct {
+		desc       string
+		toolchains Toolchains
+		files      []string
+		gotestsum  bool
+		expected   []string
+	}{
+		{"no marker", javaToolchains, nil, false, nil},
+		{"gradle", javaToolchains, []string{"build.gradle"}, false, []string{"gradle"}},
+		{"gradle wrapper", javaToolchains, []string{"build.gradle", "gradlew"}, false, []string{"gradle-wrapper", "gradle"}},
+		{"maven", javaToolchains, []string{"pom.xml"}, false, []string{"maven"}},
+		{"maven wrapper", javaToolchains, []string{"pom.xml", "mvnw"}, false, []string{"maven-wrapper", "maven"}},
+		{"bazel", javaToolchains, []string{"pom.xml", "MODULE.bazel"}, false, []string{"bazel", "maven"}},
+		{"go tools", goToolchains, []string{"go.mod"}, false, []string{"go-tools", "gotestsum"}},
+		{"gotestsum", goToolchains, []string{"go.mod"}, true, []string{"gotestsum", "go-tools"}},
+		{"make", goToolchains, []string{"go.mod", "Makefile"}, false, []string{"go-tools", "gotestsum", "make"}},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			saved := lookPath
+			t.Cleanup(func() { lookPath = saved })
+			lookPath = func(file string) (string, error) {
+				if tt.gotestsum {
+					return file, nil
+				}
+				return "", errors.New("not found")
+			}
+			var names []string
+			for _, d := range DetectToolchains(tt.toolchains, dirWithFiles(t, "project", tt.files...)) {
+				names = append(names, d.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func Test_get_toolchain_detected_from_directory_contents(t *testing.T) {
+	lang := ALanguage(
+		WithDefaultToolchain("gradle"),
+		WithCompatibleToolchain("gradle"),
+		WithCompatibleToolchain("gradle-wrapper"),
+	)
+	lang.setBaseDir(dirWithFiles(t, "project", "build.gradle", "gradlew"))
+	tchn, err := lang.GetToolchain("")
+	assert.NoError(t, err)
+	assert.Equal(t, "gradle-wrapper", tchn.GetName())
+}
+
+func Test_get_default_toolchain_when_nothing_is_detected(t *testing.T) {
+	lang := ALanguage(
+		WithDefaultToolchain("gradle"),
+		WithCompatibleToolchain("gradle"),
+		WithCompatibleToolchain("gradle-wrapper"),
+	)
+	lang.setBaseDir(dirWithFiles(t, "project"))
+	tchn, err := lang.GetToolchain("")
+	assert.NoError(t, err)
+	assert.Equal(t, "gradle", tchn.GetName())
+}
@@ -99,6 +99,23 @@ func (ftf FileTreeFilter) isInFileTree(aPath string, baseDir string) bool {
 	return false
 }
 
+// relativeDir returns the directory containing aPath, relative to the filter directory
+// containing it, or relative to baseDir when the filter has no directory
+func (ftf FileTreeFilter) relativeDir(aPath string, baseDir string) string {
+	absDir, _ := filepath.Abs(filepath.Dir(aPath))
+	dirs := ftf.Directories
+	if len(dirs) == 0 {
+		dirs = []string{""}
+	}
+	for _, dir := range dirs {
+		filterAbsPath, _ := filepath.Abs(filepath.Join(baseDir, dir))
+		if rel, err := filepath.Rel(filterAbsPath, absDir); err == nil && helpers.IsSubPathOf(absDir, filterAbsPath) {
+			return rel
+		}
+	}
+	return absDir
+}
+
 func (ftf FileTreeFilter) matches(p string, baseDir string) bool {
 	if p == "" {
 		return false
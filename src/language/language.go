@@ -59,12 +59,14 @@ type (
 		IsSrcFile(aPath string) bool
 		IsTestFile(aPath string) bool
 		IsLanguageFile(filename string) bool
+		PackageDir(aPath string) string
 		AllSrcFiles() ([]string, error)
 		AllTestFiles() ([]string, error)
 		checkName() error
 		checkCompatibleToolchains() error
 		checkDefaultToolchain() error
 		setBaseDir(dir string)
+		clone() LangInterface
 		worksWithToolchain(toolchainName string) bool
 	}
 )
@@ -138,6 +140,16 @@ func (lang *Language) IsLanguageFile(aPath string) bool {
 	return lang.IsSrcFile(aPath) || lang.IsTestFile(aPath)
 }
 
+// PackageDir returns the directory of the provided source or test file, relative to the
+// source or test directory containing it. Source and test files of the same package, such as
+// "src/main/java/a/B.java" and "src/test/java/a/BTest.java" with Java, have the same package dir
+func (lang *Language) PackageDir(aPath string) string {
+	if lang.IsTestFile(aPath) {
+		return lang.GetTestFileFilter().relativeDir(aPath, lang.baseDir)
+	}
+	return lang.GetSrcFileFilter().relativeDir(aPath, lang.baseDir)
+}
+
 // DirsToWatch returns the list of directories that TCR engine needs to watch for this language
 func (lang *Language) DirsToWatch(baseDir string) (dirs []string) {
 	// First we concatenate the 2 lists
@@ -164,7 +176,8 @@ func (lang *Language) GetToolchains() Toolchains {
 // GetToolchain returns the toolchain instance for this language.
 // - If toolchainName is provided and is compatible with this language, it will be returned.
 // - If toolchainName is provided but is not compatible with this language, an error is returned.
-// - If toolchainName is not provided, the language's default toolchain is returned.
+// - If toolchainName is not provided, the most likely toolchain found in the language's base directory
+// is returned, or the language's default toolchain if none is found.
 func (lang *Language) GetToolchain(toolchainName string) (tchn toolchain.TchnInterface, err error) {
 	// We first retrieve the toolchain
 	if toolchainName != "" {
@@ -174,8 +187,8 @@ func (lang *Language) GetToolchain(toolchainName string) (tchn toolchain.TchnInt
 			return nil, err
 		}
 	} else {
-		// If no toolchain is specified, we use the default toolchain for this language
-		tchn, err = toolchain.Get(lang.GetToolchains().Default)
+		// If no toolchain is specified, we use the detected toolchain or the default toolchain for this language
+		tchn, err = toolchain.Get(lang.detectToolchain())
 		if err != nil {
 			return nil, err
 		}
@@ -189,6 +202,15 @@ func (lang *Language) GetToolchain(toolchainName string) (tchn toolchain.TchnInt
 	return tchn, nil
 }
 
+// detectToolchain returns the name of the most likely toolchain found in the language's
+// base directory, or the name of the language's default toolchain if none is found
+func (lang *Language) detectToolchain() string {
+	if best, found := DetectToolchains(lang.GetToolchains(), lang.baseDir).Best(); found {
+		return best.Name
+	}
+	return lang.GetToolchains().Default
+}
+
 func (lang *Language) verifyCompatibility(tchn toolchain.TchnInterface) (bool, error) {
 	if tchn == nil {
 		return false, errors.New("toolchain is unknown")
@@ -240,6 +262,12 @@ func (lang *Language) allMatchingTestFiles() ([]string, error) {
 	return lang.GetTestFileFilter().findAllMatchingFiles(lang.baseDir)
 }
 
+// clone returns a copy of the language that can be bound to another base directory
+func (lang *Language) clone() LangInterface {
+	c := *lang
+	return &c
+}
+
 func (lang *Language) setBaseDir(dir string) {
 	// Warning (for tests only): filepath.Abs() does not work with MemMapFs on Windows
 	lang.baseDir, _ = filepath.Abs(dir)
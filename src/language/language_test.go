@@ -98,6 +98,18 @@ func Test_a_file_not_matching_src_or_test_is_not_a_language_file(t *testing.T) {
 	assert.False(t, lang.IsLanguageFile(filepath.Join(dir, "some-file.ext")))
 }
 
+func Test_package_dir_of_source_and_test_files(t *testing.T) {
+	baseDir := t.TempDir()
+	lang := ALanguage(
+		WithBaseDir(baseDir),
+		WithSrcFiles(AFileTreeFilter(WithDirectory(filepath.Join("src", "main")), WithPattern(`.*\.ext$`))),
+		WithTestFiles(AFileTreeFilter(WithDirectory(filepath.Join("src", "test")), WithPattern(`.*Test\.ext$`))),
+	)
+	assert.Equal(t, filepath.Join("a", "b"), lang.PackageDir(filepath.Join(baseDir, "src", "main", "a", "b", "C.ext")))
+	assert.Equal(t, filepath.Join("a", "b"), lang.PackageDir(filepath.Join(baseDir, "src", "test", "a", "b", "CTest.ext")))
+	assert.Equal(t, ".", lang.PackageDir(filepath.Join(baseDir, "src", "main", "D.ext")))
+}
+
 func Test_get_toolchain_with_unregistered_toolchain(t *testing.T) {
 	lang := ALanguage(
 		WithDefaultToolchain("some-toolchain"),
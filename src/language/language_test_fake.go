@@ -132,6 +132,11 @@ func (fl *FakeLanguage) IsLanguageFile(filename string) bool {
 	return fl.lang.IsLanguageFile(filename)
 }
 
+// PackageDir uses real Language behaviour
+func (fl *FakeLanguage) PackageDir(aPath string) string {
+	return fl.lang.PackageDir(aPath)
+}
+
 func (fl *FakeLanguage) checkName() error {
 	return fl.lang.checkName()
 }
@@ -148,6 +153,11 @@ func (fl *FakeLanguage) setBaseDir(dir string) {
 	fl.lang.setBaseDir(dir)
 }
 
+func (fl *FakeLanguage) clone() LangInterface {
+	c := *fl
+	return &c
+}
+
 func (fl *FakeLanguage) worksWithToolchain(toolchainName string) bool {
 	return fl.lang.worksWithToolchain(toolchainName)
 }
@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package language
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/murex/tcr/toolchain"
+	"github.com/spf13/afero"
+)
+
+// moduleSeparator separates the fields of a module declaration
+const moduleSeparator = ":"
+
+// Module is a sub-directory of the base directory built and tested
+// with a language and a toolchain of its own
+type Module struct {
+	// Name is the module directory as declared, relative to the base directory
+	Name string
+	// Dir is the absolute path to the module directory
+	Dir       string
+	Language  LangInterface
+	Toolchain toolchain.TchnInterface
+}
+
+// GetModule returns the module matching the provided declaration, formatted as
+// dir[:language[:toolchain]], dir being relative to baseDir. When omitted, language
+// and toolchain are detected from the module directory contents. Each module gets
+// a language instance of its own, so that several modules can use the same language
+func GetModule(declaration string, baseDir string) (*Module, error) {
+	fields := strings.SplitN(declaration, moduleSeparator, 3)
+	for len(fields) < 3 {
+		fields = append(fields, "")
+	}
+	name := filepath.ToSlash(filepath.Clean(fields[0]))
+	if fields[0] == "" || name == "." || filepath.IsAbs(fields[0]) || strings.HasPrefix(name, "../") {
+		return nil, fmt.Errorf("invalid module declaration: %v (expecting dir[:language[:toolchain]]"+
+			" with dir being a sub-directory of the base directory)", declaration)
+	}
+	// Warning (for tests only): filepath.Abs() does not work with MemMapFs on Windows
+	dir, _ := filepath.Abs(filepath.Join(baseDir, filepath.FromSlash(name)))
+	if exists, _ := afero.DirExists(appFS, dir); !exists {
+		return nil, fmt.Errorf("module directory not found: %v", dir)
+	}
+
+	lang, err := getModuleLanguage(fields[1], dir)
+	if err != nil {
+		return nil, fmt.Errorf("module %v: %w", name, err)
+	}
+	tchn, err := lang.GetToolchain(fields[2])
+	if err != nil {
+		return nil, fmt.Errorf("module %v: %w", name, err)
+	}
+	return &Module{Name: name, Dir: dir, Language: lang, Toolchain: tchn}, nil
+}
+
+// getModuleLanguage returns an instance of the language with the provided name,
+// or of the language detected in dir when no name is provided
+func getModuleLanguage(name string, dir string) (LangInterface, error) {
+	var lang LangInterface
+	var err error
+	if name != "" {
+		lang, err = getRegisteredLanguage(name)
+	} else {
+		lang, err = detectLanguage(dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	lang = lang.clone()
+	lang.setBaseDir(dir)
+	return lang, nil
+}
+
+// Contains indicates if the provided path is located in the module directory
+func (m *Module) Contains(path string) bool {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(m.Dir, abs)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// String returns the module name along with its language and toolchain names
+func (m *Module) String() string {
+	return fmt.Sprintf("%v (%v/%v)", m.Name, m.Language.GetName(), m.Toolchain.GetName())
+}
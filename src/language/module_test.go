@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package language
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_get_module_with_explicit_language_and_toolchain(t *testing.T) {
+	baseDir := dirWithFiles(t, "project", "backend/")
+	m, err := GetModule("backend:java:maven", baseDir)
+	assert.NoError(t, err)
+	assert.Equal(t, "backend", m.Name)
+	assert.Equal(t, filepath.Join(baseDir, "backend"), m.Dir)
+	assert.Equal(t, "java", m.Language.GetName())
+	assert.Equal(t, "maven", m.Toolchain.GetName())
+	assert.Equal(t, "backend (java/maven)", m.String())
+}
+
+func Test_get_module_with_language_and_toolchain_detection(t *testing.T) {
+	baseDir := dirWithFiles(t, "project", "frontend/package.json", "frontend/tsconfig.json", "frontend/yarn.lock")
+	m, err := GetModule("frontend", baseDir)
+	assert.NoError(t, err)
+	assert.Equal(t, "typescript", m.Language.GetName())
+	assert.Equal(t, "yarn", m.Toolchain.GetName())
+}
+
+func Test_get_module_with_invalid_declaration(t *testing.T) {
+	baseDir := dirWithFiles(t, "project", "backend/")
+	testFlags := []struct {
+		desc        string
+		declaration string
+	}{
+		{"empty declaration", ""},
+		{"no directory", ":java"},
+		{"base directory", ".:java"},
+		{"directory outside base directory", "../backend:java"},
+		{"directory not found", "frontend:java"},
+		{"unknown language", "backend:unknown-language"},
+		{"incompatible toolchain", "backend:java:cargo"},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			m, err := GetModule(tt.declaration, baseDir)
+			assert.Error(t, err)
+			assert.Nil(t, m)
+		})
+	}
+}
+
+func Test_modules_with_the_same_language_have_their_own_base_directory(t *testing.T) {
+	baseDir := dirWithFiles(t, "project", "app1/src/main/java/A.java", "app2/src/main/java/B.java")
+	m1, err1 := GetModule("app1:java", baseDir)
+	m2, err2 := GetModule("app2:java", baseDir)
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+
+	srcFiles1, _ := m1.Language.AllSrcFiles()
+	srcFiles2, _ := m2.Language.AllSrcFiles()
+	assert.Equal(t, []string{filepath.Join(baseDir, "app1", "src", "main", "java", "A.java")}, srcFiles1)
+	assert.Equal(t, []string{filepath.Join(baseDir, "app2", "src", "main", "java", "B.java")}, srcFiles2)
+}
+
+func Test_module_contains_files_located_in_its_directory(t *testing.T) {
+	baseDir := dirWithFiles(t, "project", "backend/", "backend-tools/")
+	m, _ := GetModule("backend:java", baseDir)
+	testFlags := []struct {
+		desc     string
+		path     string
+		expected bool
+	}{
+		{"file in module directory", filepath.Join(baseDir, "backend", "pom.xml"), true},
+		{"file in module sub-directory", filepath.Join(baseDir, "backend", "src", "A.java"), true},
+		{"module directory itself", filepath.Join(baseDir, "backend"), true},
+		{"file in base directory", filepath.Join(baseDir, "README.md"), false},
+		{"file in sibling directory with same prefix", filepath.Join(baseDir, "backend-tools", "A.java"), false},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, m.Contains(tt.path))
+		})
+	}
+}
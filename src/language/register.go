@@ -109,13 +109,13 @@ func addBuiltIn(lang LangInterface) error {
 }
 
 // GetLanguage returns the language to be used in current session. If no value is provided
-// for language (e.g. empty string), we try to detect the language based on the directory name.
+// for language (e.g. empty string), we try to detect the language based on the directory contents.
 // Both name and baseDir are case-insensitive
 func GetLanguage(name string, baseDir string) (lang LangInterface, err error) {
 	if name != "" {
 		lang, err = getRegisteredLanguage(name)
 	} else {
-		lang, err = detectLanguageFromDirName(baseDir)
+		lang, err = detectLanguage(baseDir)
 	}
 	if lang != nil {
 		lang.setBaseDir(baseDir)
@@ -131,6 +131,15 @@ func getRegisteredLanguage(name string) (LangInterface, error) {
 	return nil, errors.New(fmt.Sprint("language not supported: ", name))
 }
 
+// detectLanguage returns the most likely language used in the provided directory.
+// It falls back on the directory name when nothing is detected
+func detectLanguage(baseDir string) (LangInterface, error) {
+	if best, found := DetectLanguages(baseDir).Best(); found {
+		return getRegisteredLanguage(best.Name)
+	}
+	return detectLanguageFromDirName(baseDir)
+}
+
 // detectLanguageFromDirName is used to identify the language used in the provided directory. The current implementation
 // simply looks at the name of the directory and checks if it matches with one of the supported languages
 func detectLanguageFromDirName(baseDir string) (LangInterface, error) {
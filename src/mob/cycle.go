@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package mob
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/vcs"
+)
+
+// FileDiff contains the changes made to a file during a TCR cycle
+type FileDiff struct {
+	Path    string `json:"path"`
+	Added   int    `json:"added"`
+	Removed int    `json:"removed"`
+}
+
+// Cycle contains the outcome of a TCR cycle run by the driver,
+// as broadcast to the mob host's guests
+type Cycle struct {
+	Timestamp string     `json:"timestamp"`
+	Driver    string     `json:"driver"`
+	Status    string     `json:"status"`
+	Phase     string     `json:"phase,omitempty"`
+	SrcLines  int        `json:"srcLines"`
+	TestLines int        `json:"testLines"`
+	TestsRun  int        `json:"testsRun"`
+	Passed    int        `json:"passed"`
+	Failed    int        `json:"failed"`
+	Diffs     []FileDiff `json:"diffs"`
+}
+
+// NewCycle creates a new Cycle instance from a TCR event and the related file diffs
+func NewCycle(driver string, event events.TCREvent, diffs vcs.FileDiffs) Cycle {
+	c := Cycle{
+		Timestamp: time.Now().Format(time.RFC3339),
+		Driver:    driver,
+		Status:    string(event.Status),
+		Phase:     string(event.Phase),
+		SrcLines:  event.Changes.Src,
+		TestLines: event.Changes.Test,
+		TestsRun:  event.Tests.Run,
+		Passed:    event.Tests.Passed,
+		Failed:    event.Tests.Failed,
+		Diffs:     []FileDiff{},
+	}
+	for _, diff := range diffs {
+		c.Diffs = append(c.Diffs, FileDiff{Path: diff.Path, Added: diff.AddedLines, Removed: diff.RemovedLines})
+	}
+	return c
+}
+
+// Summary returns a one-line description of the cycle
+func (c Cycle) Summary() string {
+	who := c.Driver
+	if who == "" {
+		who = "Driver"
+	}
+	outcome := "tests passed"
+	if c.Status != string(events.StatusPass) {
+		outcome = "tests failed"
+	}
+	return fmt.Sprintf("%s: %s (%d/%d tests passed, %d src and %d test line(s) changed in %d file(s))",
+		who, outcome, c.Passed, c.TestsRun, c.SrcLines, c.TestLines, len(c.Diffs))
+}
+
+var (
+	listenersMutex sync.RWMutex
+	listeners      = map[int]func(Cycle){}
+	nextListenerID int
+)
+
+// Subscribe registers a listener called every time a cycle is published.
+// Returns the function to be called for unsubscribing
+func Subscribe(listener func(Cycle)) (unsubscribe func()) {
+	listenersMutex.Lock()
+	defer listenersMutex.Unlock()
+	id := nextListenerID
+	nextListenerID++
+	listeners[id] = listener
+	return func() {
+		listenersMutex.Lock()
+		defer listenersMutex.Unlock()
+		delete(listeners, id)
+	}
+}
+
+// Publish sends the provided cycle to all subscribed listeners
+func Publish(c Cycle) {
+	listenersMutex.RLock()
+	defer listenersMutex.RUnlock()
+	for _, listener := range listeners {
+		listener(c)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package mob
+
+import (
+	"testing"
+
+	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/vcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_new_cycle(t *testing.T) {
+	event := events.TCREvent{
+		Status:  events.StatusFail,
+		Changes: events.NewChangedLines(3, 2),
+		Tests:   events.NewTestStats(5, 4, 1, 0, 0, 0),
+		Phase:   events.PhaseRed,
+	}
+	diffs := vcs.FileDiffs{vcs.NewFileDiff("src.go", 3, 0), vcs.NewFileDiff("src_test.go", 1, 1)}
+
+	c := NewCycle("alice", event, diffs)
+
+	assert.Equal(t, "alice", c.Driver)
+	assert.Equal(t, "fail", c.Status)
+	assert.Equal(t, "red", c.Phase)
+	assert.Equal(t, 3, c.SrcLines)
+	assert.Equal(t, 2, c.TestLines)
+	assert.Equal(t, 5, c.TestsRun)
+	assert.Equal(t, 4, c.Passed)
+	assert.Equal(t, 1, c.Failed)
+	assert.Equal(t, []FileDiff{
+		{Path: "src.go", Added: 3, Removed: 0},
+		{Path: "src_test.go", Added: 1, Removed: 1},
+	}, c.Diffs)
+	assert.NotEmpty(t, c.Timestamp)
+}
+
+func Test_cycle_summary(t *testing.T) {
+	tests := []struct {
+		desc     string
+		cycle    Cycle
+		expected string
+	}{
+		{
+			desc:     "passing cycle with driver",
+			cycle:    Cycle{Driver: "alice", Status: "pass", TestsRun: 3, Passed: 3, SrcLines: 2, Diffs: []FileDiff{{}}},
+			expected: "alice: tests passed (3/3 tests passed, 2 src and 0 test line(s) changed in 1 file(s))",
+		},
+		{
+			desc:     "failing cycle without driver",
+			cycle:    Cycle{Status: "fail", TestsRun: 3, Passed: 2, TestLines: 4},
+			expected: "Driver: tests failed (2/3 tests passed, 0 src and 4 test line(s) changed in 0 file(s))",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.cycle.Summary())
+		})
+	}
+}
+
+func Test_published_cycles_are_sent_to_subscribers_until_they_unsubscribe(t *testing.T) {
+	var received []string
+	unsubscribe := Subscribe(func(c Cycle) { received = append(received, c.Driver) })
+
+	Publish(Cycle{Driver: "alice"})
+	unsubscribe()
+	Publish(Cycle{Driver: "bob"})
+
+	assert.Equal(t, []string{"alice"}, received)
+}
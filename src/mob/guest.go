@@ -0,0 +1,297 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package mob
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// ErrMissingToken is returned when trying to join a mob host without token
+var ErrMissingToken = errors.New("a mob token is required to join a mob host")
+
+// ErrUnauthorized is returned when the mob host rejects the guest's token
+var ErrUnauthorized = errors.New("mob host rejected the provided token")
+
+// ErrMissingFingerprint is returned when trying to join a mob host over TLS
+// without its certificate fingerprint
+var ErrMissingFingerprint = errors.New("the mob host certificate fingerprint is required to join a mob host over TLS")
+
+// ErrCertificateMismatch is returned when the mob host certificate does not match
+// the provided fingerprint
+var ErrCertificateMismatch = errors.New("mob host certificate does not match the provided fingerprint")
+
+// Message is a message sent by the mob host to its guests
+type Message struct {
+	Type      string `json:"type"`
+	Severity  string `json:"severity"`
+	Text      string `json:"text"`
+	Emphasis  bool   `json:"emphasis"`
+	Timestamp string `json:"timestamp"`
+	Cycle     *Cycle `json:"cycle,omitempty"`
+}
+
+// Guest follows the session of a mob host from another TCR instance. It prints
+// the messages sent by the host and can request role changes on the host
+type Guest struct {
+	address string
+	token   string
+	out     io.Writer
+	client  *http.Client
+	dialer  *websocket.Dialer
+	secure  bool
+	// outMutex prevents host messages and guest feedback from interleaving
+	outMutex sync.Mutex
+}
+
+// NewGuest creates a new guest of the mob host listening at the provided address (host:port)
+func NewGuest(address string, token string, out io.Writer) (*Guest, error) {
+	if token == "" {
+		return nil, ErrMissingToken
+	}
+	return &Guest{
+		address: address,
+		token:   token,
+		out:     out,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		dialer:  websocket.DefaultDialer,
+	}, nil
+}
+
+// CertificateFingerprint returns the SHA-256 fingerprint of the provided DER-encoded
+// certificate, formatted as colon-separated pairs of uppercase hexadecimal digits
+func CertificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	pairs := make([]string, len(sum))
+	for i, b := range sum {
+		pairs[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(pairs, ":")
+}
+
+// parseFingerprint converts a SHA-256 fingerprint into bytes. Separators and case are ignored
+func parseFingerprint(fingerprint string) ([]byte, error) {
+	digits := strings.NewReplacer(":", "", " ", "").Replace(fingerprint)
+	sum, err := hex.DecodeString(digits)
+	if err != nil || len(sum) != sha256.Size {
+		return nil, fmt.Errorf("invalid SHA-256 certificate fingerprint: \"%s\"", fingerprint)
+	}
+	return sum, nil
+}
+
+// UseTLS makes the guest connect to a mob host serving requests over TLS.
+// Mob hosts use self-signed certificates, which no certificate authority can vouch for.
+// Instead, the guest pins the mob host certificate: it only accepts the certificate
+// matching the provided SHA-256 fingerprint, as displayed by the mob host at startup
+func (g *Guest) UseTLS(fingerprint string) error {
+	if fingerprint == "" {
+		return ErrMissingFingerprint
+	}
+	expected, err := parseFingerprint(fingerprint)
+	if err != nil {
+		return err
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Chain verification is replaced with the certificate pinning done in VerifyPeerCertificate
+		InsecureSkipVerify: true, //nolint:gosec // the mob host certificate is pinned
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return ErrCertificateMismatch
+			}
+			sum := sha256.Sum256(rawCerts[0])
+			if !bytes.Equal(sum[:], expected) {
+				return ErrCertificateMismatch
+			}
+			return nil
+		},
+	}
+	g.secure = true
+	g.client.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+	dialer := *websocket.DefaultDialer
+	dialer.TLSClientConfig = tlsConfig
+	g.dialer = &dialer
+	return nil
+}
+
+func (g *Guest) url(scheme string, path string) string {
+	if g.secure {
+		scheme += "s"
+	}
+	u := url.URL{Scheme: scheme, Host: g.address, Path: path}
+	return u.String()
+}
+
+func (g *Guest) authHeader() http.Header {
+	return http.Header{"Authorization": {"Bearer " + g.token}}
+}
+
+// Follow connects to the mob host and prints the messages it sends until either
+// the provided context is done or the host closes the connection
+func (g *Guest) Follow(ctx context.Context) error {
+	conn, resp, err := g.dialer.DialContext(ctx, g.url("ws", "/mob/ws"), g.authHeader())
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return ErrUnauthorized
+		}
+		return fmt.Errorf("cannot connect to mob host %s: %w", g.address, err)
+	}
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+	defer func() { _ = conn.Close() }()
+
+	g.printf("Following mob host %s", g.address)
+	for {
+		var msg Message
+		if err := conn.ReadJSON(&msg); err != nil {
+			if ctx.Err() != nil || websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return nil
+			}
+			return fmt.Errorf("lost connection with mob host %s: %w", g.address, err)
+		}
+		g.print(msg)
+	}
+}
+
+func (g *Guest) printf(format string, a ...any) {
+	g.outMutex.Lock()
+	defer g.outMutex.Unlock()
+	_, _ = fmt.Fprintf(g.out, format+"\n", a...)
+}
+
+func (g *Guest) print(msg Message) {
+	switch msg.Type {
+	case "cycle":
+		g.printf("[cycle] %s", msg.Text)
+		if msg.Cycle != nil {
+			for _, diff := range msg.Cycle.Diffs {
+				g.printf("        %s (+%d -%d)", diff.Path, diff.Added, diff.Removed)
+			}
+		}
+	case "warning", "error", "role", "timer":
+		g.printf("[%s] %s", msg.Type, msg.Text)
+	default:
+		g.printf("%s", msg.Text)
+	}
+}
+
+// RequestRole requests the mob host to perform the provided action (start or stop)
+// on the provided role (driver or navigator)
+func (g *Guest) RequestRole(ctx context.Context, roleName string, action string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.url("http", "/api/roles/"+roleName+"/"+action), nil)
+	if err != nil {
+		return err
+	}
+	req.Header = g.authHeader()
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("cannot reach mob host %s: %w", g.address, err)
+	}
+	_ = resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		return nil
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	default:
+		return fmt.Errorf("mob host refused %s %s request: %s", roleName, action, resp.Status)
+	}
+}
+
+const guestHelp = "Enter d to ask the host to drive, n to ask the host to navigate, q to quit"
+
+// Run follows the mob host while reading guest commands from the provided input,
+// until the guest quits, the input is closed or the host closes the connection
+func (g *Guest) Run(ctx context.Context, in io.Reader) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	followErr := make(chan error, 1)
+	go func() {
+		followErr <- g.Follow(ctx)
+		cancel()
+	}()
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(in)
+		for scanner.Scan() {
+			select {
+			case lines <- strings.TrimSpace(scanner.Text()):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	g.printf(guestHelp)
+	for {
+		select {
+		case <-ctx.Done():
+			return <-followErr
+		case line, ok := <-lines:
+			if !ok || strings.EqualFold(line, "q") {
+				cancel()
+				return <-followErr
+			}
+			g.runCommand(ctx, line)
+		}
+	}
+}
+
+func (g *Guest) runCommand(ctx context.Context, command string) {
+	var err error
+	switch strings.ToLower(command) {
+	case "":
+		return
+	case "d":
+		err = g.RequestRole(ctx, "driver", "start")
+	case "n":
+		err = g.RequestRole(ctx, "navigator", "start")
+	default:
+		g.printf(guestHelp)
+		return
+	}
+	if err != nil {
+		g.printf("[error] %s", err)
+	}
+}
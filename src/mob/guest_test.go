@@ -0,0 +1,262 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package mob
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+const testToken = "s3cr3t"
+
+// fakeMobHost provides a minimal mob host for testing guests
+type fakeMobHost struct {
+	server   *httptest.Server
+	messages []Message
+	keepOpen bool
+	mutex    sync.Mutex
+	requests []string
+}
+
+func newFakeMobHost(keepOpen bool, messages ...Message) *fakeMobHost {
+	return newUnstartedFakeMobHost(keepOpen, messages...).start(false)
+}
+
+func newUnstartedFakeMobHost(keepOpen bool, messages ...Message) *fakeMobHost {
+	h := &fakeMobHost{messages: messages, keepOpen: keepOpen}
+	upgrader := websocket.Upgrader{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mob/ws", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		for _, msg := range h.messages {
+			_ = conn.WriteJSON(msg)
+		}
+		for h.keepOpen {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+		_ = conn.WriteMessage(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		_ = conn.Close()
+	})
+	mux.HandleFunc("/api/roles/{name}/{action}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.PathValue("name") != "driver" && r.PathValue("name") != "navigator" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		h.mutex.Lock()
+		h.requests = append(h.requests, r.PathValue("name")+":"+r.PathValue("action"))
+		h.mutex.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	})
+	h.server = httptest.NewUnstartedServer(mux)
+	return h
+}
+
+func (h *fakeMobHost) start(secure bool) *fakeMobHost {
+	if secure {
+		h.server.StartTLS()
+	} else {
+		h.server.Start()
+	}
+	return h
+}
+
+func (h *fakeMobHost) address() string {
+	u, _ := url.Parse(h.server.URL)
+	return u.Host
+}
+
+func (h *fakeMobHost) getRequests() []string {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.requests
+}
+
+func Test_new_guest_requires_a_token(t *testing.T) {
+	_, err := NewGuest("127.0.0.1:8483", "", nil)
+	assert.ErrorIs(t, err, ErrMissingToken)
+}
+
+func Test_guest_prints_messages_sent_by_mob_host(t *testing.T) {
+	host := newFakeMobHost(false,
+		Message{Type: "info", Text: "Running Tests"},
+		Message{Type: "timer", Text: "countdown:300:60:240"},
+		Message{Type: "cycle", Text: "alice: tests passed", Cycle: &Cycle{
+			Diffs: []FileDiff{{Path: "src.go", Added: 2, Removed: 1}},
+		}},
+	)
+	defer host.server.Close()
+	var out bytes.Buffer
+	guest, _ := NewGuest(host.address(), testToken, &out)
+
+	err := guest.Follow(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"Following mob host " + host.address(),
+		"Running Tests",
+		"[timer] countdown:300:60:240",
+		"[cycle] alice: tests passed",
+		"        src.go (+2 -1)",
+		"",
+	}, "\n"), out.String())
+}
+
+func (h *fakeMobHost) fingerprint() string {
+	return CertificateFingerprint(h.server.Certificate().Raw)
+}
+
+func Test_guest_using_tls(t *testing.T) {
+	host := newUnstartedFakeMobHost(false, Message{Type: "info", Text: "Running Tests"}).start(true)
+	defer host.server.Close()
+	var out bytes.Buffer
+	guest, _ := NewGuest(host.address(), testToken, &out)
+	assert.NoError(t, guest.UseTLS(host.fingerprint()))
+
+	assert.NoError(t, guest.Follow(context.Background()))
+	assert.Contains(t, out.String(), "Running Tests")
+	assert.NoError(t, guest.RequestRole(context.Background(), "driver", "start"))
+	assert.Equal(t, []string{"driver:start"}, host.getRequests())
+}
+
+func Test_guest_using_tls_rejects_mob_host_with_another_certificate(t *testing.T) {
+	host := newUnstartedFakeMobHost(false, Message{Type: "info", Text: "Running Tests"}).start(true)
+	defer host.server.Close()
+	var out bytes.Buffer
+	guest, _ := NewGuest(host.address(), testToken, &out)
+	assert.NoError(t, guest.UseTLS(CertificateFingerprint([]byte("another certificate"))))
+
+	assert.ErrorIs(t, guest.Follow(context.Background()), ErrCertificateMismatch)
+	assert.ErrorIs(t, guest.RequestRole(context.Background(), "driver", "start"), ErrCertificateMismatch)
+	assert.Empty(t, host.getRequests())
+	assert.NotContains(t, out.String(), "Running Tests")
+}
+
+func Test_guest_using_tls_requires_a_valid_fingerprint(t *testing.T) {
+	tests := []struct {
+		desc        string
+		fingerprint string
+		expectError bool
+	}{
+		{"missing fingerprint", "", true},
+		{"not hexadecimal", "not a fingerprint", true},
+		{"too short", "AB:CD:EF", true},
+		{"colon-separated uppercase", CertificateFingerprint([]byte("cert")), false},
+		{"lowercase without separators", strings.ToLower(strings.ReplaceAll(CertificateFingerprint([]byte("cert")), ":", "")), false},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			guest, _ := NewGuest("127.0.0.1:8483", testToken, &bytes.Buffer{})
+			err := guest.UseTLS(test.fingerprint)
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+	guest, _ := NewGuest("127.0.0.1:8483", testToken, &bytes.Buffer{})
+	assert.ErrorIs(t, guest.UseTLS(""), ErrMissingFingerprint)
+}
+
+func Test_certificate_fingerprint_format(t *testing.T) {
+	fingerprint := CertificateFingerprint([]byte("cert"))
+	assert.Len(t, strings.Split(fingerprint, ":"), 32)
+	assert.Equal(t, strings.ToUpper(fingerprint), fingerprint)
+}
+
+func Test_guest_without_tls_cannot_reach_secure_mob_host(t *testing.T) {
+	host := newUnstartedFakeMobHost(false).start(true)
+	defer host.server.Close()
+	guest, _ := NewGuest(host.address(), testToken, &bytes.Buffer{})
+
+	assert.Error(t, guest.Follow(context.Background()))
+}
+
+func Test_guest_with_invalid_token_is_rejected(t *testing.T) {
+	host := newFakeMobHost(false)
+	defer host.server.Close()
+	guest, _ := NewGuest(host.address(), "wrong-token", &bytes.Buffer{})
+
+	assert.ErrorIs(t, guest.Follow(context.Background()), ErrUnauthorized)
+	assert.ErrorIs(t, guest.RequestRole(context.Background(), "driver", "start"), ErrUnauthorized)
+}
+
+func Test_guest_cannot_follow_unreachable_mob_host(t *testing.T) {
+	host := newFakeMobHost(false)
+	address := host.address()
+	host.server.Close()
+	guest, _ := NewGuest(address, testToken, &bytes.Buffer{})
+
+	assert.Error(t, guest.Follow(context.Background()))
+	assert.Error(t, guest.RequestRole(context.Background(), "driver", "start"))
+}
+
+func Test_guest_role_requests(t *testing.T) {
+	host := newFakeMobHost(false)
+	defer host.server.Close()
+	guest, _ := NewGuest(host.address(), testToken, &bytes.Buffer{})
+
+	assert.NoError(t, guest.RequestRole(context.Background(), "driver", "start"))
+	assert.NoError(t, guest.RequestRole(context.Background(), "navigator", "start"))
+	assert.Error(t, guest.RequestRole(context.Background(), "pilot", "start"))
+	assert.Equal(t, []string{"driver:start", "navigator:start"}, host.getRequests())
+}
+
+func Test_guest_run_sends_role_requests_until_guest_quits(t *testing.T) {
+	host := newFakeMobHost(true)
+	defer host.server.Close()
+	var out bytes.Buffer
+	guest, _ := NewGuest(host.address(), testToken, &out)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := guest.Run(ctx, strings.NewReader("d\nn\nx\nq\nd\n"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"driver:start", "navigator:start"}, host.getRequests())
+	assert.Equal(t, 2, strings.Count(out.String(), guestHelp))
+}
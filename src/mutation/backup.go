@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package mutation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+const backupDirName = "mutation-backup"
+
+var backupDirPath string
+
+// backup contains the original contents of a source file being mutated
+type backup struct {
+	Path     string      `json:"path"`
+	Mode     os.FileMode `json:"mode"`
+	Contents []byte      `json:"contents"`
+}
+
+// InitConfig initializes the location where the original contents of mutated
+// files are backed up. Backups are disabled when no configuration directory is provided
+func InitConfig(configDirPath string) {
+	if configDirPath == "" {
+		backupDirPath = ""
+		return
+	}
+	backupDirPath = filepath.Join(configDirPath, backupDirName)
+}
+
+// Backup saves the original contents of the file about to be mutated, so that
+// RestoreBackups can restore it if TCR is interrupted before doing it itself
+func Backup(path string, mode os.FileMode, contents []byte) error {
+	if backupDirPath == "" {
+		return nil
+	}
+	data, err := json.Marshal(backup{Path: path, Mode: mode, Contents: contents})
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(backupDirPath, 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(backupFilePath(path), data, 0600)
+}
+
+// RemoveBackup removes the backup of the provided file once its original contents are restored
+func RemoveBackup(path string) error {
+	if backupDirPath == "" {
+		return nil
+	}
+	err := os.Remove(backupFilePath(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// RestoreBackups restores the original contents of the files left mutated by an
+// interrupted mutation run, and returns their paths
+func RestoreBackups() (restored []string, err error) {
+	if backupDirPath == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(backupDirPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	for _, entry := range entries {
+		path, restoreErr := restoreBackup(filepath.Join(backupDirPath, entry.Name()))
+		if restoreErr != nil {
+			errs = append(errs, restoreErr)
+			continue
+		}
+		restored = append(restored, path)
+	}
+	slices.Sort(restored)
+	return restored, errors.Join(errs...)
+}
+
+func restoreBackup(backupFile string) (string, error) {
+	data, err := os.ReadFile(backupFile) //nolint:gosec
+	if err != nil {
+		return "", err
+	}
+	var b backup
+	if err = json.Unmarshal(data, &b); err != nil {
+		return "", err
+	}
+	if err = os.WriteFile(b.Path, b.Contents, b.Mode); err != nil {
+		return "", err
+	}
+	return b.Path, os.Remove(backupFile)
+}
+
+// backupFilePath returns the path of the backup file for the provided source file
+func backupFilePath(path string) string {
+	sum := sha256.Sum256([]byte(path))
+	return filepath.Join(backupDirPath, hex.EncodeToString(sum[:])+".json")
+}
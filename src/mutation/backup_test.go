@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package mutation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func initBackupDir(t *testing.T, configDir string) {
+	t.Helper()
+	InitConfig(configDir)
+	t.Cleanup(func() { InitConfig("") })
+}
+
+func Test_backups_are_restored(t *testing.T) {
+	initBackupDir(t, t.TempDir())
+	dir := t.TempDir()
+	path1 := filepath.Join(dir, "a.go")
+	path2 := filepath.Join(dir, "b.go")
+	for _, path := range []string{path1, path2} {
+		assert.NoError(t, Backup(path, 0600, []byte("original "+filepath.Base(path))))
+		assert.NoError(t, os.WriteFile(path, []byte("mutant"), 0600))
+	}
+
+	restored, err := RestoreBackups()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{path1, path2}, restored)
+	for _, path := range restored {
+		contents, _ := os.ReadFile(path)
+		assert.Equal(t, "original "+filepath.Base(path), string(contents))
+	}
+
+	restored, err = RestoreBackups()
+	assert.NoError(t, err)
+	assert.Empty(t, restored)
+}
+
+func Test_removed_backups_are_not_restored(t *testing.T) {
+	initBackupDir(t, t.TempDir())
+	path := filepath.Join(t.TempDir(), "a.go")
+	assert.NoError(t, Backup(path, 0600, []byte("original")))
+	assert.NoError(t, RemoveBackup(path))
+	assert.NoError(t, os.WriteFile(path, []byte("edited"), 0600))
+
+	restored, err := RestoreBackups()
+	assert.NoError(t, err)
+	assert.Empty(t, restored)
+	contents, _ := os.ReadFile(path)
+	assert.Equal(t, "edited", string(contents))
+}
+
+func Test_backups_are_disabled_without_configuration_directory(t *testing.T) {
+	initBackupDir(t, "")
+	assert.NoError(t, Backup("some-file", 0600, []byte("original")))
+	assert.NoError(t, RemoveBackup("some-file"))
+	restored, err := RestoreBackups()
+	assert.NoError(t, err)
+	assert.Empty(t, restored)
+}
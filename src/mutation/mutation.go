@@ -0,0 +1,191 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package mutation
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Mutant is a single change applied to a source file, where an operator
+// or a boolean literal is replaced by another one
+type Mutant struct {
+	Path        string
+	Line        int
+	Offset      int
+	Original    string
+	Replacement string
+}
+
+// String returns a human-readable description of the mutant
+func (m Mutant) String() string {
+	return fmt.Sprintf("%s:%d: %s replaced with %s", m.Path, m.Line, m.Original, m.Replacement)
+}
+
+// Apply returns a copy of the provided source contents with the mutant applied
+func (m Mutant) Apply(src []byte) []byte {
+	mutated := make([]byte, 0, len(src)-len(m.Original)+len(m.Replacement))
+	mutated = append(mutated, src[:m.Offset]...)
+	mutated = append(mutated, m.Replacement...)
+	return append(mutated, src[m.Offset+len(m.Original):]...)
+}
+
+// operators contains the mutation applied to each supported binary operator
+var operators = map[string]string{
+	"==": "!=",
+	"!=": "==",
+	"<":  ">=",
+	"<=": ">",
+	">":  "<=",
+	">=": "<",
+	"&&": "||",
+	"||": "&&",
+	"+":  "-",
+	"-":  "+",
+	"*":  "/",
+	"/":  "*",
+}
+
+// literals contains the mutation applied to each supported literal
+var literals = map[string]string{
+	"true":  "false",
+	"false": "true",
+}
+
+type syntax struct {
+	rawStrings bool
+	textBlocks bool
+}
+
+var syntaxes = map[string]syntax{
+	".go":   {rawStrings: true},
+	".java": {textBlocks: true},
+}
+
+// Supports indicates if mutants can be generated for the provided file
+func Supports(path string) bool {
+	_, found := syntaxes[strings.ToLower(filepath.Ext(path))]
+	return found
+}
+
+// Generate returns the mutants that can be applied to the provided source contents.
+// Comments, string and character literals are left untouched. Binary operators are
+// only mutated when surrounded by whitespaces, so that unary operators and generic
+// type parameters are never mutated
+func Generate(path string, src []byte) (mutants []Mutant) {
+	syn, found := syntaxes[strings.ToLower(filepath.Ext(path))]
+	if !found {
+		return nil
+	}
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case hasPrefixAt(src, i, "//"):
+			i = skipUntil(src, i+2, "\n", &line)
+		case hasPrefixAt(src, i, "/*"):
+			i = skipUntil(src, i+2, "*/", &line)
+		case syn.textBlocks && hasPrefixAt(src, i, `"""`):
+			i = skipUntil(src, i+3, `"""`, &line)
+		case syn.rawStrings && c == '`':
+			i = skipUntil(src, i+1, "`", &line)
+		case c == '"' || c == '\'':
+			i = skipQuoted(src, i, &line)
+		case isWordChar(c):
+			start := i
+			for i < len(src) && isWordChar(src[i]) {
+				i++
+			}
+			word := string(src[start:i])
+			if replacement, ok := literals[word]; ok {
+				mutants = append(mutants, Mutant{path, line, start, word, replacement})
+			}
+		case isOperatorChar(c):
+			start := i
+			for i < len(src) && isOperatorChar(src[i]) && !hasPrefixAt(src, i, "//") && !hasPrefixAt(src, i, "/*") {
+				i++
+			}
+			op := string(src[start:i])
+			if replacement, ok := operators[op]; ok && isSpaceAt(src, start-1) && isSpaceAt(src, i) {
+				mutants = append(mutants, Mutant{path, line, start, op, replacement})
+			}
+		default:
+			i++
+		}
+	}
+	return mutants
+}
+
+func hasPrefixAt(src []byte, i int, prefix string) bool {
+	return strings.HasPrefix(string(src[i:min(len(src), i+len(prefix))]), prefix)
+}
+
+// skipUntil returns the position right after the next occurrence of end, or the end of src
+func skipUntil(src []byte, i int, end string, line *int) int {
+	for ; i < len(src); i++ {
+		if hasPrefixAt(src, i, end) {
+			if end == "\n" {
+				return i
+			}
+			return i + len(end)
+		}
+		if src[i] == '\n' {
+			*line++
+		}
+	}
+	return i
+}
+
+// skipQuoted returns the position right after the string or character literal starting at i
+func skipQuoted(src []byte, i int, line *int) int {
+	quote := src[i]
+	for i++; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		case '\n':
+			// Unterminated literal: resume scanning on next line
+			*line++
+			return i + 1
+		}
+	}
+	return i
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c == '.' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func isOperatorChar(c byte) bool {
+	return strings.IndexByte("=!<>&|+-*/%^:~?", c) >= 0
+}
+
+func isSpaceAt(src []byte, i int) bool {
+	return i >= 0 && i < len(src) && (src[i] == ' ' || src[i] == '\t' || src[i] == '\n' || src[i] == '\r')
+}
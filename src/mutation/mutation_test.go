@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package mutation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_supported_files(t *testing.T) {
+	testFlags := []struct {
+		path     string
+		expected bool
+	}{
+		{"main.go", true},
+		{"src/Main.java", true},
+		{"src/Main.JAVA", true},
+		{"main.cpp", false},
+		{"README.md", false},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Supports(tt.path))
+		})
+	}
+}
+
+func Test_generate_mutants(t *testing.T) {
+	testFlags := []struct {
+		desc     string
+		path     string
+		src      string
+		expected []Mutant
+	}{
+		{"unsupported file", "main.cpp", "a == b", nil},
+		{"no operator", "main.go", "x := f(y)", nil},
+		{"equality", "main.go", "a == b", []Mutant{{"main.go", 1, 2, "==", "!="}}},
+		{"inequality", "main.go", "a != b", []Mutant{{"main.go", 1, 2, "!=", "=="}}},
+		{"lower than", "main.go", "a < b", []Mutant{{"main.go", 1, 2, "<", ">="}}},
+		{"lower or equal", "main.go", "a <= b", []Mutant{{"main.go", 1, 2, "<=", ">"}}},
+		{"greater than", "main.go", "a > b", []Mutant{{"main.go", 1, 2, ">", "<="}}},
+		{"greater or equal", "main.go", "a >= b", []Mutant{{"main.go", 1, 2, ">=", "<"}}},
+		{"logical and", "main.go", "a && b", []Mutant{{"main.go", 1, 2, "&&", "||"}}},
+		{"logical or", "main.go", "a || b", []Mutant{{"main.go", 1, 2, "||", "&&"}}},
+		{"addition", "main.go", "a + b", []Mutant{{"main.go", 1, 2, "+", "-"}}},
+		{"subtraction", "main.go", "a - b", []Mutant{{"main.go", 1, 2, "-", "+"}}},
+		{"multiplication", "main.go", "a * b", []Mutant{{"main.go", 1, 2, "*", "/"}}},
+		{"division", "main.go", "a / b", []Mutant{{"main.go", 1, 2, "/", "*"}}},
+		{"true literal", "main.go", "return true", []Mutant{{"main.go", 1, 7, "true", "false"}}},
+		{"false literal", "main.go", "return false", []Mutant{{"main.go", 1, 7, "false", "true"}}},
+		{"identifier containing a literal", "main.go", "isTrue := trueValue", nil},
+		{"field named as a literal", "Main.java", "x = this.true", nil},
+		{"assignment", "main.go", "a = b", nil},
+		{"compound assignment", "main.go", "a += b", nil},
+		{"increment", "main.go", "i++", nil},
+		{"unary minus", "main.go", "a = -b", nil},
+		{"pointer dereference", "main.go", "a = *b", nil},
+		{"operator without spaces", "main.go", "a*b + c", []Mutant{{"main.go", 1, 4, "+", "-"}}},
+		{"channel receive", "main.go", "v := <-ch", nil},
+		{"java lambda", "Main.java", "x -> x", nil},
+		{"java generics", "Main.java", "List<String> l", nil},
+		{"line comment", "main.go", "// a == b", nil},
+		{"block comment", "main.go", "/* a == b */", nil},
+		{"string literal", "main.go", `s := "a == b"`, nil},
+		{"string literal with escaped quote", "main.go", `s := "\" == " + t`, []Mutant{{"main.go", 1, 14, "+", "-"}}},
+		{"character literal", "Main.java", "c == '+'", []Mutant{{"Main.java", 1, 2, "==", "!="}}},
+		{"go raw string", "main.go", "s := `a\n== b`", nil},
+		{"java text block", "Main.java", "s = \"\"\"\na == b\n\"\"\";", nil},
+		{
+			"line numbers",
+			"main.go",
+			"/*\n\n*/\ns := `\n`\nif a > b {\n\treturn true\n}",
+			[]Mutant{
+				{"main.go", 6, 21, ">", "<="},
+				{"main.go", 7, 35, "true", "false"},
+			},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Generate(tt.path, []byte(tt.src)))
+		})
+	}
+}
+
+func Test_apply_mutant(t *testing.T) {
+	src := []byte("if a <= b {")
+	m := Generate("main.go", src)[0]
+	assert.Equal(t, "if a > b {", string(m.Apply(src)))
+	assert.Equal(t, "if a <= b {", string(src))
+}
+
+func Test_mutant_description(t *testing.T) {
+	m := Mutant{"main.go", 12, 0, "==", "!="}
+	assert.Equal(t, "main.go:12: == replaced with !=", m.String())
+}
+
+func Test_mutation_score(t *testing.T) {
+	testFlags := []struct {
+		desc            string
+		outcomes        []Outcome
+		expectedTotal   int
+		expectedPercent float64
+	}{
+		{"no mutant", nil, 0, 100},
+		{"all killed", []Outcome{Killed, Killed}, 2, 100},
+		{"all survived", []Outcome{Survived, Survived}, 2, 0},
+		{"half killed", []Outcome{Killed, Survived}, 2, 50},
+		{"stillborn mutants are ignored", []Outcome{Killed, Survived, Stillborn}, 3, 50},
+		{"stillborn mutants only", []Outcome{Stillborn}, 1, 100},
+		{"timed out mutants are killed", []Outcome{TimedOut, Survived}, 2, 50},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var s Score
+			for _, o := range tt.outcomes {
+				s.Add(o)
+			}
+			assert.Equal(t, tt.expectedTotal, s.Total())
+			assert.Equal(t, tt.expectedPercent, s.Percent())
+		})
+	}
+}
+
+func Test_outcome_names(t *testing.T) {
+	assert.Equal(t, "killed", Killed.String())
+	assert.Equal(t, "survived", Survived.String())
+	assert.Equal(t, "stillborn", Stillborn.String())
+	assert.Equal(t, "timed out", TimedOut.String())
+}
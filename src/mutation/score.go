@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package mutation
+
+// Outcome is the result of running the tests against a mutant
+type Outcome int
+
+// List of possible mutant outcomes
+const (
+	// Killed means that at least one test failed with the mutant
+	Killed Outcome = iota
+	// Survived means that all tests passed with the mutant
+	Survived
+	// Stillborn means that the mutant did not build
+	Stillborn
+	// TimedOut means that tests were aborted as they ran for too long with the mutant.
+	// Such mutants are counted as killed
+	TimedOut
+)
+
+// String returns the outcome name
+func (o Outcome) String() string {
+	switch o {
+	case Killed:
+		return "killed"
+	case Survived:
+		return "survived"
+	case TimedOut:
+		return "timed out"
+	default:
+		return "stillborn"
+	}
+}
+
+// Score contains the number of mutants for each outcome. Killed includes TimedOut mutants
+type Score struct {
+	Killed    int
+	Survived  int
+	Stillborn int
+	TimedOut  int
+}
+
+// Add records the outcome of a mutant
+func (s *Score) Add(o Outcome) {
+	switch o {
+	case Killed:
+		s.Killed++
+	case TimedOut:
+		s.Killed++
+		s.TimedOut++
+	case Survived:
+		s.Survived++
+	default:
+		s.Stillborn++
+	}
+}
+
+// Total returns the total number of mutants recorded
+func (s Score) Total() int {
+	return s.Killed + s.Survived + s.Stillborn
+}
+
+// Percent returns the percentage of killed mutants among the ones that could be built.
+// Returns 100 when there is no such mutant
+func (s Score) Percent() float64 {
+	if s.Killed+s.Survived == 0 {
+		return 100
+	}
+	return 100 * float64(s.Killed) / float64(s.Killed+s.Survived)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/murex/tcr/helpers"
+	"github.com/spf13/afero"
+)
+
+const (
+	notificationDir = "notification"
+)
+
+var (
+	notificationDirPath string
+	appFS               = afero.NewOsFs()
+)
+
+type (
+	// ruleConfigYAML defines the structure of a filtering rule configuration
+	ruleConfigYAML struct {
+		Categories []string `yaml:"categories,flow"`
+		Emphasis   *bool    `yaml:"emphasis,omitempty"`
+		Match      string   `yaml:"match,omitempty"`
+	}
+
+	// configYAML defines the structure of a notification sink configuration
+	configYAML struct {
+		Name      string           `yaml:"-"`
+		Type      string           `yaml:"type"`
+		URL       string           `yaml:"url,omitempty"`
+		Command   string           `yaml:"command,omitempty"`
+		Arguments []string         `yaml:"arguments,flow,omitempty"`
+		Path      string           `yaml:"path,omitempty"`
+		Timeout   time.Duration    `yaml:"timeout,omitempty"`
+		Rules     []ruleConfigYAML `yaml:"rules"`
+	}
+)
+
+// InitConfig initializes the notification sinks configuration location.
+// Each YAML file found in the notification sub-directory describes one sink
+func InitConfig(configDirPath string) {
+	if configDirPath == "" {
+		notificationDirPath = ""
+		return
+	}
+	notificationDirPath = filepath.Join(configDirPath, notificationDir)
+}
+
+// GetConfigDirPath returns the path to the notification configuration directory
+func GetConfigDirPath() string {
+	return notificationDirPath
+}
+
+// GetConfigFileList returns the list of notification sink configuration files
+func GetConfigFileList() (list []string) {
+	if notificationDirPath == "" {
+		return nil
+	}
+	return helpers.ListYAMLFilesIn(appFS, notificationDirPath)
+}
+
+// LoadSubscriptions loads all the notification sinks found in the configuration directory.
+// Sinks with an invalid configuration are skipped, and the corresponding errors are returned
+func LoadSubscriptions() (subscriptions []Subscription, err error) {
+	for _, entry := range GetConfigFileList() {
+		cfg, loadErr := loadConfig(entry)
+		if loadErr == nil {
+			var s Subscription
+			if s, loadErr = cfg.asSubscription(); loadErr == nil {
+				subscriptions = append(subscriptions, s)
+				continue
+			}
+		}
+		err = errors.Join(err, fmt.Errorf("%s: %w", entry, loadErr))
+	}
+	return subscriptions, err
+}
+
+func loadConfig(yamlFilename string) (*configYAML, error) {
+	var cfg configYAML
+	err := helpers.LoadFromYAMLFile(afero.NewIOFS(afero.NewBasePathFs(appFS, notificationDirPath)), yamlFilename, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Name = helpers.ExtractNameFromYAMLFilename(yamlFilename)
+	return &cfg, nil
+}
+
+func (c configYAML) asSubscription() (Subscription, error) {
+	sink, err := c.asSink()
+	if err != nil {
+		return Subscription{}, err
+	}
+	var rules []Rule
+	for _, ruleCfg := range c.Rules {
+		rule, err := NewRule(ruleCfg.Categories, ruleCfg.Emphasis, ruleCfg.Match)
+		if err != nil {
+			return Subscription{}, err
+		}
+		rules = append(rules, rule)
+	}
+	return Subscription{Name: c.Name, Sink: sink, Rules: rules}, nil
+}
+
+func (c configYAML) asSink() (Sink, error) {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	switch c.Type {
+	case WebhookSink:
+		if c.URL == "" {
+			return nil, errors.New("webhook sink requires a url")
+		}
+		return NewWebhook(c.URL, timeout), nil
+	case CommandSink:
+		if c.Command == "" {
+			return nil, errors.New("command sink requires a command")
+		}
+		return NewCommand(c.Command, c.Arguments, timeout), nil
+	case SoundSink:
+		if c.Path == "" {
+			return nil, errors.New("sound sink requires a path")
+		}
+		return NewSound(c.Path, timeout), nil
+	case FileSink:
+		if c.Path == "" {
+			return nil, errors.New("file sink requires a path")
+		}
+		return NewFile(c.Path), nil
+	default:
+		return nil, fmt.Errorf("unknown notification sink type: %q", c.Type)
+	}
+}
+
+// ShowConfigs shows the notification sinks configuration
+func ShowConfigs() {
+	entries := GetConfigFileList()
+	if len(entries) == 0 {
+		return
+	}
+	helpers.Trace("Configured notification sinks:")
+	for _, entry := range entries {
+		cfg, err := loadConfig(entry)
+		if err != nil {
+			continue
+		}
+		cfg.show()
+	}
+}
+
+func (c configYAML) show() {
+	prefix := "notification." + c.Name
+	helpers.TraceKeyValue(prefix+".type", c.Type)
+	for key, value := range map[string]string{"url": c.URL, "command": c.Command, "path": c.Path} {
+		if value != "" {
+			helpers.TraceKeyValue(prefix+"."+key, value)
+		}
+	}
+	for i, rule := range c.Rules {
+		rulePrefix := fmt.Sprintf("%s.rules.%d", prefix, i)
+		helpers.TraceKeyValue(rulePrefix+".categories", rule.Categories)
+		if rule.Emphasis != nil {
+			helpers.TraceKeyValue(rulePrefix+".emphasis", *rule.Emphasis)
+		}
+		if rule.Match != "" {
+			helpers.TraceKeyValue(rulePrefix+".match", rule.Match)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package notification
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeSinkConfig(t *testing.T, dir string, name string, contents string) {
+	t.Helper()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, notificationDir), 0750))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, notificationDir, name), []byte(contents), 0600))
+}
+
+func Test_init_config(t *testing.T) {
+	InitConfig("")
+	assert.Empty(t, GetConfigDirPath())
+	assert.Empty(t, GetConfigFileList())
+
+	InitConfig("some-dir")
+	assert.Equal(t, filepath.Join("some-dir", notificationDir), GetConfigDirPath())
+	InitConfig("")
+}
+
+func Test_load_subscriptions(t *testing.T) {
+	dir := t.TempDir()
+	writeSinkConfig(t, dir, "traffic-light.yml", `
+type: webhook
+url: http://127.0.0.1:8080/light
+rules:
+  - categories: [success, error]
+    emphasis: true
+  - match: "^Next driver"
+`)
+	writeSinkConfig(t, dir, "beep.yml", `
+type: sound
+path: beep.wav
+timeout: 3s
+`)
+	writeSinkConfig(t, dir, "fifo.yml", `
+type: file
+path: /tmp/tcr.fifo
+`)
+	writeSinkConfig(t, dir, "script.yml", `
+type: command
+command: ./notify.sh
+arguments: [--verbose]
+`)
+	writeSinkConfig(t, dir, "README.md", "not a sink configuration")
+	InitConfig(dir)
+	defer InitConfig("")
+
+	subscriptions, err := LoadSubscriptions()
+
+	assert.NoError(t, err)
+	assert.Len(t, subscriptions, 4)
+	var names []string
+	for _, s := range subscriptions {
+		names = append(names, s.Name)
+		if s.Name == "traffic-light" {
+			assert.Len(t, s.Rules, 2)
+			assert.Equal(t, []string{CategorySuccess, CategoryError}, s.Rules[0].Categories)
+			assert.True(t, *s.Rules[0].Emphasis)
+			assert.True(t, s.Rules[1].Match.MatchString("Next driver is alice"))
+		}
+		if s.Name == "beep" {
+			assert.IsType(t, &command{}, s.Sink)
+		}
+	}
+	assert.ElementsMatch(t, []string{"traffic-light", "beep", "fifo", "script"}, names)
+}
+
+func Test_load_invalid_subscriptions(t *testing.T) {
+	tests := []struct {
+		desc     string
+		contents string
+	}{
+		{"unknown type", "type: pigeon"},
+		{"webhook without url", "type: webhook"},
+		{"command without command", "type: command"},
+		{"sound without path", "type: sound"},
+		{"file without path", "type: file"},
+		{"unknown category", "type: file\npath: out.jsonl\nrules:\n  - categories: [panic]"},
+		{"invalid match expression", "type: file\npath: out.jsonl\nrules:\n  - match: \"(\""},
+		{"invalid yaml", "type: [file"},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			dir := t.TempDir()
+			writeSinkConfig(t, dir, "invalid.yml", test.contents)
+			writeSinkConfig(t, dir, "valid.yml", "type: file\npath: out.jsonl")
+			InitConfig(dir)
+			defer InitConfig("")
+
+			subscriptions, err := LoadSubscriptions()
+
+			assert.ErrorContains(t, err, "invalid.yml")
+			assert.Len(t, subscriptions, 1)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/murex/tcr/report"
+)
+
+// Category names used in notification events and filtering rules
+const (
+	CategoryNormal     = "normal"
+	CategoryInfo       = "info"
+	CategoryTitle      = "title"
+	CategorySuccess    = "success"
+	CategoryWarning    = "warning"
+	CategoryError      = "error"
+	CategoryRoleEvent  = "role-event"
+	CategoryTimerEvent = "timer-event"
+)
+
+var categoryNames = map[report.Category]string{
+	report.Normal:     CategoryNormal,
+	report.Info:       CategoryInfo,
+	report.Title:      CategoryTitle,
+	report.Success:    CategorySuccess,
+	report.Warning:    CategoryWarning,
+	report.Error:      CategoryError,
+	report.RoleEvent:  CategoryRoleEvent,
+	report.TimerEvent: CategoryTimerEvent,
+}
+
+// Event is the notification sent to sinks for each reported message
+type Event struct {
+	Category  string    `json:"category"`
+	Emphasis  bool      `json:"emphasis"`
+	Text      string    `json:"text"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NewEvent creates a notification event from a reported message
+func NewEvent(category report.Category, emphasis bool, payload report.MessagePayload) Event {
+	return Event{
+		Category:  categoryNames[category],
+		Emphasis:  emphasis,
+		Text:      payload.ToString(),
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// JSON returns the JSON representation of the event
+func (e Event) JSON() []byte {
+	b, _ := json.Marshal(e)
+	return b
+}
+
+// Rule is a filtering rule selecting the events sent to a sink.
+// An event matches the rule when it matches all the criteria set in the rule
+type Rule struct {
+	// Categories restricts the rule to these categories. All categories match when empty
+	Categories []string
+	// Emphasis restricts the rule to events with (or without) emphasis when set
+	Emphasis *bool
+	// Match restricts the rule to events whose text matches this regular expression when set
+	Match *regexp.Regexp
+}
+
+// NewRule creates a filtering rule, checking that the provided categories and
+// regular expression are valid
+func NewRule(categories []string, emphasis *bool, match string) (Rule, error) {
+	for _, category := range categories {
+		if !isKnownCategory(category) {
+			return Rule{}, fmt.Errorf("unknown notification category: %s", category)
+		}
+	}
+	rule := Rule{Categories: categories, Emphasis: emphasis}
+	if match != "" {
+		re, err := regexp.Compile(match)
+		if err != nil {
+			return Rule{}, fmt.Errorf("invalid match expression %q: %w", match, err)
+		}
+		rule.Match = re
+	}
+	return rule, nil
+}
+
+func isKnownCategory(name string) bool {
+	for _, known := range categoryNames {
+		if name == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches indicates if the event matches the rule
+func (r Rule) Matches(e Event) bool {
+	if len(r.Categories) > 0 && !slices.Contains(r.Categories, e.Category) {
+		return false
+	}
+	if r.Emphasis != nil && *r.Emphasis != e.Emphasis {
+		return false
+	}
+	return r.Match == nil || r.Match.MatchString(e.Text)
+}
+
+// matchesAny indicates if the event matches at least one of the rules.
+// All events match when there is no rule
+func matchesAny(rules []Rule, e Event) bool {
+	if len(rules) == 0 {
+		return true
+	}
+	for _, rule := range rules {
+		if rule.Matches(e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package notification
+
+import (
+	"testing"
+
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/report/role_event"
+	"github.com/murex/tcr/report/text"
+	"github.com/murex/tcr/role"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_new_event(t *testing.T) {
+	tests := []struct {
+		category report.Category
+		payload  report.MessagePayload
+		expected string
+		text     string
+	}{
+		{report.Normal, text.New("some text"), CategoryNormal, "some text"},
+		{report.Info, text.New("some info"), CategoryInfo, "some info"},
+		{report.Title, text.New("some title"), CategoryTitle, "some title"},
+		{report.Success, text.New("tests passed"), CategorySuccess, "tests passed"},
+		{report.Warning, text.New("build failed"), CategoryWarning, "build failed"},
+		{report.Error, text.New("tests failed"), CategoryError, "tests failed"},
+		{report.RoleEvent, role_event.New(role_event.TriggerStart, role.Driver{}), CategoryRoleEvent, "driver:start"},
+	}
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			e := NewEvent(test.category, true, test.payload)
+			assert.Equal(t, test.expected, e.Category)
+			assert.Equal(t, test.text, e.Text)
+			assert.True(t, e.Emphasis)
+		})
+	}
+}
+
+func Test_event_json(t *testing.T) {
+	e := Event{Category: CategorySuccess, Emphasis: true, Text: "tests passed"}
+	assert.JSONEq(t,
+		`{"category":"success","emphasis":true,"text":"tests passed","timestamp":"0001-01-01T00:00:00Z"}`,
+		string(e.JSON()))
+}
+
+func Test_new_rule_validation(t *testing.T) {
+	tests := []struct {
+		desc       string
+		categories []string
+		match      string
+		expectErr  bool
+	}{
+		{"no criteria", nil, "", false},
+		{"known categories", []string{CategorySuccess, CategoryError}, "", false},
+		{"unknown category", []string{"panic"}, "", true},
+		{"valid match expression", nil, "tests (passed|failed)", false},
+		{"invalid match expression", nil, "tests (passed", true},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := NewRule(test.categories, nil, test.match)
+			if test.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_rule_matching(t *testing.T) {
+	yes, no := true, false
+	passed := Event{Category: CategorySuccess, Emphasis: true, Text: "tests passed"}
+	failed := Event{Category: CategoryError, Emphasis: true, Text: "tests failed"}
+	info := Event{Category: CategoryInfo, Emphasis: false, Text: "Running tests"}
+	tests := []struct {
+		desc       string
+		categories []string
+		emphasis   *bool
+		match      string
+		event      Event
+		expected   bool
+	}{
+		{"no criteria", nil, nil, "", info, true},
+		{"matching category", []string{CategorySuccess, CategoryError}, nil, "", failed, true},
+		{"other category", []string{CategorySuccess, CategoryError}, nil, "", info, false},
+		{"matching emphasis", nil, &yes, "", passed, true},
+		{"other emphasis", nil, &no, "", passed, false},
+		{"matching text", nil, nil, "failed$", failed, true},
+		{"other text", nil, nil, "failed$", passed, false},
+		{"all criteria matching", []string{CategorySuccess}, &yes, "passed", passed, true},
+		{"one criterion not matching", []string{CategorySuccess}, &yes, "failed", passed, false},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			rule, _ := NewRule(test.categories, test.emphasis, test.match)
+			assert.Equal(t, test.expected, rule.Matches(test.event))
+		})
+	}
+}
+
+func Test_matches_any_rule(t *testing.T) {
+	success, _ := NewRule([]string{CategorySuccess}, nil, "")
+	failure, _ := NewRule([]string{CategoryError}, nil, "")
+	e := Event{Category: CategoryError}
+	assert.True(t, matchesAny(nil, e))
+	assert.True(t, matchesAny([]Rule{success, failure}, e))
+	assert.False(t, matchesAny([]Rule{success}, e))
+}
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package notification
+
+import (
+	"github.com/murex/tcr/helpers"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/report/role_event"
+	"github.com/murex/tcr/report/text"
+	"github.com/murex/tcr/report/timer_event"
+)
+
+// Subscription associates a sink with the filtering rules selecting the events it receives
+type Subscription struct {
+	Name  string
+	Sink  Sink
+	Rules []Rule
+}
+
+// Notifier forwards reported messages to notification sinks
+type Notifier struct {
+	subscriptions []Subscription
+}
+
+// NewNotifier creates a notifier forwarding reported messages to the provided subscriptions
+func NewNotifier(subscriptions ...Subscription) *Notifier {
+	return &Notifier{subscriptions: subscriptions}
+}
+
+// Start loads notification sinks from the configuration directory and subscribes them
+// to reported messages. It returns the number of sinks started, and the channel
+// allowing to unsubscribe them (nil when there is no sink)
+func Start() (int, chan bool) {
+	subscriptions, err := LoadSubscriptions()
+	if err != nil {
+		report.PostWarning("Some notification sinks could not be loaded: ", err.Error())
+	}
+	if len(subscriptions) == 0 {
+		return 0, nil
+	}
+	return len(subscriptions), report.Subscribe(NewNotifier(subscriptions...))
+}
+
+// notify sends the event to all the sinks whose rules match it.
+// Sink failures are only traced: reporting them would trigger new notifications
+func (n *Notifier) notify(e Event) {
+	for _, s := range n.subscriptions {
+		if !matchesAny(s.Rules, e) {
+			continue
+		}
+		if err := s.Sink.Notify(e); err != nil {
+			helpers.Trace("Notification sink ", s.Name, " failed: ", err)
+		}
+	}
+}
+
+// ReportSimple reports simple messages
+func (n *Notifier) ReportSimple(emphasis bool, payload text.Message) {
+	n.notify(NewEvent(report.Normal, emphasis, payload))
+}
+
+// ReportInfo reports info messages
+func (n *Notifier) ReportInfo(emphasis bool, payload text.Message) {
+	n.notify(NewEvent(report.Info, emphasis, payload))
+}
+
+// ReportTitle reports title messages
+func (n *Notifier) ReportTitle(emphasis bool, payload text.Message) {
+	n.notify(NewEvent(report.Title, emphasis, payload))
+}
+
+// ReportSuccess reports success messages
+func (n *Notifier) ReportSuccess(emphasis bool, payload text.Message) {
+	n.notify(NewEvent(report.Success, emphasis, payload))
+}
+
+// ReportWarning reports warning messages
+func (n *Notifier) ReportWarning(emphasis bool, payload text.Message) {
+	n.notify(NewEvent(report.Warning, emphasis, payload))
+}
+
+// ReportError reports error messages
+func (n *Notifier) ReportError(emphasis bool, payload text.Message) {
+	n.notify(NewEvent(report.Error, emphasis, payload))
+}
+
+// ReportRoleEvent reports role event messages
+func (n *Notifier) ReportRoleEvent(emphasis bool, payload role_event.Message) {
+	n.notify(NewEvent(report.RoleEvent, emphasis, payload))
+}
+
+// ReportTimerEvent reports timer event messages
+func (n *Notifier) ReportTimerEvent(emphasis bool, payload timer_event.Message) {
+	n.notify(NewEvent(report.TimerEvent, emphasis, payload))
+}
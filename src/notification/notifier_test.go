@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package notification
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/report/role_event"
+	"github.com/murex/tcr/report/text"
+	"github.com/murex/tcr/report/timer_event"
+	"github.com/murex/tcr/role"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSink records the events it receives
+type fakeSink struct {
+	mutex  sync.Mutex
+	events []Event
+	err    error
+}
+
+func (s *fakeSink) Notify(e Event) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.events = append(s.events, e)
+	return s.err
+}
+
+func (s *fakeSink) categories() (categories []string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, e := range s.events {
+		categories = append(categories, e.Category)
+	}
+	return categories
+}
+
+func Test_notifier_forwards_all_categories(t *testing.T) {
+	sink := &fakeSink{}
+	n := NewNotifier(Subscription{Name: "all", Sink: sink})
+
+	n.ReportSimple(false, text.New("simple"))
+	n.ReportInfo(false, text.New("info"))
+	n.ReportTitle(false, text.New("title"))
+	n.ReportSuccess(true, text.New("success"))
+	n.ReportWarning(false, text.New("warning"))
+	n.ReportError(true, text.New("error"))
+	n.ReportRoleEvent(false, role_event.New(role_event.TriggerStart, role.Driver{}))
+	n.ReportTimerEvent(false, timer_event.New(timer_event.TriggerStart, 0, 0, 0))
+
+	assert.Equal(t, []string{
+		CategoryNormal, CategoryInfo, CategoryTitle, CategorySuccess,
+		CategoryWarning, CategoryError, CategoryRoleEvent, CategoryTimerEvent,
+	}, sink.categories())
+}
+
+func Test_notifier_applies_subscription_rules(t *testing.T) {
+	emphasis := true
+	cycles, _ := NewRule([]string{CategorySuccess, CategoryError}, &emphasis, "")
+	roles, _ := NewRule([]string{CategoryRoleEvent}, nil, "")
+	trafficLight := &fakeSink{}
+	chatBot := &fakeSink{}
+	n := NewNotifier(
+		Subscription{Name: "traffic-light", Sink: trafficLight, Rules: []Rule{cycles}},
+		Subscription{Name: "chat-bot", Sink: chatBot, Rules: []Rule{cycles, roles}},
+	)
+
+	n.ReportInfo(false, text.New("Running tests"))
+	n.ReportError(true, text.New("Some tests are failing"))
+	n.ReportSuccess(false, text.New("Changes committed"))
+	n.ReportRoleEvent(false, role_event.New(role_event.TriggerEnd, role.Driver{}))
+	n.ReportSuccess(true, text.New("Tests passed"))
+
+	assert.Equal(t, []string{CategoryError, CategorySuccess}, trafficLight.categories())
+	assert.Equal(t, []string{CategoryError, CategoryRoleEvent, CategorySuccess}, chatBot.categories())
+}
+
+func Test_notifier_keeps_on_going_when_a_sink_fails(t *testing.T) {
+	failing := &fakeSink{err: errors.New("sink failure")}
+	working := &fakeSink{}
+	n := NewNotifier(
+		Subscription{Name: "failing", Sink: failing},
+		Subscription{Name: "working", Sink: working},
+	)
+
+	n.ReportError(true, text.New("tests failed"))
+	n.ReportSuccess(true, text.New("tests passed"))
+
+	assert.Len(t, failing.categories(), 2)
+	assert.Len(t, working.categories(), 2)
+}
+
+func Test_start_without_sinks(t *testing.T) {
+	InitConfig(t.TempDir())
+	defer InitConfig("")
+	count, unsubscribe := Start()
+	assert.Zero(t, count)
+	assert.Nil(t, unsubscribe)
+}
+
+func Test_start_subscribes_configured_sinks_to_reported_messages(t *testing.T) {
+	received := make(chan Event, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e Event
+		_ = json.NewDecoder(r.Body).Decode(&e)
+		received <- e
+	}))
+	defer server.Close()
+	dir := t.TempDir()
+	writeSinkConfig(t, dir, "chat-bot.yml", "type: webhook\nurl: "+server.URL+
+		"\nrules:\n  - categories: [error]\n")
+	InitConfig(dir)
+	defer InitConfig("")
+	defer report.SetDefaultReporter(report.SetDefaultReporter(report.NewReporter()))
+
+	count, unsubscribe := Start()
+	assert.Equal(t, 1, count)
+	defer report.Unsubscribe(unsubscribe)
+	report.PostInfo("Running tests")
+	report.PostErrorWithEmphasis("Some tests are failing")
+
+	select {
+	case e := <-received:
+		assert.Equal(t, CategoryError, e.Category)
+		assert.Equal(t, "Some tests are failing", e.Text)
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook did not receive any notification")
+	}
+}
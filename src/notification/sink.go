@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Sink types that can be set in notification configuration files
+const (
+	WebhookSink = "webhook"
+	CommandSink = "command"
+	SoundSink   = "sound"
+	FileSink    = "file"
+)
+
+// DefaultTimeout is the maximum time given to a sink for handling an event
+const DefaultTimeout = 10 * time.Second
+
+// Sink is the interface that any notification sink should implement
+type Sink interface {
+	Notify(e Event) error
+}
+
+// webhook sends events as JSON POST requests to a URL
+type webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook creates a sink sending events as JSON POST requests to the provided URL.
+// The "text" field of the JSON payload makes it compatible with most chat webhooks
+func NewWebhook(url string, timeout time.Duration) Sink {
+	return &webhook{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+// Notify sends the event to the webhook
+func (w *webhook) Notify(e Event) error {
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(e.JSON()))
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook %s returned %s", w.url, resp.Status)
+	}
+	return nil
+}
+
+// command runs a shell command for each event
+type command struct {
+	path      string
+	arguments []string
+	timeout   time.Duration
+}
+
+// NewCommand creates a sink running the provided command for each event.
+// The event is passed to the command as JSON on its standard input,
+// and through TCR_CATEGORY, TCR_EMPHASIS and TCR_TEXT environment variables
+func NewCommand(path string, arguments []string, timeout time.Duration) Sink {
+	return &command{path: path, arguments: arguments, timeout: timeout}
+}
+
+// Notify runs the command for the event
+func (c *command) Notify(e Event) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, c.path, c.arguments...) //nolint:gosec // command is set by the user
+	cmd.Stdin = bytes.NewReader(e.JSON())
+	cmd.Env = append(os.Environ(),
+		"TCR_CATEGORY="+e.Category,
+		"TCR_EMPHASIS="+strconv.FormatBool(e.Emphasis),
+		"TCR_TEXT="+e.Text,
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: %w: %s", c.path, err, bytes.TrimSpace(output))
+	}
+	return nil
+}
+
+// NewSound creates a sink playing the provided sound file for each event,
+// using the sound player available on the current platform
+func NewSound(path string, timeout time.Duration) Sink {
+	player, arguments := soundPlayer(runtime.GOOS, path)
+	return NewCommand(player, arguments, timeout)
+}
+
+// soundPlayer returns the command and arguments used to play a sound file on the provided OS
+func soundPlayer(goos string, path string) (string, []string) {
+	switch goos {
+	case "darwin":
+		return "afplay", []string{path}
+	case "windows":
+		return "powershell", []string{"-NoProfile", "-Command",
+			"(New-Object Media.SoundPlayer '" + path + "').PlaySync()"}
+	default:
+		return "paplay", []string{path}
+	}
+}
+
+// file appends events as JSON lines to a file or a named pipe (FIFO)
+type file struct {
+	path string
+}
+
+// NewFile creates a sink appending events as JSON lines to the provided file.
+// When the file is a named pipe (FIFO), events are dropped while no process reads it
+func NewFile(path string) Sink {
+	return &file{path: path}
+}
+
+// Notify appends the event to the file
+func (f *file) Notify(e Event) error {
+	// O_NONBLOCK prevents from waiting for a reader when the file is a named pipe
+	out, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE|syscall.O_NONBLOCK, 0644) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer func() { _ = out.Close() }()
+	_, err = out.Write(append(e.JSON(), '\n'))
+	return err
+}
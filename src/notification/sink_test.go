@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package notification
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testEvent = Event{Category: CategoryError, Emphasis: true, Text: "tests failed"}
+
+func Test_webhook_posts_event_as_json(t *testing.T) {
+	var received Event
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := NewWebhook(server.URL, time.Second).Notify(testEvent)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, testEvent, received)
+}
+
+func Test_webhook_failures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	url := server.URL
+	assert.Error(t, NewWebhook(url, time.Second).Notify(testEvent))
+	server.Close()
+	assert.Error(t, NewWebhook(url, time.Second).Notify(testEvent))
+}
+
+func Test_command_receives_event(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a POSIX shell")
+	}
+	out := filepath.Join(t.TempDir(), "out.txt")
+	script := `echo "$TCR_CATEGORY|$TCR_EMPHASIS|$TCR_TEXT" > "$0"; cat >> "$0"`
+
+	err := NewCommand("sh", []string{"-c", script, out}, time.Second).Notify(testEvent)
+
+	assert.NoError(t, err)
+	contents, _ := os.ReadFile(out)
+	lines := strings.SplitN(string(contents), "\n", 2)
+	assert.Equal(t, "error|true|tests failed", lines[0])
+	assert.JSONEq(t, string(testEvent.JSON()), lines[1])
+}
+
+func Test_command_failure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a POSIX shell")
+	}
+	err := NewCommand("sh", []string{"-c", "echo oops; exit 3"}, time.Second).Notify(testEvent)
+	assert.ErrorContains(t, err, "oops")
+	assert.Error(t, NewCommand("unknown-command-for-tcr", nil, time.Second).Notify(testEvent))
+}
+
+func Test_command_timeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("relies on a POSIX shell")
+	}
+	err := NewCommand("sleep", []string{"5"}, 50*time.Millisecond).Notify(testEvent)
+	assert.Error(t, err)
+}
+
+func Test_sound_player(t *testing.T) {
+	tests := []struct {
+		goos         string
+		expectedCmd  string
+		expectedArgs []string
+	}{
+		{"darwin", "afplay", []string{"ding.wav"}},
+		{"linux", "paplay", []string{"ding.wav"}},
+		{"windows", "powershell", []string{"-NoProfile", "-Command", "(New-Object Media.SoundPlayer 'ding.wav').PlaySync()"}},
+	}
+	for _, test := range tests {
+		t.Run(test.goos, func(t *testing.T) {
+			cmd, args := soundPlayer(test.goos, "ding.wav")
+			assert.Equal(t, test.expectedCmd, cmd)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func Test_file_appends_events_as_json_lines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink := NewFile(path)
+
+	assert.NoError(t, sink.Notify(testEvent))
+	assert.NoError(t, sink.Notify(Event{Category: CategorySuccess, Text: "tests passed"}))
+
+	contents, _ := os.ReadFile(path)
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	assert.Len(t, lines, 2)
+	assert.JSONEq(t, string(testEvent.JSON()), lines[0])
+}
+
+func Test_file_sink_in_missing_directory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "events.jsonl")
+	assert.Error(t, NewFile(path).Notify(testEvent))
+}
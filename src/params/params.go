@@ -30,19 +30,55 @@ import (
 
 // Params contains the main parameter values that TCR engine is using
 type Params struct {
-	ConfigDir       string
-	BaseDir         string
-	WorkDir         string
-	Language        string
-	Toolchain       string
-	MobTurnDuration time.Duration
-	GitRemote       string
-	AutoPush        bool
-	Variant         string
-	PollingPeriod   time.Duration
-	Mode            runmode.RunMode
-	VCS             string
-	MessageSuffix   string
-	Trace           string
-	PortNumber      int
+	ConfigDir         string
+	BaseDir           string
+	WorkDir           string
+	Language          string
+	Toolchain         string
+	Modules           []string
+	MobTurnDuration   time.Duration
+	GitRemote         string
+	AutoPush          bool
+	IsolatedRuns      bool
+	TestSelection     bool
+	FullTestRunPeriod int
+	Variant           string
+	LintPolicy        string
+	AutoFormat        bool
+	CoverageGate      bool
+	PollingPeriod     time.Duration
+	// WatchDebounce is the quiet period following the last file change before a TCR cycle is triggered
+	WatchDebounce time.Duration
+	// WatchPollingPeriod is the period for polling watched directories. Filesystem notifications
+	// are used when it's zero
+	WatchPollingPeriod time.Duration
+	Mode               runmode.RunMode
+	VCS                string
+	MessageSuffix      string
+	Trace              string
+	PortNumber         int
+	ShelfID            string
+	FromJournal        bool
+	Format             string
+	OutputFile         string
+	Since              string
+	Until              string
+	Authors            []string
+	Branches           []string
+	Template           string
+	MutationCommits    int
+	MessageTemplate    string
+	Members            []string
+	Handover           bool
+	MobHost            bool
+	MobJoin            string
+	MobToken           string
+	MobFingerprint     string
+	WebAuth            bool
+	BindAddress        string
+	TLS                bool
+	// Hooks contains the shell commands associated to lifecycle hooks, indexed by hook name
+	Hooks map[string]string
+	// WebToken is the token generated for the session when the HTTP server requires one
+	WebToken string
 }
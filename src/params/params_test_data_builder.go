@@ -33,19 +33,50 @@ import (
 // AParamSet is a test data builder for type Params
 func AParamSet(builders ...func(params *Params)) *Params {
 	params := &Params{
-		ConfigDir:       "",
-		BaseDir:         "",
-		WorkDir:         "",
-		Language:        "",
-		Toolchain:       "",
-		MobTurnDuration: 0,
-		AutoPush:        false,
-		GitRemote:       "origin",
-		Variant:         "relaxed",
-		PollingPeriod:   0,
-		Mode:            runmode.OneShot{},
-		VCS:             "git",
-		PortNumber:      0,
+		ConfigDir:          "",
+		BaseDir:            "",
+		WorkDir:            "",
+		Language:           "",
+		Toolchain:          "",
+		Modules:            nil,
+		MobTurnDuration:    0,
+		AutoPush:           false,
+		IsolatedRuns:       false,
+		TestSelection:      false,
+		FullTestRunPeriod:  0,
+		GitRemote:          "origin",
+		Variant:            "relaxed",
+		LintPolicy:         "off",
+		AutoFormat:         false,
+		CoverageGate:       false,
+		PollingPeriod:      0,
+		WatchDebounce:      0,
+		WatchPollingPeriod: 0,
+		Mode:               runmode.OneShot{},
+		VCS:                "git",
+		PortNumber:         0,
+		ShelfID:            "",
+		FromJournal:        false,
+		Format:             "text",
+		OutputFile:         "",
+		Since:              "",
+		Until:              "",
+		Authors:            nil,
+		Branches:           nil,
+		Template:           "",
+		MutationCommits:    5,
+		MessageTemplate:    "default",
+		Members:            nil,
+		Handover:           false,
+		MobHost:            false,
+		MobJoin:            "",
+		MobToken:           "",
+		MobFingerprint:     "",
+		WebAuth:            false,
+		BindAddress:        "",
+		TLS:                false,
+		WebToken:           "",
+		Hooks:              nil,
 	}
 
 	for _, build := range builders {
@@ -89,6 +120,27 @@ func WithToolchain(name string) func(params *Params) {
 	}
 }
 
+// WithModules sets the provided values as the module declarations
+func WithModules(modules ...string) func(params *Params) {
+	return func(params *Params) {
+		params.Modules = modules
+	}
+}
+
+// WithWatchDebounce sets the provided value as the watch debounce period
+func WithWatchDebounce(period time.Duration) func(params *Params) {
+	return func(params *Params) {
+		params.WatchDebounce = period
+	}
+}
+
+// WithWatchPollingPeriod sets the provided value as the watch polling period
+func WithWatchPollingPeriod(period time.Duration) func(params *Params) {
+	return func(params *Params) {
+		params.WatchPollingPeriod = period
+	}
+}
+
 // WithPollingPeriod sets the provided value as the VCS polling period
 func WithPollingPeriod(period time.Duration) func(params *Params) {
 	return func(params *Params) {
@@ -110,6 +162,27 @@ func WithAutoPush(value bool) func(params *Params) {
 	}
 }
 
+// WithTestSelection sets test-selection flag to the provided value
+func WithTestSelection(value bool) func(params *Params) {
+	return func(params *Params) {
+		params.TestSelection = value
+	}
+}
+
+// WithFullTestRunPeriod sets the number of TCR cycles after which all tests are run
+func WithFullTestRunPeriod(period int) func(params *Params) {
+	return func(params *Params) {
+		params.FullTestRunPeriod = period
+	}
+}
+
+// WithIsolatedRuns sets isolated-runs flag to the provided value
+func WithIsolatedRuns(value bool) func(params *Params) {
+	return func(params *Params) {
+		params.IsolatedRuns = value
+	}
+}
+
 // WithGitRemote sets the provided value as the git remote name to be used
 func WithGitRemote(remote string) func(params *Params) {
 	return func(params *Params) {
@@ -124,6 +197,27 @@ func WithVariant(variant string) func(params *Params) {
 	}
 }
 
+// WithLintPolicy sets the provided value as the lint policy to be used
+func WithLintPolicy(policy string) func(params *Params) {
+	return func(params *Params) {
+		params.LintPolicy = policy
+	}
+}
+
+// WithAutoFormat sets auto-format flag to the provided value
+func WithAutoFormat(value bool) func(params *Params) {
+	return func(params *Params) {
+		params.AutoFormat = value
+	}
+}
+
+// WithCoverageGate sets coverage-gate flag to the provided value
+func WithCoverageGate(value bool) func(params *Params) {
+	return func(params *Params) {
+		params.CoverageGate = value
+	}
+}
+
 // WithRunMode sets the provided mode as the run mode
 func WithRunMode(mode runmode.RunMode) func(params *Params) {
 	return func(params *Params) {
@@ -151,3 +245,167 @@ func WithPortNumber(port int) func(params *Params) {
 		params.PortNumber = port
 	}
 }
+
+// WithShelfID sets the provided value as the shelf entry ID
+func WithShelfID(id string) func(params *Params) {
+	return func(params *Params) {
+		params.ShelfID = id
+	}
+}
+
+// WithFromJournal sets from-journal flag to the provided value
+func WithFromJournal(value bool) func(params *Params) {
+	return func(params *Params) {
+		params.FromJournal = value
+	}
+}
+
+// WithFormat sets the output format to the provided value
+func WithFormat(format string) func(params *Params) {
+	return func(params *Params) {
+		params.Format = format
+	}
+}
+
+// WithOutputFile sets the output file to the provided value
+func WithOutputFile(path string) func(params *Params) {
+	return func(params *Params) {
+		params.OutputFile = path
+	}
+}
+
+// WithSince sets the start of the history period to the provided value
+func WithSince(since string) func(params *Params) {
+	return func(params *Params) {
+		params.Since = since
+	}
+}
+
+// WithUntil sets the end of the history period to the provided value
+func WithUntil(until string) func(params *Params) {
+	return func(params *Params) {
+		params.Until = until
+	}
+}
+
+// WithAuthors sets the history authors to the provided values
+func WithAuthors(authors ...string) func(params *Params) {
+	return func(params *Params) {
+		params.Authors = authors
+	}
+}
+
+// WithBranches sets the history branches to the provided values
+func WithBranches(branches ...string) func(params *Params) {
+	return func(params *Params) {
+		params.Branches = branches
+	}
+}
+
+// WithTemplate sets the retrospective template path to the provided value
+func WithTemplate(path string) func(params *Params) {
+	return func(params *Params) {
+		params.Template = path
+	}
+}
+
+// WithMutationCommits sets the number of TCR commits considered by mutate subcommand
+func WithMutationCommits(count int) func(params *Params) {
+	return func(params *Params) {
+		params.MutationCommits = count
+	}
+}
+
+// WithMessageTemplate sets the commit message template to the provided value
+func WithMessageTemplate(value string) func(params *Params) {
+	return func(params *Params) {
+		params.MessageTemplate = value
+	}
+}
+
+// WithMembers sets the mob roster members to the provided values
+func WithMembers(members ...string) func(params *Params) {
+	return func(params *Params) {
+		params.Members = members
+	}
+}
+
+// WithHandover sets handover flag to the provided value
+func WithHandover(value bool) func(params *Params) {
+	return func(params *Params) {
+		params.Handover = value
+	}
+}
+
+// WithMobHost sets mob host flag to the provided value
+func WithMobHost(value bool) func(params *Params) {
+	return func(params *Params) {
+		params.MobHost = value
+	}
+}
+
+// WithMobJoin sets the address of the mob host to join to the provided value
+func WithMobJoin(address string) func(params *Params) {
+	return func(params *Params) {
+		params.MobJoin = address
+	}
+}
+
+// WithMobToken sets the mob host token to the provided value
+func WithMobToken(token string) func(params *Params) {
+	return func(params *Params) {
+		params.MobToken = token
+	}
+}
+
+// WithMobFingerprint sets the mob host certificate fingerprint to the provided value
+func WithMobFingerprint(fingerprint string) func(params *Params) {
+	return func(params *Params) {
+		params.MobFingerprint = fingerprint
+	}
+}
+
+// WithWebAuth sets web authentication flag to the provided value
+func WithWebAuth(value bool) func(params *Params) {
+	return func(params *Params) {
+		params.WebAuth = value
+	}
+}
+
+// WithBindAddress sets the HTTP server bind address to the provided value
+func WithBindAddress(address string) func(params *Params) {
+	return func(params *Params) {
+		params.BindAddress = address
+	}
+}
+
+// WithTLS sets TLS flag to the provided value
+func WithTLS(value bool) func(params *Params) {
+	return func(params *Params) {
+		params.TLS = value
+	}
+}
+
+// WithWebToken sets the HTTP server session token to the provided value
+func WithWebToken(token string) func(params *Params) {
+	return func(params *Params) {
+		params.WebToken = token
+	}
+}
+
+// WithHook sets the shell command associated to the provided lifecycle hook
+func WithHook(name string, command string) func(params *Params) {
+	return func(params *Params) {
+		if params.Hooks == nil {
+			params.Hooks = make(map[string]string)
+		}
+		params.Hooks[name] = command
+	}
+}
+
+// WithHooks sets the shell commands associated to lifecycle hooks
+func WithHooks(hooks map[string]string) func(params *Params) {
+	return func(params *Params) {
+		params.Hooks = hooks
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package retro
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+const noChartData = "_No data available_"
+
+// Colors used for chart series
+const (
+	passColor     = "#2da44e"
+	failColor     = "#cf222e"
+	srcColor      = "#0969da"
+	testColor     = "#8250df"
+	durationColor = "#bf8700"
+)
+
+// SVG chart dimensions, in pixels
+const (
+	svgWidth  = 640
+	svgHeight = 240
+	svgMargin = 40
+)
+
+// series is a named sequence of values, one value per commit
+type series struct {
+	name   string
+	color  string
+	values []float64
+}
+
+// mermaidChart returns a Mermaid XY line chart block showing the provided
+// series values over commits
+func mermaidChart(title string, yLabel string, data []series) string {
+	if isEmpty(data) {
+		return noChartData
+	}
+	var sb strings.Builder
+	sb.WriteString("```mermaid\n")
+	sb.WriteString("xychart-beta\n")
+	_, _ = fmt.Fprintf(&sb, "    title \"%s\"\n", title)
+	_, _ = fmt.Fprintf(&sb, "    x-axis \"commits\" 1 --> %d\n", len(data[0].values))
+	_, _ = fmt.Fprintf(&sb, "    y-axis \"%s\"\n", yLabel)
+	for _, s := range data {
+		_, _ = fmt.Fprintf(&sb, "    line [%s]\n", formatValues(s.values, ", "))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// svgChart returns an inline SVG line chart showing the provided
+// series values over commits
+func svgChart(title string, data []series) string {
+	if isEmpty(data) {
+		return "<p><em>No data available</em></p>"
+	}
+	maxValue := 0.0
+	for _, s := range data {
+		for _, v := range s.values {
+			maxValue = max(maxValue, v)
+		}
+	}
+	if maxValue == 0 {
+		maxValue = 1
+	}
+	plotWidth := float64(svgWidth - 2*svgMargin)
+	plotHeight := float64(svgHeight - 2*svgMargin)
+
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" role="img">`,
+		svgWidth, svgHeight, svgWidth, svgHeight)
+	_, _ = fmt.Fprintf(&sb, `<title>%s</title>`, html.EscapeString(title))
+	_, _ = fmt.Fprintf(&sb, `<text x="%d" y="%d" font-size="14" font-weight="bold">%s</text>`,
+		svgMargin, svgMargin/2, html.EscapeString(title))
+	_, _ = fmt.Fprintf(&sb, `<polyline points="%d,%d %d,%d %d,%d" fill="none" stroke="#57606a"/>`,
+		svgMargin, svgMargin, svgMargin, svgHeight-svgMargin, svgWidth-svgMargin, svgHeight-svgMargin)
+	_, _ = fmt.Fprintf(&sb, `<text x="%d" y="%d" font-size="10" text-anchor="end">%s</text>`,
+		svgMargin-4, svgMargin+4, formatValue(maxValue))
+	_, _ = fmt.Fprintf(&sb, `<text x="%d" y="%d" font-size="10" text-anchor="end">0</text>`,
+		svgMargin-4, svgHeight-svgMargin)
+	for i, s := range data {
+		points := make([]string, len(s.values))
+		for j, v := range s.values {
+			x := float64(svgMargin) + plotWidth/2
+			if len(s.values) > 1 {
+				x = float64(svgMargin) + plotWidth*float64(j)/float64(len(s.values)-1)
+			}
+			y := float64(svgHeight-svgMargin) - plotHeight*v/maxValue
+			points[j] = formatCoordinate(x) + "," + formatCoordinate(y)
+		}
+		_, _ = fmt.Fprintf(&sb, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"/>`,
+			strings.Join(points, " "), s.color)
+		_, _ = fmt.Fprintf(&sb, `<text x="%d" y="%d" font-size="12" fill="%s">%s</text>`,
+			svgMargin+i*100, svgHeight-svgMargin/4, s.color, html.EscapeString(s.name))
+	}
+	sb.WriteString(`</svg>`)
+	return sb.String()
+}
+
+func isEmpty(data []series) bool {
+	return len(data) == 0 || len(data[0].values) == 0
+}
+
+func formatValues(values []float64, sep string) string {
+	formatted := make([]string, len(values))
+	for i, v := range values {
+		formatted[i] = formatValue(v)
+	}
+	return strings.Join(formatted, sep)
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatCoordinate(v float64) string {
+	return strconv.FormatFloat(v, 'f', 1, 64)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package retro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_mermaid_chart(t *testing.T) {
+	data := []series{
+		{name: "a", values: []float64{1, 2.5}},
+		{name: "b", values: []float64{0, 3}},
+	}
+	expected := "```mermaid\n" +
+		"xychart-beta\n" +
+		"    title \"Title\"\n" +
+		"    x-axis \"commits\" 1 --> 2\n" +
+		"    y-axis \"unit\"\n" +
+		"    line [1, 2.5]\n" +
+		"    line [0, 3]\n" +
+		"```"
+	assert.Equal(t, expected, mermaidChart("Title", "unit", data))
+}
+
+func Test_mermaid_chart_with_no_data(t *testing.T) {
+	assert.Equal(t, noChartData, mermaidChart("Title", "unit", []series{{name: "a"}}))
+}
+
+func Test_svg_chart(t *testing.T) {
+	data := []series{{name: "a<b", color: "#000000", values: []float64{0, 4}}}
+	svg := svgChart("Title", data)
+	assert.Contains(t, svg, "<title>Title</title>")
+	assert.Contains(t, svg, `<polyline points="40.0,200.0 600.0,40.0" fill="none" stroke="#000000" stroke-width="2"/>`)
+	assert.Contains(t, svg, ">a&lt;b</text>")
+	assert.Contains(t, svg, ">4</text>")
+}
+
+func Test_svg_chart_with_single_value(t *testing.T) {
+	svg := svgChart("Title", []series{{name: "a", values: []float64{0}}})
+	assert.Contains(t, svg, `<polyline points="320.0,200.0"`)
+}
+
+func Test_largest_commits(t *testing.T) {
+	commits := []Commit{{Status: "a"}, {Status: "b"}, {Status: "c"}}
+	commits[0].Changes.Src = 1
+	commits[1].Changes.Test = 5
+	commits[2].Changes.Src = 3
+	largest := largestCommits(commits, 2)
+	assert.Len(t, largest, 2)
+	assert.Equal(t, "b", string(largest[0].Status))
+	assert.Equal(t, "c", string(largest[1].Status))
+}
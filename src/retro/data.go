@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package retro
+
+import (
+	"cmp"
+	htmltemplate "html/template"
+	"slices"
+	"time"
+
+	"github.com/murex/tcr/events"
+)
+
+// largestCommitsCount is the maximum number of commits listed in the largest commits section
+const largestCommitsCount = 5
+
+// Commit contains the information related to one commit, as presented in the retrospective
+type Commit struct {
+	Timestamp time.Time
+	Status    events.CommandStatus
+	Changes   events.ChangedLines
+	Tests     events.TestStats
+}
+
+// Charts contains the charts illustrating the evolution of TCR metrics during the session.
+// Charts are Mermaid diagram blocks in markdown format, and inline SVG images in HTML format
+type Charts struct {
+	Tests        any
+	LineChanges  any
+	TestDuration any
+}
+
+// Data contains all the information made available to retrospective templates
+type Data struct {
+	Title              string
+	Date               string
+	GreenAvg           any
+	RedAvg             any
+	Commits            int
+	PassingCommits     events.ValueAndRatio
+	FailingCommits     events.ValueAndRatio
+	TimeSpan           time.Duration
+	TimeInGreen        events.ValueAndRatio
+	TimeInRed          events.ValueAndRatio
+	TimeBetweenCommits events.Aggregates
+	SrcLineChanges     events.Aggregates
+	TestLineChanges    events.Aggregates
+	AllLineChanges     events.Aggregates
+	PassingTests       events.ValueEvolution
+	FailingTests       events.ValueEvolution
+	SkippedTests       events.ValueEvolution
+	TestDuration       events.ValueEvolution
+	LargestCommits     []Commit
+	Charts             Charts
+}
+
+// newData gathers retrospective data from the provided TCR events
+func newData(repoName string, tcrEvents *events.TcrEvents, format Format) Data {
+	d := time.Now().UTC()
+	if tcrEvents.Len() != 0 {
+		d = tcrEvents.EndingTime()
+	}
+	commits := sortedCommits(tcrEvents)
+	return Data{
+		Title:              repoName,
+		Date:               d.Format("2006/01/02"),
+		GreenAvg:           tcrEvents.AllLineChangesPerGreenCommit().Avg(),
+		RedAvg:             tcrEvents.AllLineChangesPerRedCommit().Avg(),
+		Commits:            tcrEvents.NbRecords(),
+		PassingCommits:     tcrEvents.PassingRecords(),
+		FailingCommits:     tcrEvents.FailingRecords(),
+		TimeSpan:           tcrEvents.TimeSpan(),
+		TimeInGreen:        tcrEvents.DurationInGreen(),
+		TimeInRed:          tcrEvents.DurationInRed(),
+		TimeBetweenCommits: tcrEvents.TimeBetweenCommits(),
+		SrcLineChanges:     tcrEvents.SrcLineChangesPerCommit(),
+		TestLineChanges:    tcrEvents.TestLineChangesPerCommit(),
+		AllLineChanges:     tcrEvents.AllLineChangesPerCommit(),
+		PassingTests:       tcrEvents.PassingTestsEvolution(),
+		FailingTests:       tcrEvents.FailingTestsEvolution(),
+		SkippedTests:       tcrEvents.SkippedTestsEvolution(),
+		TestDuration:       tcrEvents.TestDurationEvolution(),
+		LargestCommits:     largestCommits(commits, largestCommitsCount),
+		Charts:             newCharts(commits, format),
+	}
+}
+
+// sortedCommits returns the commits corresponding to the provided TCR events,
+// sorted from the oldest to the most recent one
+func sortedCommits(tcrEvents *events.TcrEvents) []Commit {
+	commits := make([]Commit, 0, tcrEvents.Len())
+	for _, e := range *tcrEvents {
+		commits = append(commits, Commit{
+			Timestamp: e.Timestamp,
+			Status:    e.Event.Status,
+			Changes:   e.Event.Changes,
+			Tests:     e.Event.Tests,
+		})
+	}
+	slices.SortStableFunc(commits, func(a, b Commit) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	return commits
+}
+
+// largestCommits returns up to count commits with the largest number of changed lines,
+// from the largest to the smallest one
+func largestCommits(commits []Commit, count int) []Commit {
+	largest := slices.Clone(commits)
+	slices.SortStableFunc(largest, func(a, b Commit) int {
+		return cmp.Compare(b.Changes.All(), a.Changes.All())
+	})
+	return largest[:min(count, len(largest))]
+}
+
+// newCharts builds the charts for the provided commits, in the provided format
+func newCharts(commits []Commit, format Format) Charts {
+	tests := []series{{name: "passing", color: passColor}, {name: "failing", color: failColor}}
+	changes := []series{{name: "src", color: srcColor}, {name: "test", color: testColor}}
+	duration := []series{{name: "seconds", color: durationColor}}
+	for _, c := range commits {
+		tests[0].values = append(tests[0].values, float64(c.Tests.Passed))
+		tests[1].values = append(tests[1].values, float64(c.Tests.Failed))
+		changes[0].values = append(changes[0].values, float64(c.Changes.Src))
+		changes[1].values = append(changes[1].values, float64(c.Changes.Test))
+		duration[0].values = append(duration[0].values, c.Tests.Duration.Seconds())
+	}
+	if format == HTML {
+		return Charts{
+			Tests:        htmltemplate.HTML(svgChart("Tests", tests)),            //nolint:gosec
+			LineChanges:  htmltemplate.HTML(svgChart("Changed lines", changes)),  //nolint:gosec
+			TestDuration: htmltemplate.HTML(svgChart("Test duration", duration)), //nolint:gosec
+		}
+	}
+	return Charts{
+		Tests:        mermaidChart("Tests", "tests", tests),
+		LineChanges:  mermaidChart("Changed lines", "lines", changes),
+		TestDuration: mermaidChart("Test duration", "seconds", duration),
+	}
+}
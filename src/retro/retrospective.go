@@ -25,35 +25,153 @@ package retro
 import (
 	"bytes"
 	_ "embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"text/template"
-	"time"
 
 	"github.com/murex/tcr/events"
 )
 
+// UnsupportedFormatError is returned when the provided retrospective format name is not supported.
+type UnsupportedFormatError struct {
+	formatName string
+}
+
+// Error returns the error description
+func (e *UnsupportedFormatError) Error() string {
+	return fmt.Sprintf("retrospective format not supported: \"%s\"", e.formatName)
+}
+
+// Format is the format of the generated retrospective
+type Format string
+
+// List of possible values for Format
+const (
+	Markdown Format = "markdown"
+	HTML     Format = "html"
+)
+
+// SelectFormat returns the retrospective format with the provided name.
+// An empty name or "text" selects markdown format.
+// It returns an UnsupportedFormatError if the name is not recognized.
+func SelectFormat(name string) (Format, error) {
+	switch strings.ToLower(name) {
+	case "", "text", "md", string(Markdown):
+		return Markdown, nil
+	case string(HTML):
+		return HTML, nil
+	default:
+		return "", &UnsupportedFormatError{name}
+	}
+}
+
+// FileName returns the default name of the retrospective file for the provided format
+func (f Format) FileName() string {
+	if f == HTML {
+		return "tcr-retro.html"
+	}
+	return "tcr-retro.md"
+}
+
 //go:embed template/retro.md
 var retroTemplate string
 
-// GenerateMarkdown builds retrospective markdown contents
-func GenerateMarkdown(repoName string, tcrEvents *events.TcrEvents) string {
-	t := template.New("retro")
-	t, _ = t.Parse(retroTemplate)
+//go:embed template/retro.html
+var retroHTMLTemplate string
 
-	d := time.Now().UTC()
-	if tcrEvents.Len() != 0 {
-		d = tcrEvents.EndingTime()
+const templateDirName = "retro"
+
+var templateDirPath string
+
+// InitConfig initializes the location where user-supplied retrospective templates
+// are looked for, under the provided configuration directory. When this directory
+// contains a "retro.md" or "retro.html" file, it is used instead of the built-in template
+// for the corresponding format
+func InitConfig(configDirPath string) {
+	if configDirPath == "" {
+		templateDirPath = ""
+		return
 	}
-	date := d.Format("2006/01/02")
+	templateDirPath = filepath.Join(configDirPath, templateDirName)
+}
 
-	greenAvg := tcrEvents.AllLineChangesPerGreenCommit().Avg()
-	redAvg := tcrEvents.AllLineChangesPerRedCommit().Avg()
+// GetTemplateDirPath returns the path to the directory containing user-supplied
+// retrospective templates. Returns an empty string when the location is not initialized
+func GetTemplateDirPath() string {
+	return templateDirPath
+}
 
+// GenerateMarkdown builds retrospective markdown contents using the built-in template
+func GenerateMarkdown(repoName string, tcrEvents *events.TcrEvents) string {
 	buf := new(bytes.Buffer)
-	_ = t.Execute(buf, struct {
-		Title    string
-		Date     string
-		GreenAvg any
-		RedAvg   any
-	}{repoName, date, greenAvg, redAvg})
+	_ = execute(buf, Markdown, retroTemplate, newData(repoName, tcrEvents, Markdown))
 	return buf.String()
 }
+
+// Generate builds retrospective contents in the provided format. When templatePath
+// is not empty, the template at this location is used. Otherwise the template found
+// in the configuration directory is used if any, or the built-in template if none
+func Generate(format Format, templatePath string, repoName string, tcrEvents *events.TcrEvents) (string, error) {
+	text, err := loadTemplate(format, templatePath)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	if err = execute(buf, format, text, newData(repoName, tcrEvents, format)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// loadTemplate returns the text of the template to be used for the provided format
+func loadTemplate(format Format, templatePath string) (string, error) {
+	if templatePath != "" {
+		content, err := os.ReadFile(templatePath) //nolint:gosec
+		if err != nil {
+			return "", fmt.Errorf("cannot read retrospective template: %w", err)
+		}
+		return string(content), nil
+	}
+	if templateDirPath != "" {
+		content, err := os.ReadFile(filepath.Join(templateDirPath, "retro"+templateExtension(format)))
+		if err == nil {
+			return string(content), nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("cannot read retrospective template: %w", err)
+		}
+	}
+	if format == HTML {
+		return retroHTMLTemplate, nil
+	}
+	return retroTemplate, nil
+}
+
+func templateExtension(format Format) string {
+	if format == HTML {
+		return ".html"
+	}
+	return ".md"
+}
+
+// execute applies the template text to the provided data. HTML templates
+// are processed with html/template so that data contents get escaped
+func execute(w io.Writer, format Format, text string, data Data) error {
+	if format == HTML {
+		t, err := htmltemplate.New("retro").Parse(text)
+		if err != nil {
+			return fmt.Errorf("invalid retrospective template: %w", err)
+		}
+		return t.Execute(w, data)
+	}
+	t, err := template.New("retro").Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid retrospective template: %w", err)
+	}
+	return t.Execute(w, data)
+}
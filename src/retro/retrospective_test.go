@@ -23,6 +23,8 @@ SOFTWARE.
 package retro
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -79,3 +81,108 @@ func Test_include_the_repo_name_in_generated_md(t *testing.T) {
 	md := GenerateMarkdown(repo, &tcrEvents)
 	assert.Contains(t, md, "## "+repo)
 }
+
+func Test_select_retrospective_format(t *testing.T) {
+	testFlags := []struct {
+		name        string
+		expected    Format
+		expectError bool
+	}{
+		{"", Markdown, false},
+		{"text", Markdown, false},
+		{"markdown", Markdown, false},
+		{"HTML", HTML, false},
+		{"json", "", true},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := SelectFormat(tt.name)
+			assert.Equal(t, tt.expected, format)
+			assert.Equal(t, tt.expectError, err != nil)
+		})
+	}
+}
+
+func Test_retrospective_file_name(t *testing.T) {
+	assert.Equal(t, "tcr-retro.md", Markdown.FileName())
+	assert.Equal(t, "tcr-retro.html", HTML.FileName())
+}
+
+func Test_include_all_tcr_metrics_in_generated_md(t *testing.T) {
+	d := time.Date(2022, 9, 22, 11, 0, 0, 0, time.UTC)
+	tcrEvents := e.TcrEvents{
+		*e.ADatedTcrEvent(e.WithTimestamp(d), e.WithTcrEvent(*e.ATcrEvent(
+			e.WithCommandStatus(e.StatusPass), e.WithModifiedSrcLines(3), e.WithTotalTestsRun(2),
+			e.WithTestsPassed(2)))),
+		*e.ADatedTcrEvent(e.WithTimestamp(d.Add(2*time.Minute)), e.WithTcrEvent(*e.ATcrEvent(
+			e.WithCommandStatus(e.StatusFail), e.WithModifiedSrcLines(12), e.WithTotalTestsRun(3),
+			e.WithTestsPassed(2), e.WithTestsFailed(1)))),
+	}
+	md, err := Generate(Markdown, "", "", &tcrEvents)
+	assert.NoError(t, err)
+	assert.Contains(t, md, "| Number of commits | 2 |")
+	assert.Contains(t, md, "| Time in green | 2m0s (100%) |")
+	assert.Contains(t, md, "| Failing tests | 0 → 1 |")
+	assert.Contains(t, md, "| 2022/09/22 11:02:00 | fail | 12 | 0 |\n| 2022/09/22 11:00:00 | pass | 3 | 0 |")
+	assert.Contains(t, md, "```mermaid\nxychart-beta\n    title \"Tests\"")
+	assert.Contains(t, md, "    line [2, 2]\n    line [0, 1]\n")
+}
+
+func Test_generate_retrospective_html(t *testing.T) {
+	repo := "<repo>"
+	tcrEvents := e.TcrEvents{*e.ADatedTcrEvent()}
+	html, err := Generate(HTML, "", repo, &tcrEvents)
+	assert.NoError(t, err)
+	assert.Contains(t, html, "<h1>Quick Retrospective</h1>")
+	assert.Contains(t, html, "<h2>&lt;repo&gt;</h2>")
+	assert.Contains(t, html, "<svg xmlns=\"http://www.w3.org/2000/svg\"")
+}
+
+func Test_generate_retrospective_with_template_file(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "my-retro.md")
+	assert.NoError(t, os.WriteFile(path, []byte("Retro for {{.Title}}: {{.Commits}} commit(s)"), 0600))
+	tcrEvents := e.TcrEvents{*e.ADatedTcrEvent()}
+	md, err := Generate(Markdown, path, "repo", &tcrEvents)
+	assert.NoError(t, err)
+	assert.Equal(t, "Retro for repo: 1 commit(s)", md)
+}
+
+func Test_generate_retrospective_with_template_in_config_dir(t *testing.T) {
+	configDir := t.TempDir()
+	InitConfig(configDir)
+	t.Cleanup(func() { InitConfig("") })
+	assert.NoError(t, os.MkdirAll(GetTemplateDirPath(), 0750))
+	assert.NoError(t, os.WriteFile(filepath.Join(GetTemplateDirPath(), "retro.html"),
+		[]byte("<p>{{.Title}}</p>"), 0600))
+
+	html, err := Generate(HTML, "", "repo", &e.TcrEvents{})
+	assert.NoError(t, err)
+	assert.Equal(t, "<p>repo</p>", html)
+
+	md, err := Generate(Markdown, "", "repo", &e.TcrEvents{})
+	assert.NoError(t, err)
+	assert.Contains(t, md, "# Quick Retrospective")
+}
+
+func Test_generate_retrospective_with_invalid_template(t *testing.T) {
+	testFlags := []struct {
+		desc    string
+		content string
+	}{
+		{"unparsable template", "{{.Title"},
+		{"unknown field", "{{.Unknown}}"},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "retro.md")
+			assert.NoError(t, os.WriteFile(path, []byte(tt.content), 0600))
+			_, err := Generate(Markdown, path, "", &e.TcrEvents{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_generate_retrospective_with_missing_template_file(t *testing.T) {
+	_, err := Generate(Markdown, filepath.Join(t.TempDir(), "missing.md"), "", &e.TcrEvents{})
+	assert.Error(t, err)
+}
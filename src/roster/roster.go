@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package roster
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Member is a mob participant listed in the roster
+type Member struct {
+	Name    string
+	Email   string
+	Skipped bool
+}
+
+// ParseMember creates a roster member from its text representation, which
+// can be either a name, or a name followed by an e-mail address between
+// angle brackets (ex: "John Doe <john.doe@example.com>")
+func ParseMember(s string) Member {
+	name, rest, found := strings.Cut(s, "<")
+	if !found {
+		return Member{Name: strings.TrimSpace(s)}
+	}
+	email, _, _ := strings.Cut(rest, ">")
+	return Member{Name: strings.TrimSpace(name), Email: strings.TrimSpace(email)}
+}
+
+// String returns the text representation of the member
+func (m Member) String() string {
+	if m.Email == "" {
+		return m.Name
+	}
+	return fmt.Sprintf("%s <%s>", m.Name, m.Email)
+}
+
+// CoAuthorTrailer returns the commit message trailer recording the member
+// as co-author of a commit. Members without e-mail address are recorded
+// with a TCR-specific trailer, as VCS co-author trailers require one
+func (m Member) CoAuthorTrailer() string {
+	if m.Email == "" {
+		return "TCR-Driver: " + m.Name
+	}
+	return "Co-authored-by: " + m.String()
+}
+
+// Roster keeps track of mob participants and of their driver turns.
+// Skipped members are ignored when rotating the driver role
+type Roster struct {
+	mutex   sync.RWMutex
+	members []Member
+	driver  int
+}
+
+// New creates a new roster from the provided member text representations.
+// Empty and duplicate entries are ignored. The first member is the driver
+func New(members ...string) *Roster {
+	r := Roster{}
+	for _, s := range members {
+		m := ParseMember(s)
+		if m.Name != "" && r.indexOf(m.Name) < 0 {
+			r.members = append(r.members, m)
+		}
+	}
+	return &r
+}
+
+// IsEmpty indicates whether the roster contains no member
+func (r *Roster) IsEmpty() bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return len(r.members) == 0
+}
+
+// Members returns the roster members, in their rotation order
+func (r *Roster) Members() []Member {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return slices.Clone(r.members)
+}
+
+// Driver returns the member currently holding the driver role.
+// The returned boolean is false when the roster is empty
+func (r *Roster) Driver() (Member, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if len(r.members) == 0 {
+		return Member{}, false
+	}
+	return r.members[r.driver], true
+}
+
+// NextDriver returns the member who will hold the driver role after
+// the current driver. The returned boolean is false when there is
+// no other member available
+func (r *Roster) NextDriver() (Member, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	next := r.next()
+	if next < 0 {
+		return Member{}, false
+	}
+	return r.members[next], true
+}
+
+// Rotate hands over the driver role to the next member and returns it.
+// The returned boolean is false when there is no other member available
+func (r *Roster) Rotate() (Member, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	next := r.next()
+	if next < 0 {
+		return Member{}, false
+	}
+	r.driver = next
+	return r.members[next], true
+}
+
+// SetDriver hands over the driver role to the member with the provided name
+func (r *Roster) SetDriver(name string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	i := r.indexOf(name)
+	if i < 0 {
+		return unknownMemberError(name)
+	}
+	r.members[i].Skipped = false
+	r.driver = i
+	return nil
+}
+
+// Skip sets whether the member with the provided name should be skipped
+// when rotating. Skipping the current driver hands over the driver role
+// to the next member
+func (r *Roster) Skip(name string, skipped bool) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	i := r.indexOf(name)
+	if i < 0 {
+		return unknownMemberError(name)
+	}
+	r.members[i].Skipped = skipped
+	if skipped && i == r.driver {
+		if next := r.next(); next >= 0 {
+			r.driver = next
+		}
+	}
+	return nil
+}
+
+// Reorder changes the rotation order of the roster members. The provided
+// names must match roster members exactly. The current driver is unchanged
+func (r *Roster) Reorder(names []string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(names) != len(r.members) {
+		return fmt.Errorf("expecting %d roster members, got %d", len(r.members), len(names))
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	reordered := make([]Member, 0, len(names))
+	for _, name := range names {
+		i := r.indexOf(name)
+		if i < 0 {
+			return unknownMemberError(name)
+		}
+		if slices.ContainsFunc(reordered, func(m Member) bool { return m.Name == name }) {
+			return fmt.Errorf("duplicate roster member: %s", name)
+		}
+		reordered = append(reordered, r.members[i])
+	}
+	driver := r.members[r.driver].Name
+	r.members = reordered
+	r.driver = r.indexOf(driver)
+	return nil
+}
+
+// next returns the index of the first non-skipped member after the current
+// driver, or -1 if there is none
+func (r *Roster) next() int {
+	for offset := 1; offset < len(r.members); offset++ {
+		i := (r.driver + offset) % len(r.members)
+		if !r.members[i].Skipped {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *Roster) indexOf(name string) int {
+	return slices.IndexFunc(r.members, func(m Member) bool { return m.Name == name })
+}
+
+func unknownMemberError(name string) error {
+	return fmt.Errorf("unknown roster member: %s", name)
+}
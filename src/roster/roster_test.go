@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package roster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parse_member(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Member
+	}{
+		{"John", Member{Name: "John"}},
+		{" John Doe ", Member{Name: "John Doe"}},
+		{"John Doe <john@example.com>", Member{Name: "John Doe", Email: "john@example.com"}},
+		{"John Doe<john@example.com", Member{Name: "John Doe", Email: "john@example.com"}},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.expected, ParseMember(test.input))
+		})
+	}
+}
+
+func Test_member_co_author_trailer(t *testing.T) {
+	assert.Equal(t, "Co-authored-by: John Doe <john@example.com>",
+		Member{Name: "John Doe", Email: "john@example.com"}.CoAuthorTrailer())
+	assert.Equal(t, "TCR-Driver: John Doe", Member{Name: "John Doe"}.CoAuthorTrailer())
+}
+
+func Test_new_roster_ignores_empty_and_duplicate_members(t *testing.T) {
+	r := New("alice", "", "bob", "alice <alice@example.com>")
+	assert.Equal(t, []Member{{Name: "alice"}, {Name: "bob"}}, r.Members())
+}
+
+func Test_empty_roster(t *testing.T) {
+	r := New()
+	assert.True(t, r.IsEmpty())
+	_, ok := r.Driver()
+	assert.False(t, ok)
+	_, ok = r.NextDriver()
+	assert.False(t, ok)
+	_, ok = r.Rotate()
+	assert.False(t, ok)
+	assert.NoError(t, r.Reorder(nil))
+}
+
+func Test_first_member_is_the_initial_driver(t *testing.T) {
+	driver, ok := New("alice", "bob").Driver()
+	assert.True(t, ok)
+	assert.Equal(t, "alice", driver.Name)
+}
+
+func Test_rotating_the_driver_role(t *testing.T) {
+	r := New("alice", "bob", "carol")
+	var drivers []string
+	for range 4 {
+		next, _ := r.NextDriver()
+		driver, ok := r.Rotate()
+		assert.True(t, ok)
+		assert.Equal(t, next, driver)
+		drivers = append(drivers, driver.Name)
+	}
+	assert.Equal(t, []string{"bob", "carol", "alice", "bob"}, drivers)
+}
+
+func Test_rotating_with_a_single_member(t *testing.T) {
+	r := New("alice")
+	_, ok := r.Rotate()
+	assert.False(t, ok)
+	driver, _ := r.Driver()
+	assert.Equal(t, "alice", driver.Name)
+}
+
+func Test_skipped_members_are_ignored_when_rotating(t *testing.T) {
+	r := New("alice", "bob", "carol")
+	assert.NoError(t, r.Skip("bob", true))
+	next, _ := r.NextDriver()
+	assert.Equal(t, "carol", next.Name)
+	assert.NoError(t, r.Skip("bob", false))
+	next, _ = r.NextDriver()
+	assert.Equal(t, "bob", next.Name)
+}
+
+func Test_skipping_the_current_driver_hands_over_the_driver_role(t *testing.T) {
+	r := New("alice", "bob")
+	assert.NoError(t, r.Skip("alice", true))
+	driver, _ := r.Driver()
+	assert.Equal(t, "bob", driver.Name)
+}
+
+func Test_setting_the_driver(t *testing.T) {
+	r := New("alice", "bob", "carol")
+	assert.NoError(t, r.Skip("carol", true))
+	assert.NoError(t, r.SetDriver("carol"))
+	driver, _ := r.Driver()
+	assert.Equal(t, Member{Name: "carol"}, driver)
+	assert.Error(t, r.SetDriver("dave"))
+	assert.Error(t, r.Skip("dave", true))
+}
+
+func Test_reordering_members(t *testing.T) {
+	r := New("alice", "bob", "carol")
+	_, _ = r.Rotate()
+	assert.NoError(t, r.Reorder([]string{"carol", "bob", "alice"}))
+	driver, _ := r.Driver()
+	assert.Equal(t, "bob", driver.Name)
+	next, _ := r.NextDriver()
+	assert.Equal(t, "alice", next.Name)
+}
+
+func Test_reordering_members_with_invalid_names(t *testing.T) {
+	tests := []struct {
+		desc  string
+		names []string
+	}{
+		{"missing member", []string{"alice", "bob"}},
+		{"unknown member", []string{"alice", "bob", "dave"}},
+		{"duplicate member", []string{"alice", "bob", "bob"}},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			r := New("alice", "bob", "carol")
+			assert.Error(t, r.Reorder(test.names))
+			assert.Equal(t, []Member{{Name: "alice"}, {Name: "bob"}, {Name: "carol"}}, r.Members())
+		})
+	}
+}
@@ -32,7 +32,7 @@ type RunMode interface {
 }
 
 var (
-	allModes = []RunMode{Mob{}, Solo{}, OneShot{}, Check{}, Log{}, Stats{}, Retro{}}
+	allModes = []RunMode{Mob{}, Solo{}, OneShot{}, Check{}, Log{}, Stats{}, Retro{}, Recover{}, Mutate{}}
 )
 
 // InteractiveModes returns the list of names of available interactive run modes
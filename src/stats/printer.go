@@ -27,7 +27,9 @@ import (
 	"time"
 
 	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/journal"
 	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/role"
 )
 
 // Print prints all TCR stats for the provided list of TCR events.
@@ -52,6 +54,21 @@ func Print(branch string, tcrEvents events.TcrEvents) {
 	printStatEvolution("Test execution duration", tcrEvents.TestDurationEvolution())
 }
 
+// PrintJournal prints all TCR stats for the provided session journal entries,
+// including the ones that cannot be retrieved from VCS history
+func PrintJournal(branch string, entries journal.Entries) {
+	Print(branch, entries.ToTcrEvents())
+	builds := entries.Count(journal.Build)
+	tests := entries.Count(journal.Test)
+	printStat("Number of builds", builds)
+	printStatValueAndRatio("Failing builds",
+		events.NewIntValueAndRatio(entries.Count(journal.Build, events.StatusFail), builds))
+	printStatValueAndRatio("Reverts",
+		events.NewIntValueAndRatio(entries.Count(journal.Revert), tests))
+	printStat("Aborted commands", entries.Count(journal.Abort))
+	printStat("Driver turns", entries.CountRoleStarts(role.Driver{}.Name()))
+}
+
 func printStatEvolution(name string, stat events.ValueEvolution) {
 	// printStat(name, "from ", stat.From(), " to ", stat.To())
 	printStat(name, stat.From(), " --> ", stat.To())
@@ -27,7 +27,9 @@ import (
 	"time"
 
 	"github.com/murex/tcr/events"
+	"github.com/murex/tcr/journal"
 	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/role"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -226,3 +228,37 @@ func Test_print_all_stats(t *testing.T) {
 		assert.Equal(t, expected, result)
 	})
 }
+
+func Test_print_journal_specific_stats(t *testing.T) {
+	entries := journal.Entries{
+		{Type: journal.RoleStart, Role: role.Driver{}.Name()},
+		{Type: journal.Build, Status: events.StatusFail},
+		{Type: journal.Build, Status: events.StatusPass},
+		{Type: journal.Test, Status: events.StatusFail},
+		{Type: journal.Revert, Files: 1},
+		{Type: journal.Build, Status: events.StatusPass},
+		{Type: journal.Test, Status: events.StatusPass},
+		{Type: journal.Commit, Status: events.StatusPass},
+		{Type: journal.Abort},
+		{Type: journal.RoleEnd, Role: role.Driver{}.Name()},
+		{Type: journal.RoleStart, Role: role.Navigator{}.Name()},
+	}
+	expected := []string{
+		"- Number of builds:          3",
+		"- Failing builds:            1 (33%)",
+		"- Reverts:                   1 (50%)",
+		"- Aborted commands:          1",
+		"- Driver turns:              1",
+	}
+	report.TestWithIsolatedReporter(func(reporter *report.Reporter, sniffer *report.Sniffer) {
+		PrintJournal("some-branch", entries)
+		time.Sleep(1 * time.Millisecond)
+		sniffer.Stop()
+
+		var result []string
+		for _, line := range sniffer.GetAllMatches() {
+			result = append(result, line.Payload.ToString())
+		}
+		assert.Equal(t, expected, result[len(result)-len(expected):])
+	})
+}
@@ -33,12 +33,20 @@ import (
 // NewMobTurnCountdown creates a PeriodicReminder that starts when entering driver mode, and
 // then sends a countdown message periodically until the driver turn expires, after which it
 // sends a message notifying the end of driver's turn.
+// When provided, onTimeout is called once when driver's turn expires.
 // If the mode does not require a mob timer, this function returns nil
-func NewMobTurnCountdown(mode runmode.RunMode, timeout time.Duration) *PeriodicReminder {
+func NewMobTurnCountdown(mode runmode.RunMode, timeout time.Duration, onTimeout func()) *PeriodicReminder {
 	if !mode.IsMultiRole() {
 		return nil
 	}
 	tickPeriod := findBestTickPeriodFor(timeout)
+	timedOut := false
+	notifyTimeout := func() {
+		if onTimeout != nil && !timedOut {
+			timedOut = true
+			onTimeout()
+		}
+	}
 	return NewPeriodicReminder(timeout, tickPeriod,
 		func(ctx ReminderContext) {
 			switch ctx.eventType {
@@ -49,11 +57,13 @@ func NewMobTurnCountdown(mode runmode.RunMode, timeout time.Duration) *PeriodicR
 					reportTimerEvent(ctx, timer_event.TriggerCountdown, timeout)
 				} else {
 					reportTimerEvent(ctx, timer_event.TriggerTimeout, timeout)
+					notifyTimeout()
 				}
 			case interruptEvent:
 				reportTimerEvent(ctx, timer_event.TriggerStop, timeout)
 			case timeoutEvent:
 				reportTimerEvent(ctx, timer_event.TriggerTimeout, timeout)
+				notifyTimeout()
 			}
 		},
 	)
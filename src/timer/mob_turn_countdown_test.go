@@ -23,6 +23,7 @@ SOFTWARE.
 package timer
 
 import (
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -52,24 +53,24 @@ func Test_best_tick_period_for_timeout(t *testing.T) {
 }
 
 func Test_mob_turn_countdown_creation_in_mob_runmode(t *testing.T) {
-	assert.NotZero(t, NewMobTurnCountdown(runmode.Mob{}, defaultTimeout))
+	assert.NotZero(t, NewMobTurnCountdown(runmode.Mob{}, defaultTimeout, nil))
 }
 
 func Test_mob_turn_countdown_creation_in_solo_runmode(t *testing.T) {
-	assert.Zero(t, NewMobTurnCountdown(runmode.Solo{}, defaultTimeout))
+	assert.Zero(t, NewMobTurnCountdown(runmode.Solo{}, defaultTimeout, nil))
 }
 
 func Test_mob_turn_countdown_creation_in_check_runmode(t *testing.T) {
-	assert.Zero(t, NewMobTurnCountdown(runmode.Check{}, defaultTimeout))
+	assert.Zero(t, NewMobTurnCountdown(runmode.Check{}, defaultTimeout, nil))
 }
 
 func Test_mob_turn_countdown_creation_in_one_shot_runmode(t *testing.T) {
-	assert.Zero(t, NewMobTurnCountdown(runmode.OneShot{}, defaultTimeout))
+	assert.Zero(t, NewMobTurnCountdown(runmode.OneShot{}, defaultTimeout, nil))
 }
 
 func Test_mob_turn_count_down(t *testing.T) {
 	report.TestWithIsolatedReporter(func(reporter *report.Reporter, sniffer *report.Sniffer) {
-		reminder := NewMobTurnCountdown(runmode.Mob{}, 2*time.Second)
+		reminder := NewMobTurnCountdown(runmode.Mob{}, 2*time.Second, nil)
 		reminder.Start()
 		time.Sleep(3200 * time.Millisecond)
 		reminder.Stop()
@@ -95,3 +96,14 @@ func Test_mob_turn_count_down(t *testing.T) {
 		}
 	})
 }
+
+func Test_mob_turn_count_down_notifies_timeout_once(t *testing.T) {
+	var timeouts atomic.Int32
+	report.TestWithIsolatedReporter(func(_ *report.Reporter, _ *report.Sniffer) {
+		reminder := NewMobTurnCountdown(runmode.Mob{}, 1*time.Second, func() { timeouts.Add(1) })
+		reminder.Start()
+		time.Sleep(2200 * time.Millisecond)
+		reminder.Stop()
+	})
+	assert.Equal(t, int32(1), timeouts.Load())
+}
@@ -108,6 +108,22 @@ func Test_built_in_toolchain_test_command_args(t *testing.T) {
 	}
 }
 
+func Test_built_in_toolchain_lint_command(t *testing.T) {
+	for _, builtIn := range BuiltInTests {
+		t.Run(builtIn.Name, func(t *testing.T) {
+			assertLintCommand(t, builtIn.Name, builtIn.LintCommand)
+		})
+	}
+}
+
+func Test_built_in_toolchain_format_command(t *testing.T) {
+	for _, builtIn := range BuiltInTests {
+		t.Run(builtIn.Name, func(t *testing.T) {
+			assertFormatCommand(t, builtIn.Name, builtIn.FormatCommand)
+		})
+	}
+}
+
 func Test_built_in_toolchain_supported_platforms(t *testing.T) {
 	for _, builtIn := range BuiltInTests {
 		t.Run(builtIn.Name, func(t *testing.T) {
@@ -32,6 +32,8 @@ func init() {
 			BuildCommandArgs: []string{"test", "--test", "lib_test", "--no-run"},
 			TestCommandPath:  "cargo",
 			TestCommandArgs:  []string{"test", "--test", "lib_test"},
+			LintCommand:      []string{"cargo", "clippy", "--all-targets", "--", "-D", "warnings"},
+			FormatCommand:    []string{"cargo", "fmt"},
 			TestResultDir:    "target",
 		},
 	)
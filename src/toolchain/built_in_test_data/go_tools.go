@@ -31,7 +31,9 @@ func init() {
 			BuildCommandPath: "go",
 			BuildCommandArgs: []string{"test", "-count=0", "./..."},
 			TestCommandPath:  "go",
-			TestCommandArgs:  []string{"test", "-short", "./..."},
+			TestCommandArgs:  []string{"test", "-short", "{{.AffectedTestDirs}}"},
+			LintCommand:      []string{"golangci-lint", "run", "./..."},
+			FormatCommand:    []string{"gofmt", "-w", "."},
 			TestResultDir:    ".",
 		},
 	)
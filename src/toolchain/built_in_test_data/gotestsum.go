@@ -35,8 +35,10 @@ func init() {
 				"--format", "pkgname",
 				"--junitfile", "_test_results/output.xml",
 				"--",
-				"-short", "./...",
+				"-short", "{{.AffectedTestDirs}}",
 			},
+			LintCommand:   []string{"golangci-lint", "run", "./..."},
+			FormatCommand: []string{"gofmt", "-w", "."},
 			TestResultDir: "_test_results",
 		},
 	)
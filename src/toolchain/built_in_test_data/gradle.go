@@ -38,6 +38,8 @@ func init() {
 			BuildCommandArgs: gradleBuildCommandArgs,
 			TestCommandPath:  "gradle",
 			TestCommandArgs:  gradleTestCommandArgs,
+			LintCommand:      []string{"ktlint"},
+			FormatCommand:    []string{"ktlint", "--format"},
 			TestResultDir:    gradleTestResultDir,
 		},
 		BuiltInTestData{
@@ -46,6 +48,8 @@ func init() {
 			BuildCommandArgs: gradleBuildCommandArgs,
 			TestCommandPath:  gradleWrapperCommandPath,
 			TestCommandArgs:  gradleTestCommandArgs,
+			LintCommand:      []string{"ktlint"},
+			FormatCommand:    []string{"ktlint", "--format"},
 			TestResultDir:    gradleTestResultDir,
 		},
 	)
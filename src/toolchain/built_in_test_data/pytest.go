@@ -31,7 +31,9 @@ func init() {
 			BuildCommandPath: "pytest",
 			BuildCommandArgs: []string{"--collect-only"},
 			TestCommandPath:  "pytest",
-			TestCommandArgs:  []string{},
+			TestCommandArgs:  []string{"{{.AffectedTests}}"},
+			LintCommand:      []string{"ruff", "check", "."},
+			FormatCommand:    []string{"black", "."},
 			TestResultDir:    "pytest",
 		},
 	)
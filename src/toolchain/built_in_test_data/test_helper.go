@@ -31,7 +31,11 @@ type BuiltInTestData struct {
 	BuildCommandArgs []string
 	TestCommandPath  string
 	TestCommandArgs  []string
-	TestResultDir    string
+	// LintCommand and FormatCommand hold the command path followed by its arguments.
+	// They are nil when the toolchain has no such command
+	LintCommand   []string
+	FormatCommand []string
+	TestResultDir string
 }
 
 // BuiltInTests is the slice containing all built-in toolchain test data.
@@ -32,6 +32,7 @@ func init() {
 			BuildCommandArgs: []string{"jest", "--testNamePattern=DO-NOT-RUN-ANYTHING", "--silent"},
 			TestCommandPath:  "yarn",
 			TestCommandArgs:  []string{"jest"},
+			LintCommand:      []string{"yarn", "eslint", "."},
 			TestResultDir:    "test_results",
 		},
 	)
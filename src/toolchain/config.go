@@ -26,6 +26,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/murex/tcr/coverage"
 	"github.com/murex/tcr/helpers"
 	"github.com/murex/tcr/toolchain/command"
 )
@@ -47,12 +48,22 @@ type (
 		Arguments []string `yaml:"arguments,flow"`
 	}
 
+	// coverageReportConfigYAML defines the structure of a toolchain coverage report configuration.
+	coverageReportConfigYAML struct {
+		Format string `yaml:"format"`
+		Path   string `yaml:"path"`
+	}
+
 	// configYAML defines the structure of a toolchain configuration.
 	configYAML struct {
 		Name          string              `yaml:"-"`
 		BuildCommand  []commandConfigYAML `yaml:"build"`
 		TestCommand   []commandConfigYAML `yaml:"test"`
+		LintCommand   []commandConfigYAML `yaml:"lint,omitempty"`
+		FormatCommand []commandConfigYAML `yaml:"format,omitempty"`
 		TestResultDir string              `yaml:"test-result-dir"`
+		// CoverageReport is optional
+		CoverageReport *coverageReportConfigYAML `yaml:"coverage-report,omitempty"`
 	}
 )
 
@@ -106,12 +117,21 @@ func loadConfig(yamlFilename string) *configYAML {
 }
 
 func asToolchain(toolchainCfg configYAML) *Toolchain {
-	return New(
+	tchn := New(
 		toolchainCfg.Name,
 		asCommandTable(toolchainCfg.BuildCommand),
 		asCommandTable(toolchainCfg.TestCommand),
 		toolchainCfg.TestResultDir,
 	)
+	tchn.lintCommands = asCommandTable(toolchainCfg.LintCommand)
+	tchn.formatCommands = asCommandTable(toolchainCfg.FormatCommand)
+	if toolchainCfg.CoverageReport != nil {
+		tchn.coverageReport = coverage.Report{
+			Format: coverage.Format(toolchainCfg.CoverageReport.Format),
+			Path:   toolchainCfg.CoverageReport.Path,
+		}
+	}
+	return tchn
 }
 
 func asCommandTable(commandsCfg []commandConfigYAML) []command.Command {
@@ -158,12 +178,21 @@ func ResetConfigs() {
 }
 
 func asConfig(tchn TchnInterface) configYAML {
-	return configYAML{
+	cfg := configYAML{
 		Name:          tchn.GetName(),
 		BuildCommand:  asCommandConfigTable(tchn.GetBuildCommands()),
 		TestCommand:   asCommandConfigTable(tchn.GetTestCommands()),
+		LintCommand:   asCommandConfigTable(tchn.GetLintCommands()),
+		FormatCommand: asCommandConfigTable(tchn.GetFormatCommands()),
 		TestResultDir: tchn.GetTestResultDir(),
 	}
+	if coverageReport := tchn.GetCoverageReport(); coverageReport.IsSet() {
+		cfg.CoverageReport = &coverageReportConfigYAML{
+			Format: string(coverageReport.Format),
+			Path:   coverageReport.Path,
+		}
+	}
+	return cfg
 }
 
 func asCommandConfigTable(commands []command.Command) []commandConfigYAML {
@@ -232,7 +261,17 @@ func (t configYAML) show() {
 	for _, cmd := range t.TestCommand {
 		cmd.show(prefix + ".test")
 	}
+	for _, cmd := range t.LintCommand {
+		cmd.show(prefix + ".lint")
+	}
+	for _, cmd := range t.FormatCommand {
+		cmd.show(prefix + ".format")
+	}
 	helpers.TraceKeyValue(prefix+".test-result-dir", t.TestResultDir)
+	if t.CoverageReport != nil {
+		helpers.TraceKeyValue(prefix+".coverage-report.format", t.CoverageReport.Format)
+		helpers.TraceKeyValue(prefix+".coverage-report.path", t.CoverageReport.Path)
+	}
 }
 
 func (c commandConfigYAML) show(prefix string) {
@@ -27,6 +27,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/murex/tcr/coverage"
 	"github.com/murex/tcr/helpers"
 	"github.com/murex/tcr/toolchain/command"
 	"github.com/spf13/afero"
@@ -153,6 +154,102 @@ func Test_save_and_load_a_toolchain_config(t *testing.T) {
 	}
 }
 
+func Test_show_toolchain_config_with_lint_and_format_commands(t *testing.T) {
+	tchn := AToolchain(
+		WithLintCommand(command.ACommand(command.WithPath("lint-cmd"))),
+		WithFormatCommand(command.ACommand(command.WithPath("format-cmd"))),
+	)
+	cfg := asConfig(tchn)
+	prefix := "- toolchain." + cfg.Name
+	buildCmd := cfg.BuildCommand[0]
+	testCmd := cfg.TestCommand[0]
+	lintCmd := cfg.LintCommand[0]
+	formatCmd := cfg.FormatCommand[0]
+	expected := []string{
+		fmt.Sprintf("%v.build.os: %v", prefix, buildCmd.Os),
+		fmt.Sprintf("%v.build.arch: %v", prefix, buildCmd.Arch),
+		fmt.Sprintf("%v.build.command: %v", prefix, buildCmd.Command),
+		fmt.Sprintf("%v.build.args: %v", prefix, buildCmd.Arguments),
+		fmt.Sprintf("%v.test.os: %v", prefix, testCmd.Os),
+		fmt.Sprintf("%v.test.arch: %v", prefix, testCmd.Arch),
+		fmt.Sprintf("%v.test.command: %v", prefix, testCmd.Command),
+		fmt.Sprintf("%v.test.args: %v", prefix, testCmd.Arguments),
+		fmt.Sprintf("%v.lint.os: %v", prefix, lintCmd.Os),
+		fmt.Sprintf("%v.lint.arch: %v", prefix, lintCmd.Arch),
+		fmt.Sprintf("%v.lint.command: %v", prefix, "lint-cmd"),
+		fmt.Sprintf("%v.lint.args: %v", prefix, lintCmd.Arguments),
+		fmt.Sprintf("%v.format.os: %v", prefix, formatCmd.Os),
+		fmt.Sprintf("%v.format.arch: %v", prefix, formatCmd.Arch),
+		fmt.Sprintf("%v.format.command: %v", prefix, "format-cmd"),
+		fmt.Sprintf("%v.format.args: %v", prefix, formatCmd.Arguments),
+		fmt.Sprintf("%v.test-result-dir: %v", prefix, tchn.GetTestResultDir()),
+	}
+	helpers.AssertSimpleTrace(t, expected,
+		func() {
+			cfg.show()
+		},
+	)
+}
+
+func Test_show_toolchain_config_with_coverage_report(t *testing.T) {
+	tchn := AToolchain(WithCoverageReport(coverage.LCOV, "coverage/lcov.info"))
+	cfg := asConfig(tchn)
+	prefix := "- toolchain." + cfg.Name
+	buildCmd := cfg.BuildCommand[0]
+	testCmd := cfg.TestCommand[0]
+	expected := []string{
+		fmt.Sprintf("%v.build.os: %v", prefix, buildCmd.Os),
+		fmt.Sprintf("%v.build.arch: %v", prefix, buildCmd.Arch),
+		fmt.Sprintf("%v.build.command: %v", prefix, buildCmd.Command),
+		fmt.Sprintf("%v.build.args: %v", prefix, buildCmd.Arguments),
+		fmt.Sprintf("%v.test.os: %v", prefix, testCmd.Os),
+		fmt.Sprintf("%v.test.arch: %v", prefix, testCmd.Arch),
+		fmt.Sprintf("%v.test.command: %v", prefix, testCmd.Command),
+		fmt.Sprintf("%v.test.args: %v", prefix, testCmd.Arguments),
+		fmt.Sprintf("%v.test-result-dir: %v", prefix, tchn.GetTestResultDir()),
+		fmt.Sprintf("%v.coverage-report.format: %v", prefix, "lcov"),
+		fmt.Sprintf("%v.coverage-report.path: %v", prefix, "coverage/lcov.info"),
+	}
+	helpers.AssertSimpleTrace(t, expected,
+		func() {
+			cfg.show()
+		},
+	)
+}
+
+func Test_save_and_load_a_toolchain_config_with_optional_sections(t *testing.T) {
+	const name = "my-linted-toolchain"
+	tchn := AToolchain(
+		WithName(name),
+		WithLintCommand(command.ACommand(command.WithPath("lint-cmd"))),
+		WithFormatCommand(command.ACommand(command.WithPath("format-cmd"))),
+		WithCoverageReport(coverage.Cobertura, "coverage.xml"),
+	)
+	errRegister := Register(tchn)
+	if errRegister != nil {
+		t.Fatal(errRegister)
+	}
+
+	appFS = afero.NewOsFs()
+	dir, errTempDir := os.MkdirTemp("", "tcr-toolchain")
+	if errTempDir != nil {
+		t.Fatal(errTempDir)
+	}
+	defer t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+		delete(registered, name)
+	})
+	initConfigDirPath(dir)
+	createConfigDir()
+
+	saveConfig(name)
+	yamlConfig := loadConfig(helpers.BuildYAMLFilename(name))
+
+	if assert.NotNil(t, yamlConfig) {
+		assert.Equal(t, tchn, asToolchain(*yamlConfig))
+	}
+}
+
 func Test_save_and_load_all_toolchain_configs(t *testing.T) {
 	// Set up a temporary directory
 	appFS = afero.NewOsFs()
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package toolchain
+
+import (
+	"path/filepath"
+	"slices"
+)
+
+// Test command arguments that can be used in toolchain configuration for running
+// only the tests affected by the latest changes:
+// - AffectedTestsArg is replaced with the path to each affected test file,
+// and is removed when running all tests.
+// - AffectedTestDirsArg is replaced with the path to each directory containing
+// an affected test file, and with "./..." when running all tests.
+// All paths are relative to the work directory.
+const (
+	AffectedTestsArg    = "{{.AffectedTests}}"
+	AffectedTestDirsArg = "{{.AffectedTestDirs}}"
+)
+
+// allTestDirs is the value given to AffectedTestDirsArg when running all tests
+const allTestDirs = "./..."
+
+// supportsTestSelection indicates if the provided arguments allow running
+// only the affected tests
+func supportsTestSelection(args []string) bool {
+	return slices.Contains(args, AffectedTestsArg) || slices.Contains(args, AffectedTestDirsArg)
+}
+
+// expandTestArgs replaces affected test arguments with their value.
+// When affectedTests is empty, arguments are expanded for running all tests
+func expandTestArgs(args []string, affectedTests []string) (expanded []string) {
+	for _, arg := range args {
+		switch arg {
+		case AffectedTestsArg:
+			for _, test := range affectedTests {
+				expanded = append(expanded, relativeToWorkDir(test))
+			}
+		case AffectedTestDirsArg:
+			if len(affectedTests) == 0 {
+				expanded = append(expanded, allTestDirs)
+				break
+			}
+			var dirs []string
+			for _, test := range affectedTests {
+				dir := "./" + filepath.ToSlash(relativeToWorkDir(filepath.Dir(test)))
+				if dir == "./." {
+					dir = "."
+				}
+				if !slices.Contains(dirs, dir) {
+					dirs = append(dirs, dir)
+				}
+			}
+			expanded = append(expanded, dirs...)
+		default:
+			expanded = append(expanded, arg)
+		}
+	}
+	return expanded
+}
+
+// relativeToWorkDir returns the provided path relative to the work directory.
+// The path is returned unchanged if it cannot be made relative
+func relativeToWorkDir(path string) string {
+	if !filepath.IsAbs(path) {
+		return path
+	}
+	rel, err := filepath.Rel(GetWorkDir(), path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package toolchain
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/murex/tcr/toolchain/command"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_supports_test_selection(t *testing.T) {
+	testFlags := []struct {
+		desc     string
+		args     []string
+		expected bool
+	}{
+		{"no affected test argument", []string{"test", "./..."}, false},
+		{"affected tests argument", []string{AffectedTestsArg}, true},
+		{"affected test dirs argument", []string{"test", AffectedTestDirsArg}, true},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			cmd := command.ACommand(command.WithArgs(tt.args))
+			tchn := AToolchain(WithNoTestCommand(), WithTestCommand(cmd))
+			assert.Equal(t, tt.expected, tchn.SupportsTestSelection())
+		})
+	}
+}
+
+func Test_expand_test_args(t *testing.T) {
+	_ = SetWorkDir("")
+	workDir := GetWorkDir()
+	testFlags := []struct {
+		desc          string
+		args          []string
+		affectedTests []string
+		expected      []string
+	}{
+		{
+			"no affected test argument",
+			[]string{"test", "./..."},
+			[]string{filepath.Join(workDir, "a_test.go")},
+			[]string{"test", "./..."},
+		},
+		{
+			"affected tests argument when running all tests",
+			[]string{"-v", AffectedTestsArg},
+			nil,
+			[]string{"-v"},
+		},
+		{
+			"affected tests argument when running affected tests",
+			[]string{"-v", AffectedTestsArg},
+			[]string{filepath.Join(workDir, "tests", "test_a.py"), filepath.Join(workDir, "test_b.py")},
+			[]string{"-v", filepath.Join("tests", "test_a.py"), "test_b.py"},
+		},
+		{
+			"affected test dirs argument when running all tests",
+			[]string{"test", AffectedTestDirsArg},
+			nil,
+			[]string{"test", "./..."},
+		},
+		{
+			"affected test dirs argument when running affected tests",
+			[]string{"test", AffectedTestDirsArg},
+			[]string{
+				filepath.Join(workDir, "a_test.go"),
+				filepath.Join(workDir, "x", "y", "b_test.go"),
+				filepath.Join(workDir, "x", "y", "c_test.go"),
+			},
+			[]string{"test", ".", "./x/y"},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, expandTestArgs(tt.args, tt.affectedTests))
+		})
+	}
+}
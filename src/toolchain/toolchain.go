@@ -27,7 +27,9 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"time"
 
+	"github.com/murex/tcr/coverage"
 	"github.com/murex/tcr/report"
 	"github.com/murex/tcr/toolchain/command"
 	"github.com/murex/tcr/xunit"
@@ -40,17 +42,27 @@ type (
 	// matching the current OS and configuration will be the one to be called.
 	// - testCommands is a table of commands that can be called when running the tests. The first one
 	// matching the current OS and configuration will be the one to be called.
+	// - lintCommands is an optional table of commands that can be called for checking the code
+	// once tests pass. The first one matching the current OS and configuration will be the one to be called.
+	// - formatCommands is an optional table of commands that can be called for formatting the code
+	// before running the build. The first one matching the current OS and configuration will be the one to be called.
+	// - coverageReport is the optional coverage report written by the test command
 	Toolchain struct {
-		name          string
-		buildCommands []command.Command
-		testCommands  []command.Command
-		testResultDir string
+		name           string
+		buildCommands  []command.Command
+		testCommands   []command.Command
+		lintCommands   []command.Command
+		formatCommands []command.Command
+		testResultDir  string
+		coverageReport coverage.Report
 	}
 
-	// TestCommandResult is a Result enriched with test Stats
+	// TestCommandResult is a Result enriched with test Stats, and with
+	// line coverage when the toolchain provides a coverage report
 	TestCommandResult struct {
 		command.Result
-		Stats TestStats
+		Stats    TestStats
+		Coverage *coverage.Stats
 	}
 
 	// TchnInterface provides the interface for interacting with a toolchain
@@ -58,10 +70,21 @@ type (
 		GetName() string
 		GetBuildCommands() []command.Command
 		GetTestCommands() []command.Command
+		GetLintCommands() []command.Command
+		GetFormatCommands() []command.Command
 		GetTestResultDir() string
 		GetTestResultPath() string
+		GetCoverageReport() coverage.Report
+		GetCoverageReportPath() string
+		checkCoverageReport() error
 		RunBuild() command.Result
 		RunTests() TestCommandResult
+		RunAffectedTests(affectedTests []string) TestCommandResult
+		RunLint() command.Result
+		RunFormat() command.Result
+		HasLintCommand() bool
+		HasFormatCommand() bool
+		SupportsTestSelection() bool
 		checkName() error
 		BuildCommandLine() string
 		BuildCommandPath() string
@@ -71,6 +94,10 @@ type (
 		TestCommandPath() string
 		TestCommandArgs() []string
 		checkTestCommand() error
+		LintCommandLine() string
+		LintCommandPath() string
+		FormatCommandLine() string
+		FormatCommandPath() string
 		runsOnPlatform(osName command.OsName, archName command.ArchName) bool
 		CheckCommandAccess(cmdPath string) (string, error)
 		AbortExecution() bool
@@ -137,6 +164,14 @@ func (tchn Toolchain) checkTestCommand() error {
 	return nil
 }
 
+func (tchn Toolchain) checkCoverageReport() error {
+	if !tchn.coverageReport.IsSet() {
+		return nil
+	}
+	_, err := coverage.SelectFormat(string(tchn.coverageReport.Format))
+	return err
+}
+
 // GetName provides the name of the toolchain
 func (tchn Toolchain) GetName() string {
 	return tchn.name
@@ -152,18 +187,69 @@ func (tchn Toolchain) GetTestCommands() []command.Command {
 	return tchn.testCommands
 }
 
+// GetLintCommands returns the toolchain's lint commands
+func (tchn Toolchain) GetLintCommands() []command.Command {
+	return tchn.lintCommands
+}
+
+// GetFormatCommands returns the toolchain's format commands
+func (tchn Toolchain) GetFormatCommands() []command.Command {
+	return tchn.formatCommands
+}
+
 // RunBuild runs the build with this toolchain
 func (tchn Toolchain) RunBuild() command.Result {
 	cmd := command.FindCompatibleCommand(tchn.buildCommands)
 	return command.GetRunner().Run(GetWorkDir(), cmd)
 }
 
-// RunTests runs the tests with this toolchain
+// RunTests runs all the tests with this toolchain
 func (tchn Toolchain) RunTests() TestCommandResult {
+	return tchn.RunAffectedTests(nil)
+}
+
+// RunAffectedTests runs only the provided test files with this toolchain.
+// All the tests are run when the list of test files is empty
+func (tchn Toolchain) RunAffectedTests(affectedTests []string) TestCommandResult {
 	cmd := command.FindCompatibleCommand(tchn.testCommands)
+	if cmd != nil {
+		cmd.Arguments = expandTestArgs(cmd.Arguments, affectedTests)
+	}
+	start := time.Now()
 	result := command.GetRunner().Run(GetWorkDir(), cmd)
 	testStats, _ := tchn.parseTestReport()
-	return TestCommandResult{result, testStats}
+	return TestCommandResult{result, testStats, tchn.parseCoverageReport(start)}
+}
+
+// RunLint runs the lint command of this toolchain
+func (tchn Toolchain) RunLint() command.Result {
+	cmd := command.FindCompatibleCommand(tchn.lintCommands)
+	return command.GetRunner().Run(GetWorkDir(), cmd)
+}
+
+// RunFormat runs the format command of this toolchain
+func (tchn Toolchain) RunFormat() command.Result {
+	cmd := command.FindCompatibleCommand(tchn.formatCommands)
+	return command.GetRunner().Run(GetWorkDir(), cmd)
+}
+
+// HasLintCommand indicates if the toolchain has a lint command
+// compatible with the current platform
+func (tchn Toolchain) HasLintCommand() bool {
+	return command.FindCompatibleCommand(tchn.lintCommands) != nil
+}
+
+// HasFormatCommand indicates if the toolchain has a format command
+// compatible with the current platform
+func (tchn Toolchain) HasFormatCommand() bool {
+	return command.FindCompatibleCommand(tchn.formatCommands) != nil
+}
+
+// SupportsTestSelection indicates if the toolchain's test command allows
+// running only the tests affected by the latest changes
+func (tchn Toolchain) SupportsTestSelection() bool {
+	cmd := command.FindCompatibleCommand(tchn.testCommands)
+	return cmd != nil && supportsTestSelection(cmd.Arguments)
 }
 
 // AbortExecution asks the toolchain to abort any command currently executing
@@ -201,6 +287,26 @@ func (tchn Toolchain) TestCommandLine() string {
 	return command.FindCompatibleCommand(tchn.testCommands).AsCommandLine()
 }
 
+// LintCommandPath returns the lint command path for this toolchain
+func (tchn Toolchain) LintCommandPath() string {
+	return command.FindCompatibleCommand(tchn.lintCommands).Path
+}
+
+// LintCommandLine returns the toolchain's lint command line as a string
+func (tchn Toolchain) LintCommandLine() string {
+	return command.FindCompatibleCommand(tchn.lintCommands).AsCommandLine()
+}
+
+// FormatCommandPath returns the format command path for this toolchain
+func (tchn Toolchain) FormatCommandPath() string {
+	return command.FindCompatibleCommand(tchn.formatCommands).Path
+}
+
+// FormatCommandLine returns the toolchain's format command line as a string
+func (tchn Toolchain) FormatCommandLine() string {
+	return command.FindCompatibleCommand(tchn.formatCommands).AsCommandLine()
+}
+
 func (tchn Toolchain) runsOnPlatform(osName command.OsName, archName command.ArchName) bool {
 	return tchn.findBuildCommandFor(osName, archName) != nil && tchn.findTestCommandFor(osName, archName) != nil
 }
@@ -236,6 +342,37 @@ func (tchn Toolchain) parseTestReport() (TestStats, error) {
 	), nil
 }
 
+// parseCoverageReport extracts line coverage from the toolchain's coverage report.
+// Returns nil when the toolchain has no coverage report, or when the report was not
+// written after the provided time, which would mean that it is not up-to-date
+func (tchn Toolchain) parseCoverageReport(since time.Time) *coverage.Stats {
+	if !tchn.coverageReport.IsSet() {
+		return nil
+	}
+	path := tchn.GetCoverageReportPath()
+	info, err := os.Stat(path)
+	if err != nil || info.ModTime().Before(since.Truncate(time.Second)) {
+		report.PostWarning("No up-to-date coverage report found in ", path)
+		return nil
+	}
+	stats, err := coverage.ParseFile(path, tchn.coverageReport.Format)
+	if err != nil {
+		report.PostWarning("Cannot parse coverage report ", path, ": ", err)
+		return nil
+	}
+	return &stats
+}
+
+// GetCoverageReport returns the coverage report written by the toolchain's test command
+func (tchn Toolchain) GetCoverageReport() coverage.Report {
+	return tchn.coverageReport
+}
+
+// GetCoverageReportPath provides the absolute path to the coverage report
+func (tchn Toolchain) GetCoverageReportPath() string {
+	return filepath.Join(workDir, tchn.coverageReport.Path)
+}
+
 // GetTestResultPath provides the absolute path to the test result directory
 func (tchn Toolchain) GetTestResultPath() string {
 	return filepath.Join(workDir, tchn.GetTestResultDir())
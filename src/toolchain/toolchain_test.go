@@ -25,8 +25,11 @@ package toolchain
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/murex/tcr/coverage"
 	"github.com/murex/tcr/toolchain/command"
 	"github.com/stretchr/testify/assert"
 )
@@ -106,6 +109,77 @@ func Test_test_command_line(t *testing.T) {
 	assert.Equal(t, "test-cmd arg1 arg2", tchn.TestCommandLine())
 }
 
+func Test_lint_command_line(t *testing.T) {
+	cmd := command.ACommand(command.WithPath("lint-cmd"), command.WithArgs([]string{"arg1", "arg2"}))
+	tchn := AToolchain(WithLintCommand(cmd))
+	assert.Equal(t, []command.Command{*cmd}, tchn.GetLintCommands())
+	assert.True(t, tchn.HasLintCommand())
+	assert.Equal(t, "lint-cmd", tchn.LintCommandPath())
+	assert.Equal(t, "lint-cmd arg1 arg2", tchn.LintCommandLine())
+}
+
+func Test_format_command_line(t *testing.T) {
+	cmd := command.ACommand(command.WithPath("format-cmd"), command.WithArgs([]string{"arg1", "arg2"}))
+	tchn := AToolchain(WithFormatCommand(cmd))
+	assert.Equal(t, []command.Command{*cmd}, tchn.GetFormatCommands())
+	assert.True(t, tchn.HasFormatCommand())
+	assert.Equal(t, "format-cmd", tchn.FormatCommandPath())
+	assert.Equal(t, "format-cmd arg1 arg2", tchn.FormatCommandLine())
+}
+
+func Test_lint_and_format_commands_are_optional(t *testing.T) {
+	tchn := AToolchain()
+	assert.False(t, tchn.HasLintCommand())
+	assert.False(t, tchn.HasFormatCommand())
+	assert.NoError(t, Register(tchn))
+	Unregister(tchn.GetName())
+}
+
+func Test_lint_command_not_available_on_current_platform(t *testing.T) {
+	cmd := command.ACommand(command.WithPath("lint-cmd"), command.WithNoOs(), command.WithOs("some-os"))
+	tchn := AToolchain(WithLintCommand(cmd))
+	assert.False(t, tchn.HasLintCommand())
+}
+
+func Test_parse_coverage_report(t *testing.T) {
+	const profile = "mode: set\na.go:1.1,2.2 3 1\na.go:3.1,4.2 1 0\n"
+	testFlags := []struct {
+		desc     string
+		report   string
+		age      time.Duration
+		expected *coverage.Stats
+	}{
+		{"with no coverage report", "", 0, nil},
+		{"with missing coverage report", "missing.out", 0, nil},
+		{"with outdated coverage report", profile, time.Hour, nil},
+		{"with invalid coverage report", "mode: set\ninvalid\n", 0, nil},
+		{"with valid coverage report", profile, 0, &coverage.Stats{Covered: 3, Total: 4}},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			dir := t.TempDir()
+			_ = SetWorkDir(dir)
+			tchn := AToolchain()
+			if tt.report != "" {
+				tchn = AToolchain(WithCoverageReport(coverage.GoCover, "coverage.out"))
+			}
+			if strings.HasPrefix(tt.report, "mode:") {
+				path := filepath.Join(dir, "coverage.out")
+				assert.NoError(t, os.WriteFile(path, []byte(tt.report), 0600))
+				modTime := time.Now().Add(-tt.age)
+				assert.NoError(t, os.Chtimes(path, modTime, modTime))
+			}
+			assert.Equal(t, tt.expected, tchn.parseCoverageReport(time.Now().Add(-time.Minute)))
+		})
+	}
+}
+
+func Test_register_toolchain_with_unsupported_coverage_report_format(t *testing.T) {
+	tchn := AToolchain(WithName("bad-coverage"), WithCoverageReport("clover", "clover.xml"))
+	assert.Error(t, Register(tchn))
+	assert.False(t, isSupported("bad-coverage"))
+}
+
 func Test_check_command_access_for_valid_command(t *testing.T) {
 	tchn := AToolchain()
 	path, err := tchn.CheckCommandAccess("go")
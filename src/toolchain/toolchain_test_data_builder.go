@@ -24,7 +24,10 @@ SOFTWARE.
 
 package toolchain
 
-import "github.com/murex/tcr/toolchain/command"
+import (
+	"github.com/murex/tcr/coverage"
+	"github.com/murex/tcr/toolchain/command"
+)
 
 // AToolchain is a test data builder for type Toolchain
 func AToolchain(toolchainBuilders ...func(tchn *Toolchain)) *Toolchain {
@@ -68,7 +71,26 @@ func WithTestCommand(command *command.Command) func(tchn *Toolchain) {
 	}
 }
 
+// WithLintCommand adds the provided command as a lint command
+func WithLintCommand(command *command.Command) func(tchn *Toolchain) {
+	return func(tchn *Toolchain) {
+		tchn.lintCommands = append(tchn.lintCommands, *command)
+	}
+}
+
+// WithFormatCommand adds the provided command as a format command
+func WithFormatCommand(command *command.Command) func(tchn *Toolchain) {
+	return func(tchn *Toolchain) {
+		tchn.formatCommands = append(tchn.formatCommands, *command)
+	}
+}
+
 // WithTestResultDir sets the test result directory of the created toolchain to dir
 func WithTestResultDir(dir string) func(tchn *Toolchain) {
 	return func(tchn *Toolchain) { tchn.testResultDir = dir }
 }
+
+// WithCoverageReport sets the coverage report of the created toolchain
+func WithCoverageReport(format coverage.Format, path string) func(tchn *Toolchain) {
+	return func(tchn *Toolchain) { tchn.coverageReport = coverage.Report{Format: format, Path: path} }
+}
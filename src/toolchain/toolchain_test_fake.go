@@ -24,7 +24,10 @@ SOFTWARE.
 
 package toolchain
 
-import "github.com/murex/tcr/toolchain/command"
+import (
+	"github.com/murex/tcr/coverage"
+	"github.com/murex/tcr/toolchain/command"
+)
 
 type commandFunc func() string
 type checkCommandFunc func() (string, error)
@@ -38,9 +41,11 @@ type (
 
 // List of supported toolchain operations
 const (
-	BuildOperation Operation = "build"
-	TestOperation  Operation = "test"
-	Never          Operation = ""
+	BuildOperation  Operation = "build"
+	TestOperation   Operation = "test"
+	LintOperation   Operation = "lint"
+	FormatOperation Operation = "format"
+	Never           Operation = ""
 )
 
 func (operations Operations) contains(operation Operation) bool {
@@ -63,6 +68,12 @@ type FakeToolchain struct {
 	buildCommandLine   commandFunc
 	testCommandLine    commandFunc
 	checkCommandAccess checkCommandFunc
+	testSelection      bool
+	lastAffectedTests  []string
+	withLint           bool
+	withFormat         bool
+	ranOperations      Operations
+	coverage           *coverage.Stats
 }
 
 // NewFakeToolchain creates a FakeToolchain instance
@@ -155,10 +166,81 @@ func (ft *FakeToolchain) RunBuild() command.Result {
 // RunTests returns an error if test is part of failingOperations, nil otherwise.
 // This method does not call any real command
 func (ft *FakeToolchain) RunTests() TestCommandResult {
-	return TestCommandResult{ft.fakeOperation(TestOperation), ft.testStats}
+	return ft.RunAffectedTests(nil)
+}
+
+// RunAffectedTests records the provided test files, then returns an error if test
+// is part of failingOperations, nil otherwise.
+// This method does not call any real command
+func (ft *FakeToolchain) RunAffectedTests(affectedTests []string) TestCommandResult {
+	ft.lastAffectedTests = affectedTests
+	return TestCommandResult{ft.fakeOperation(TestOperation), ft.testStats, ft.coverage}
+}
+
+// WithCoverage sets the line coverage returned with test results. No coverage
+// is returned when stats is nil
+func (ft *FakeToolchain) WithCoverage(stats *coverage.Stats) *FakeToolchain {
+	ft.coverage = stats
+	return ft
+}
+
+// GetLastAffectedTests returns the test files provided to the last RunAffectedTests call
+func (ft *FakeToolchain) GetLastAffectedTests() []string {
+	return ft.lastAffectedTests
+}
+
+// SupportsTestSelection indicates if the toolchain allows running only affected tests (faked)
+func (ft *FakeToolchain) SupportsTestSelection() bool {
+	return ft.testSelection
+}
+
+// WithTestSelection allows to change the behaviour of SupportsTestSelection() method
+func (ft *FakeToolchain) WithTestSelection(flag bool) *FakeToolchain {
+	ft.testSelection = flag
+	return ft
+}
+
+// RunLint returns an error if lint is part of failingOperations, nil otherwise.
+// This method does not call any real command
+func (ft *FakeToolchain) RunLint() command.Result {
+	return ft.fakeOperation(LintOperation)
+}
+
+// RunFormat returns an error if format is part of failingOperations, nil otherwise.
+// This method does not call any real command
+func (ft *FakeToolchain) RunFormat() command.Result {
+	return ft.fakeOperation(FormatOperation)
+}
+
+// HasLintCommand indicates if the toolchain has a lint command (faked)
+func (ft *FakeToolchain) HasLintCommand() bool {
+	return ft.withLint
+}
+
+// WithLint allows to change the behaviour of HasLintCommand() method
+func (ft *FakeToolchain) WithLint(flag bool) *FakeToolchain {
+	ft.withLint = flag
+	return ft
+}
+
+// HasFormatCommand indicates if the toolchain has a format command (faked)
+func (ft *FakeToolchain) HasFormatCommand() bool {
+	return ft.withFormat
+}
+
+// WithFormat allows to change the behaviour of HasFormatCommand() method
+func (ft *FakeToolchain) WithFormat(flag bool) *FakeToolchain {
+	ft.withFormat = flag
+	return ft
+}
+
+// GetRanOperations returns the list of operations run so far, in their execution order
+func (ft *FakeToolchain) GetRanOperations() Operations {
+	return ft.ranOperations
 }
 
 func (ft *FakeToolchain) fakeOperation(operation Operation) (result command.Result) {
+	ft.ranOperations = append(ft.ranOperations, operation)
 	if ft.failingOperations.contains(operation) {
 		result = command.Result{
 			Status: command.StatusFail,
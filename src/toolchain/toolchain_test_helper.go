@@ -77,6 +77,30 @@ func assertTestResultDir(t *testing.T, toolchainName string, expected string) {
 	assert.Equal(t, expected, toolchain.GetTestResultDir())
 }
 
+func assertLintCommand(t *testing.T, toolchainName string, expected []string) {
+	t.Helper()
+	toolchain, _ := Get(toolchainName)
+	assertCompatibleCommand(t, toolchain.GetLintCommands(), expected)
+}
+
+func assertFormatCommand(t *testing.T, toolchainName string, expected []string) {
+	t.Helper()
+	toolchain, _ := Get(toolchainName)
+	assertCompatibleCommand(t, toolchain.GetFormatCommands(), expected)
+}
+
+func assertCompatibleCommand(t *testing.T, commands []command.Command, expected []string) {
+	t.Helper()
+	cmd := command.FindCompatibleCommand(commands)
+	if expected == nil {
+		assert.Nil(t, cmd)
+		return
+	}
+	if assert.NotNil(t, cmd) {
+		assert.Equal(t, expected, append([]string{cmd.Path}, cmd.Arguments...))
+	}
+}
+
 func assertErrorWhenBuildFails(t *testing.T, toolchainName string, workDir string) {
 	t.Helper()
 	toolchain, _ := Get(toolchainName)
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package variant
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnsupportedLintPolicyError is returned when the provided LintPolicy name is not supported.
+type UnsupportedLintPolicyError struct {
+	policyName string
+}
+
+// Error returns the error description
+func (e *UnsupportedLintPolicyError) Error() string {
+	return fmt.Sprintf("lint policy not supported: \"%s\"", e.policyName)
+}
+
+// LintPolicy tells what TCR does with changes that pass the tests but fail
+// the toolchain's lint command
+type LintPolicy string
+
+// Recognized lint policy values
+const (
+	// LintOff skips the lint stage
+	LintOff LintPolicy = "off"
+	// LintWarn reports lint failures but still commits the changes
+	LintWarn LintPolicy = "warn"
+	// LintBlock keeps the changes uncommitted until lint passes
+	LintBlock LintPolicy = "block"
+	// LintRevert reverts the changes, as if tests had failed
+	LintRevert LintPolicy = "revert"
+)
+
+var recognizedLintPolicies = []LintPolicy{LintOff, LintWarn, LintBlock, LintRevert}
+
+// SelectLintPolicy returns the lint policy for the provided name.
+// It returns an UnsupportedLintPolicyError if the name is not recognized as a
+// valid lint policy name.
+func SelectLintPolicy(name string) (LintPolicy, error) {
+	for _, policy := range recognizedLintPolicies {
+		if strings.EqualFold(name, policy.Name()) {
+			return policy, nil
+		}
+	}
+	return LintOff, &UnsupportedLintPolicyError{name}
+}
+
+// Name returns the lint policy name
+func (p LintPolicy) Name() string {
+	return string(p)
+}
+
+// IsOn indicates if the lint stage runs with this policy
+func (p LintPolicy) IsOn() bool {
+	return p != LintOff
+}
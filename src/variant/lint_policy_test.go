@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package variant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_select_lint_policy(t *testing.T) {
+	tests := []struct {
+		name           string
+		expectedPolicy LintPolicy
+		expectedError  error
+	}{
+		{"off", LintOff, nil},
+		{"warn", LintWarn, nil},
+		{"Warn", LintWarn, nil},
+		{"block", LintBlock, nil},
+		{"revert", LintRevert, nil},
+		{"unknown", LintOff, &UnsupportedLintPolicyError{"unknown"}},
+		{"", LintOff, &UnsupportedLintPolicyError{""}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy, err := SelectLintPolicy(test.name)
+			assert.Equal(t, test.expectedPolicy, policy)
+			assert.Equal(t, test.expectedError, err)
+		})
+	}
+}
+
+func Test_lint_policy_is_on(t *testing.T) {
+	assert.False(t, LintOff.IsOn())
+	assert.True(t, LintWarn.IsOn())
+	assert.True(t, LintBlock.IsOn())
+	assert.True(t, LintRevert.IsOn())
+}
+
+func Test_unsupported_lint_policy_message_format(t *testing.T) {
+	err := UnsupportedLintPolicyError{"some-policy"}
+	assert.Equal(t, "lint policy not supported: \"some-policy\"", err.Error())
+}
@@ -47,9 +47,13 @@ const (
 	Relaxed       Variant = "relaxed"
 	BTCR          Variant = "btcr"
 	Introspective Variant = "introspective"
+	Shelve        Variant = "shelve"
+	// RedGreenRefactor allows committing a freshly added failing test ("red" phase),
+	// then requires tests to pass on the next commit ("green" phase)
+	RedGreenRefactor Variant = "red-green-refactor"
 )
 
-var recognized = []Variant{Relaxed, BTCR, Introspective}
+var recognized = []Variant{Relaxed, BTCR, Introspective, Shelve, RedGreenRefactor}
 
 // Select returns a variant instance for the provided name.
 // It returns an UnsupportedVariantError if the name is not recognized as a
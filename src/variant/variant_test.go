@@ -37,6 +37,8 @@ func Test_get_variant_name(t *testing.T) {
 		{"relaxed", Relaxed, "relaxed"},
 		{"btcr", BTCR, "btcr"},
 		{"introspective", Introspective, "introspective"},
+		{"shelve", Shelve, "shelve"},
+		{"red-green-refactor", RedGreenRefactor, "red-green-refactor"},
 	}
 
 	for _, test := range tests {
@@ -47,7 +49,7 @@ func Test_get_variant_name(t *testing.T) {
 }
 
 func Test_select_variant(t *testing.T) {
-	relaxed, btcr, introspective := Relaxed, BTCR, Introspective
+	relaxed, btcr, introspective, shelve, redGreenRefactor := Relaxed, BTCR, Introspective, Shelve, RedGreenRefactor
 	tests := []struct {
 		name            string
 		expectedVariant *Variant
@@ -58,6 +60,8 @@ func Test_select_variant(t *testing.T) {
 		{"btcr", &btcr, nil},
 		{"BTCR", &btcr, nil},
 		{"introspective", &introspective, nil},
+		{"shelve", &shelve, nil},
+		{"red-green-refactor", &redGreenRefactor, nil},
 		{"unknown", nil, &UnsupportedVariantError{"unknown"}},
 		{"", nil, &UnsupportedVariantError{""}},
 	}
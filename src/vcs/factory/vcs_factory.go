@@ -28,6 +28,8 @@ import (
 
 	"github.com/murex/tcr/vcs"
 	"github.com/murex/tcr/vcs/git"
+	"github.com/murex/tcr/vcs/hg"
+	"github.com/murex/tcr/vcs/jj"
 	"github.com/murex/tcr/vcs/p4"
 )
 
@@ -58,6 +60,10 @@ func initVCS(name string, dir string, remoteName string) (vcs.Interface, error)
 		return git.New(dir, remoteName)
 	case p4.Name:
 		return p4.New(dir)
+	case hg.Name:
+		return hg.New(dir, remoteName)
+	case jj.Name:
+		return jj.New(dir, remoteName)
 	default:
 		return nil, &UnsupportedVCSError{name}
 	}
@@ -30,7 +30,7 @@ import (
 )
 
 func Test_supported_vcs(t *testing.T) {
-	for _, name := range []string{"git", "p4"} {
+	for _, name := range []string{"git", "p4", "hg", "jj"} {
 		t.Run(name, func(t *testing.T) {
 			_, err := initVCS(name, "", "")
 			assert.NotEqual(t, reflect.TypeOf(&UnsupportedVCSError{}), reflect.TypeOf(err))
@@ -56,20 +56,34 @@ const (
 	CommitCommand             Command = "commit"
 	DiffCommand               Command = "diff"
 	LogCommand                Command = "log"
+	CommitFilesCommand        Command = "commitFiles"
 	PullCommand               Command = "pull"
 	PushCommand               Command = "push"
 	RevertLocalCommand        Command = "revertLocal"
 	RollbackLastCommitCommand Command = "rollbackLastCommit"
+	ShelveCommand             Command = "shelve"
+	ListShelvedCommand        Command = "listShelved"
+	UnshelveCommand           Command = "unshelve"
+	TakeSnapshotCommand       Command = "takeSnapshot"
+	CommitSnapshotCommand     Command = "commitSnapshot"
+	DropSnapshotCommand       Command = "dropSnapshot"
+	PushHandoverCommand       Command = "pushHandover"
+	PullHandoverCommand       Command = "pullHandover"
 )
 
 type (
 	// Settings provide a few ways to tune VCS Fake behaviour
 	Settings struct {
-		FailingCommands     Commands
-		ChangedFiles        vcs.FileDiffs
-		Logs                vcs.LogItems
+		FailingCommands Commands
+		ChangedFiles    vcs.FileDiffs
+		Logs            vcs.LogItems
+		// CommitFiles contains the files changed by each commit, indexed by commit hash
+		CommitFiles         map[string][]string
+		ShelvedItems        vcs.ShelfItems
 		RemoteEnabled       bool
 		RemoteAccessWorking bool
+		// HandoverConflicts contains the files reported in conflict when pulling handed over changes
+		HandoverConflicts []string
 	}
 
 	// VCSFake provides a fake implementation of the VCS interface
@@ -80,6 +94,9 @@ type (
 		lastCommands       []Command
 		lastCommitSubjects []string
 		supportsEmojis     bool
+		supportsSnapshots  bool
+		supportsHandover   bool
+		lastLogFilter      vcs.LogFilter
 	}
 )
 
@@ -100,6 +117,8 @@ func NewVCSFake(settings Settings) *VCSFake {
 		lastCommitSubjects: make([]string, 0),
 		lastCommands:       make([]Command, 0),
 		supportsEmojis:     true,
+		supportsSnapshots:  true,
+		supportsHandover:   true,
 	}
 }
 
@@ -155,22 +174,87 @@ func (vf *VCSFake) Diff() (_ vcs.FileDiffs, err error) {
 }
 
 // Log returns the list of VCS logs configured at fake initialization
-func (vf *VCSFake) Log(msgFilter func(msg string) bool) (logs vcs.LogItems, err error) {
+// that match the provided msgFilter and filter time range.
+// The filter provided in the last call is kept for later inspection
+func (vf *VCSFake) Log(msgFilter func(msg string) bool, filter vcs.LogFilter) (logs vcs.LogItems, err error) {
 	err = vf.fakeCommand(LogCommand)
+	vf.lastLogFilter = filter
+
+	for _, log := range vf.settings.Logs {
+		if !filter.IncludesTime(log.Timestamp) {
+			continue
+		}
+		if msgFilter == nil || msgFilter(log.Message) {
+			logs.Add(log)
+		}
+	}
+	return
+}
+
+// CommitFiles returns the files configured at fake initialization for the provided commit.
+// Returns an error if in the list of failing commands
+func (vf *VCSFake) CommitFiles(id string) (files []string, err error) {
+	err = vf.fakeCommand(CommitFilesCommand)
+	return vf.settings.CommitFiles[id], err
+}
+
+// GetLastLogFilter returns the filter provided in the last call to Log
+func (vf *VCSFake) GetLastLogFilter() vcs.LogFilter {
+	return vf.lastLogFilter
+}
+
+// Shelve does nothing. Returns an error if in the list of failing commands
+func (vf *VCSFake) Shelve(_ []string, _ ...string) error {
+	return vf.fakeCommand(ShelveCommand)
+}
+
+// ListShelved returns the list of VCS shelf items configured at fake initialization
+func (vf *VCSFake) ListShelved(msgFilter func(msg string) bool) (items vcs.ShelfItems, err error) {
+	err = vf.fakeCommand(ListShelvedCommand)
 
 	if msgFilter == nil {
-		logs = vf.settings.Logs
+		items = vf.settings.ShelvedItems
 		return
 	}
 
-	for _, log := range vf.settings.Logs {
-		if msgFilter(log.Message) {
-			logs.Add(log)
+	for _, item := range vf.settings.ShelvedItems {
+		if msgFilter(item.Message) {
+			items.Add(item)
 		}
 	}
 	return
 }
 
+// Unshelve does nothing. Returns an error if in the list of failing commands
+func (vf *VCSFake) Unshelve(_ string) error {
+	return vf.fakeCommand(UnshelveCommand)
+}
+
+// TakeSnapshot returns a snapshot of the changed files sharing the same directory
+// as the working tree. Returns an error if in the list of failing commands
+func (vf *VCSFake) TakeSnapshot() (*vcs.Snapshot, error) {
+	err := vf.fakeCommand(TakeSnapshotCommand)
+	if err != nil {
+		return nil, err
+	}
+	s := vcs.NewSnapshot(vf.GetRootDir(), vf.GetRootDir(), vf.settings.ChangedFiles)
+	for _, diff := range vf.settings.ChangedFiles {
+		s.Record(diff.Path)
+	}
+	return s, nil
+}
+
+// CommitSnapshot does nothing. Returns an error if in the list of failing commands
+func (vf *VCSFake) CommitSnapshot(_ *vcs.Snapshot, messages ...string) error {
+	vf.lastCommitSubjects = append(vf.lastCommitSubjects, messages[0])
+	return vf.fakeCommand(CommitSnapshotCommand)
+}
+
+// DropSnapshot does nothing. Returns an error if in the list of failing commands
+func (vf *VCSFake) DropSnapshot(_ *vcs.Snapshot) error {
+	return vf.fakeCommand(DropSnapshotCommand)
+}
+
 // RollbackLastCommit does nothing. Returns an error if in the list of failing commands
 func (vf *VCSFake) RollbackLastCommit() error {
 	return vf.fakeCommand(RollbackLastCommitCommand)
@@ -230,3 +314,45 @@ func (vf *VCSFake) SupportsEmojis() bool {
 func (vf *VCSFake) SetSupportsEmojis(flag bool) {
 	vf.supportsEmojis = flag
 }
+
+// SupportsSnapshots indicates if the VCS supports running TCR operations
+// in an isolated snapshot of the working tree
+func (vf *VCSFake) SupportsSnapshots() bool {
+	return vf.supportsSnapshots
+}
+
+// SetSupportsSnapshots allows to configure VCS fake's support for snapshots
+func (vf *VCSFake) SetSupportsSnapshots(flag bool) {
+	vf.supportsSnapshots = flag
+}
+
+// SupportsHandover indicates if the VCS supports handing over uncommitted
+// work to another workstation
+func (vf *VCSFake) SupportsHandover() bool {
+	return vf.supportsHandover
+}
+
+// SetSupportsHandover allows to configure VCS fake's support for handover
+func (vf *VCSFake) SetSupportsHandover(flag bool) {
+	vf.supportsHandover = flag
+}
+
+// PushHandover returns true when there are changed files.
+// Returns an error if in the list of failing commands
+func (vf *VCSFake) PushHandover(_ ...string) (bool, error) {
+	err := vf.fakeCommand(PushHandoverCommand)
+	return err == nil && len(vf.settings.ChangedFiles) > 0, err
+}
+
+// PullHandover returns true unless in the list of failing commands. Returns a
+// vcs.HandoverConflictError when settings contain handover conflicts
+func (vf *VCSFake) PullHandover() (bool, error) {
+	err := vf.fakeCommand(PullHandoverCommand)
+	if err != nil {
+		return false, err
+	}
+	if len(vf.settings.HandoverConflicts) > 0 {
+		return false, &vcs.HandoverConflictError{Files: vf.settings.HandoverConflicts}
+	}
+	return true, nil
+}
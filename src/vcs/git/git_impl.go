@@ -27,10 +27,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-git/go-billy/v5"
 	"github.com/go-git/go-git/v5"
@@ -56,8 +58,14 @@ type gitImpl struct {
 	workingBranch               string
 	workingBranchExistsOnRemote bool
 	autoPushEnabled             bool
-	runGitFunction              func(params ...string) (output []byte, err error)
-	traceGitFunction            func(params ...string) (err error)
+	// scope is the path of the base directory relative to the root directory, in slash
+	// form. VCS operations are restricted to this path. Empty when both directories are
+	// the same
+	scope string
+	// sparseCheckout indicates if the local clone uses git sparse-checkout
+	sparseCheckout   bool
+	runGitFunction   func(params ...string) (output []byte, err error)
+	traceGitFunction func(params ...string) (err error)
 }
 
 // New initializes the git implementation based on the provided directory from local clone
@@ -85,6 +93,8 @@ func newGitImpl(
 	}
 
 	g.rootDir = retrieveRootDir(g.filesystem)
+	g.scope = retrieveScope(g.rootDir, dir)
+	g.sparseCheckout = isSparseCheckout(g.repository)
 
 	g.workingBranch, err = retrieveWorkingBranch(g.repository)
 	if err != nil {
@@ -116,7 +126,18 @@ func (g *gitImpl) SessionSummary() string {
 	if g.IsRemoteEnabled() {
 		branch = fmt.Sprintf("%s/%s", g.GetRemoteName(), g.GetWorkingBranch())
 	}
-	return fmt.Sprintf("%s branch \"%s\"", g.Name(), branch)
+	summary := fmt.Sprintf("%s branch \"%s\"", g.Name(), branch)
+	var details []string
+	if g.scope != "" {
+		details = append(details, "scope: "+g.scope)
+	}
+	if g.sparseCheckout {
+		details = append(details, "sparse checkout")
+	}
+	if len(details) > 0 {
+		summary += " (" + strings.Join(details, ", ") + ")"
+	}
+	return summary
 }
 
 // plainOpen is the regular function used to open a repository
@@ -180,6 +201,70 @@ func retrieveRootDir(fs billy.Filesystem) string {
 	return filepath.Dir(fs.Root())
 }
 
+// retrieveScope returns the path of baseDir relative to rootDir in slash form,
+// or an empty string when baseDir is the root directory or is not set
+func retrieveScope(rootDir string, baseDir string) string {
+	if baseDir == "" {
+		return ""
+	}
+	absBaseDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return ""
+	}
+	rel, err := filepath.Rel(evalSymlinks(rootDir), evalSymlinks(absBaseDir))
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return ""
+	}
+	return filepath.ToSlash(rel)
+}
+
+// evalSymlinks returns the provided path with symbolic links resolved when possible
+func evalSymlinks(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return path
+}
+
+// isSparseCheckout indicates if the provided repository uses git sparse-checkout
+func isSparseCheckout(repository *git.Repository) bool {
+	cfg, err := repository.Config()
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(cfg.Raw.Section("core").Options.Get("sparseCheckout"), "true")
+}
+
+// inScope indicates if the provided absolute path is located in the scope of VCS operations
+func (g *gitImpl) inScope(path string) bool {
+	if g.scope == "" {
+		return true
+	}
+	rel, err := filepath.Rel(g.rootDir, path)
+	if err != nil {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	return rel == g.scope || strings.HasPrefix(rel, g.scope+"/")
+}
+
+// scopePath returns the path to be used for operating on the whole scope of VCS operations
+func (g *gitImpl) scopePath() string {
+	if g.scope == "" {
+		return "."
+	}
+	return g.scope
+}
+
+// pathspec returns the git pathspec arguments restricting a git command to the scope
+// of VCS operations. Returns nil when there is no restriction
+func (g *gitImpl) pathspec() []string {
+	if g.scope == "" {
+		return nil
+	}
+	return []string{"--", g.scope}
+}
+
 // retrieveWorkingBranch returns the current working branch for provided repository
 func retrieveWorkingBranch(repository *git.Repository) (string, error) {
 	// Repo with at least one commit
@@ -227,7 +312,7 @@ func (g *gitImpl) IsOnRootBranch() bool {
 func (g *gitImpl) Add(paths ...string) error {
 	gitArgs := []string{"add"}
 	if len(paths) == 0 {
-		gitArgs = append(gitArgs, ".")
+		gitArgs = append(gitArgs, g.scopePath())
 	} else {
 		gitArgs = append(gitArgs, paths...)
 	}
@@ -241,7 +326,7 @@ func (g *gitImpl) Commit(messages ...string) error {
 	for _, message := range messages {
 		gitArgs = append(gitArgs, "-m", message)
 	}
-	err := g.traceGit(gitArgs...)
+	err := g.traceGit(append(gitArgs, g.pathspec()...)...)
 	// This is to prevent from returning an error when there is nothing to commit
 	if err != nil && g.nothingToCommit() {
 		return nil
@@ -251,6 +336,12 @@ func (g *gitImpl) Commit(messages ...string) error {
 
 // nothingToCommit returns true if there is nothing to commit
 func (g *gitImpl) nothingToCommit() bool {
+	if g.scope != "" || g.sparseCheckout {
+		// go-git status does not support pathspecs, and reports files
+		// outside of sparse checkout definition as deleted
+		gitOutput, err := g.runGit(append([]string{"status", "--porcelain"}, g.pathspec()...)...)
+		return err == nil && strings.TrimSpace(string(gitOutput)) == ""
+	}
 	worktree, _ := g.repository.Worktree()
 	status, err := worktree.Status()
 	if err != nil {
@@ -262,6 +353,9 @@ func (g *gitImpl) nothingToCommit() bool {
 // RevertLocal restores to last commit for the provided path.
 // Current implementation uses a direct call to git
 func (g *gitImpl) RevertLocal(path string) error {
+	if !g.inScope(path) {
+		return fmt.Errorf("cannot revert %s: file is outside of %s", path, g.scope)
+	}
 	report.PostWarning("Reverting ", path)
 	return g.traceGit("checkout", "HEAD", "--", path)
 }
@@ -304,8 +398,8 @@ func (g *gitImpl) Pull() error {
 // Current implementation uses a direct call to git
 func (g *gitImpl) Diff() (diffs vcs.FileDiffs, err error) {
 	var gitOutput []byte
-	gitOutput, err = g.runGit("diff", "--numstat", "--ignore-cr-at-eol",
-		"--ignore-blank-lines", "HEAD")
+	gitOutput, err = g.runGit(append([]string{"diff", "--numstat", "--ignore-cr-at-eol",
+		"--ignore-blank-lines", "HEAD"}, g.pathspec()...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -323,10 +417,11 @@ func (g *gitImpl) Diff() (diffs vcs.FileDiffs, err error) {
 	return diffs, nil
 }
 
-// Log returns the list of git log items compliant with the provided msgFilter.
-// When no msgFilter is provided, returns all git log items unfiltered.
+// Log returns the list of git log items compliant with the provided msgFilter and filter.
+// When no msgFilter is provided, returns all git log items matching filter.
+// When filter contains several branches, log items are merged and sorted from the most recent.
 // Current implementation uses go-git's Log() function
-func (g *gitImpl) Log(msgFilter func(msg string) bool) (logs vcs.LogItems, err error) {
+func (g *gitImpl) Log(msgFilter func(msg string) bool, filter vcs.LogFilter) (logs vcs.LogItems, err error) {
 	plainOpenOptions := git.PlainOpenOptions{
 		DetectDotGit:          true,
 		EnableDotGitCommonDir: false,
@@ -336,24 +431,148 @@ func (g *gitImpl) Log(msgFilter func(msg string) bool) (logs vcs.LogItems, err e
 	if err != nil {
 		return nil, err
 	}
-	var head *plumbing.Reference
-	head, err = repo.Head()
+	var from []plumbing.Hash
+	from, err = g.logStartingPoints(repo, filter.Branches)
 	if err != nil {
 		return nil, err
 	}
 
-	var cIter object.CommitIter
-	cIter, err = repo.Log(&git.LogOptions{From: head.Hash()})
+	seen := make(map[plumbing.Hash]bool)
+	for _, hash := range from {
+		options := git.LogOptions{From: hash}
+		if g.scope != "" {
+			options.PathFilter = func(path string) bool {
+				return path == g.scope || strings.HasPrefix(path, g.scope+"/")
+			}
+		}
+		if !filter.Since.IsZero() {
+			options.Since = &filter.Since
+		}
+		if !filter.Until.IsZero() {
+			options.Until = &filter.Until
+		}
+		var cIter object.CommitIter
+		cIter, err = repo.Log(&options)
+		if err != nil {
+			return nil, err
+		}
+		_ = cIter.ForEach(func(c *object.Commit) error {
+			if seen[c.Hash] {
+				return nil
+			}
+			seen[c.Hash] = true
+			if !filter.IncludesAuthor(c.Author.String()) {
+				return nil
+			}
+			if msgFilter == nil || msgFilter(c.Message) {
+				logs.Add(vcs.NewLogItem(c.Hash.String(), c.Committer.When.UTC(), c.Message))
+			}
+			return nil
+		})
+	}
+	if len(from) > 1 {
+		slices.SortStableFunc(logs, func(a, b vcs.LogItem) int {
+			return b.Timestamp.Compare(a.Timestamp)
+		})
+	}
+	return logs, nil
+}
+
+// CommitFiles returns the absolute path of the files added or modified by the commit
+// with the provided hash. Deleted files are not included.
+// Current implementation uses a direct call to git
+func (g *gitImpl) CommitFiles(id string) (files []string, err error) {
+	var gitOutput []byte
+	gitOutput, err = g.runGit(append([]string{"diff-tree", "--no-commit-id", "--name-only",
+		"-r", "--root", "--diff-filter=d", id}, g.pathspec()...)...)
 	if err != nil {
 		return nil, err
 	}
-	_ = cIter.ForEach(func(c *object.Commit) error {
-		if msgFilter == nil || msgFilter(c.Message) {
-			logs.Add(vcs.NewLogItem(c.Hash.String(), c.Committer.When.UTC(), c.Message))
+	scanner := bufio.NewScanner(bytes.NewReader(gitOutput))
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			files = append(files, filepath.Join(g.rootDir, line))
 		}
-		return nil
-	})
-	return logs, nil
+	}
+	return files, nil
+}
+
+// logStartingPoints returns the commit hashes of the provided branches.
+// Branches are looked up first locally, then on the remote.
+// Returns the current HEAD commit hash when no branch is provided
+func (g *gitImpl) logStartingPoints(repo *git.Repository, branches []string) ([]plumbing.Hash, error) {
+	if len(branches) == 0 {
+		head, err := repo.Head()
+		if err != nil {
+			return nil, err
+		}
+		return []plumbing.Hash{head.Hash()}, nil
+	}
+	var hashes []plumbing.Hash
+	for _, branch := range branches {
+		ref, err := repo.Reference(plumbing.NewBranchReferenceName(branch), true)
+		if err != nil && g.remoteName != "" {
+			ref, err = repo.Reference(plumbing.NewRemoteReferenceName(g.remoteName, branch), true)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("branch %s not found: %w", branch, err)
+		}
+		hashes = append(hashes, ref.Hash())
+	}
+	return hashes, nil
+}
+
+// Shelve saves local changes for the provided paths into a git stash entry
+// and restores these paths to last commit.
+// Current implementation uses a direct call to git
+func (g *gitImpl) Shelve(paths []string, messages ...string) error {
+	report.PostWarning("Shelving ", len(paths), " file(s)")
+	gitArgs := []string{"stash", "push", "--include-untracked",
+		"-m", strings.Join(messages, "\n\n"), "--"}
+	return g.traceGit(append(gitArgs, paths...)...)
+}
+
+// Field and record separators used when parsing git stash list output
+const (
+	stashFieldSeparator  = "\x1f"
+	stashRecordSeparator = "\x1e"
+)
+
+// ListShelved returns the list of git stash entries compliant with the provided msgFilter.
+// When no msgFilter is provided, returns all git stash entries unfiltered.
+// Current implementation uses a direct call to git
+func (g *gitImpl) ListShelved(msgFilter func(msg string) bool) (items vcs.ShelfItems, err error) {
+	var gitOutput []byte
+	gitOutput, err = g.runGit("stash", "list",
+		"--format=%gd%x1f%ct%x1f%B%x1e")
+	if err != nil {
+		return nil, err
+	}
+
+	for record := range strings.SplitSeq(string(gitOutput), stashRecordSeparator) {
+		fields := strings.SplitN(strings.TrimLeft(record, "\r\n"), stashFieldSeparator, 3)
+		if len(fields) != 3 { //nolint:revive
+			continue
+		}
+		seconds, _ := strconv.ParseInt(fields[1], 10, 64)
+		// git prefixes stash messages with "On <branch>: "
+		_, message, found := strings.Cut(fields[2], ": ")
+		if !found {
+			message = fields[2]
+		}
+		if msgFilter == nil || msgFilter(message) {
+			items.Add(vcs.NewShelfItem(fields[0], time.Unix(seconds, 0).UTC(), message))
+		}
+	}
+	return items, nil
+}
+
+// Unshelve re-applies the changes saved in the git stash entry matching the provided id.
+// The stash entry is kept so that it can be recovered again if needed.
+// Current implementation uses a direct call to git
+func (g *gitImpl) Unshelve(id string) error {
+	report.PostInfo("Applying ", id)
+	return g.traceGit("stash", "apply", id)
 }
 
 // EnableAutoPush sets a flag allowing to turn on/off git auto push operations
@@ -390,6 +609,232 @@ func (*gitImpl) SupportsEmojis() bool {
 	return true
 }
 
+// SupportsSnapshots indicates if the VCS supports running TCR operations
+// in a snapshot of the working tree (true in case of git)
+func (*gitImpl) SupportsSnapshots() bool {
+	return true
+}
+
+// TakeSnapshot copies the current state of the working tree into a temporary git worktree.
+// Both unstaged and staged changes are copied.
+// Current implementation uses direct calls to git
+func (g *gitImpl) TakeSnapshot() (*vcs.Snapshot, error) {
+	diffs, err := g.Diff()
+	if err != nil {
+		return nil, err
+	}
+	changed, err := g.listFiles("--modified", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, err
+	}
+	staged, err := g.listPaths("diff", "--cached", "--name-only")
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range staged {
+		if !slices.Contains(changed, path) {
+			changed = append(changed, path)
+		}
+	}
+
+	dir, err := os.MkdirTemp("", "tcr-snapshot-")
+	if err != nil {
+		return nil, err
+	}
+	s := vcs.NewSnapshot(g.rootDir, dir, diffs)
+	err = g.traceGit("worktree", "add", "--detach", dir, "HEAD")
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	for _, path := range changed {
+		// The fingerprint is computed from the copied contents, so that a file
+		// saved in the meantime is not mistaken for the snapshot version
+		var contents []byte
+		contents, err = copyToSnapshot(path, s.MapPath(path))
+		if err != nil {
+			_ = g.DropSnapshot(s)
+			return nil, err
+		}
+		s.RecordContents(path, contents)
+	}
+	return s, nil
+}
+
+// copyToSnapshot copies the src working tree file to dst snapshot location, and returns
+// the copied contents. If src no longer exists, dst is removed from the snapshot and
+// the returned contents is nil
+func copyToSnapshot(src string, dst string) ([]byte, error) {
+	info, err := os.Stat(src)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, os.RemoveAll(dst)
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(src) //nolint:gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, os.RemoveAll(dst)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		data = []byte{}
+	}
+	err = os.MkdirAll(filepath.Dir(dst), 0750)
+	if err != nil {
+		return nil, err
+	}
+	return data, os.WriteFile(dst, data, info.Mode().Perm())
+}
+
+// listFiles returns the absolute path of the files returned by git ls-files
+// command when called with the provided options
+func (g *gitImpl) listFiles(options ...string) (files []string, err error) {
+	return g.listPaths(append([]string{"ls-files"}, options...)...)
+}
+
+// listPaths returns the absolute path of the files listed by the provided git command,
+// which must output one path relative to the root directory per line
+func (g *gitImpl) listPaths(args ...string) (files []string, err error) {
+	var gitOutput []byte
+	gitOutput, err = g.runGit(append(args, g.pathspec()...)...)
+	if err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(gitOutput))
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			files = append(files, filepath.Join(g.rootDir, line))
+		}
+	}
+	return files, nil
+}
+
+// CommitSnapshot commits the snapshot contents, then moves the working branch
+// to this commit while leaving the working tree untouched, so that changes done
+// in the working tree since the snapshot was taken remain pending.
+// Current implementation uses direct calls to git
+func (g *gitImpl) CommitSnapshot(s *vcs.Snapshot, messages ...string) error {
+	if s.IsEmpty() {
+		// There's nothing to commit in this case
+		return nil
+	}
+	err := g.traceGitIn(s.Dir, "add", "--all")
+	if err != nil {
+		return err
+	}
+	gitArgs := []string{"commit", "--no-gpg-sign"}
+	for _, message := range messages {
+		gitArgs = append(gitArgs, "-m", message)
+	}
+	err = g.traceGitIn(s.Dir, gitArgs...)
+	if err != nil {
+		return err
+	}
+	var gitOutput []byte
+	gitOutput, err = g.runGitIn(s.Dir, "rev-parse", "HEAD")
+	if err != nil {
+		return err
+	}
+	return g.traceGit("reset", "--mixed", "--quiet", strings.TrimSpace(string(gitOutput)))
+}
+
+// DropSnapshot removes the git worktree containing the snapshot.
+// Current implementation uses a direct call to git
+func (g *gitImpl) DropSnapshot(s *vcs.Snapshot) error {
+	err := g.traceGit("worktree", "remove", "--force", s.Dir)
+	if err != nil {
+		_ = os.RemoveAll(s.Dir)
+		return g.traceGit("worktree", "prune")
+	}
+	return nil
+}
+
+// SupportsHandover indicates if the VCS supports handing over uncommitted
+// work to another workstation (true in case of git)
+func (*gitImpl) SupportsHandover() bool {
+	return true
+}
+
+// PushHandover saves all uncommitted changes (including untracked files) into a git stash
+// entry, then pushes this entry to the remote handover branch so that another workstation can
+// restore it. The stash entry is kept locally so that it can be recovered if needed.
+// Returns false when there is nothing to hand over.
+// Current implementation uses direct calls to git
+func (g *gitImpl) PushHandover(messages ...string) (bool, error) {
+	if !g.IsRemoteEnabled() {
+		return false, nil
+	}
+	gitOutput, err := g.runGit(append([]string{"status", "--porcelain"}, g.pathspec()...)...)
+	if err != nil {
+		return false, err
+	}
+	if strings.TrimSpace(string(gitOutput)) == "" {
+		return false, nil
+	}
+	branch := vcs.HandoverBranch(g.GetWorkingBranch())
+	report.PostInfo("Handing over uncommitted changes to ", g.GetRemoteName(), "/", branch)
+	err = g.traceGit(append([]string{"stash", "push", "--include-untracked",
+		"-m", strings.Join(messages, "\n\n")}, g.pathspec()...)...)
+	if err != nil {
+		return false, err
+	}
+	err = g.traceGit("push", "--force", "--no-recurse-submodules",
+		g.GetRemoteName(), "stash@{0}:refs/heads/"+branch)
+	if err != nil {
+		// Restore local changes so that the driver can keep on working
+		_ = g.traceGit("stash", "pop")
+		return false, err
+	}
+	return true, nil
+}
+
+// PullHandover restores the uncommitted changes pushed to the remote handover branch
+// by another workstation, then removes the remote handover branch.
+// Returns false when there is nothing to restore. The returned error is a
+// vcs.HandoverConflictError when handed over changes conflict with local changes.
+// Current implementation uses direct calls to git
+func (g *gitImpl) PullHandover() (bool, error) {
+	if !g.IsRemoteEnabled() {
+		return false, nil
+	}
+	ref := "refs/heads/" + vcs.HandoverBranch(g.GetWorkingBranch())
+	gitOutput, err := g.runGit("ls-remote", g.GetRemoteName(), ref)
+	if err != nil {
+		return false, err
+	}
+	if strings.TrimSpace(string(gitOutput)) == "" {
+		return false, nil
+	}
+	report.PostInfo("Restoring changes handed over on ", g.GetRemoteName(), "/", vcs.HandoverBranch(g.GetWorkingBranch()))
+	err = g.traceGit("fetch", "--no-recurse-submodules", g.GetRemoteName(), ref)
+	if err != nil {
+		return false, err
+	}
+	err = g.traceGit("stash", "apply", "FETCH_HEAD")
+	if err != nil {
+		return false, &vcs.HandoverConflictError{Files: g.conflictingFiles(), Err: err}
+	}
+	return true, g.traceGit("push", "--no-recurse-submodules", g.GetRemoteName(), "--delete", ref)
+}
+
+// conflictingFiles returns the list of files with unresolved merge conflicts
+func (g *gitImpl) conflictingFiles() (files []string) {
+	gitOutput, err := g.runGit("diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return nil
+	}
+	for line := range strings.SplitSeq(string(gitOutput), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files
+}
+
 // traceGit runs a git command and traces its output.
 // The command is launched from the git root directory
 func (g *gitImpl) traceGit(args ...string) error {
@@ -405,3 +850,15 @@ func (g *gitImpl) runGit(args ...string) (output []byte, err error) {
 func (g *gitImpl) buildGitArgs(args ...string) []string {
 	return append([]string{"-C", g.GetRootDir()}, args...)
 }
+
+// traceGitIn runs a git command and traces its output.
+// The command is launched from the provided directory
+func (g *gitImpl) traceGitIn(dir string, args ...string) error {
+	return g.traceGitFunction(append([]string{"-C", dir}, args...)...)
+}
+
+// runGitIn calls git command in a separate process and returns its output traces
+// The command is launched from the provided directory
+func (g *gitImpl) runGitIn(dir string, args ...string) (output []byte, err error) {
+	return g.runGitFunction(append([]string{"-C", dir}, args...)...)
+}
@@ -25,7 +25,10 @@ package git
 import (
 	"errors"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -251,6 +254,56 @@ func Test_git_diff(t *testing.T) {
 	}
 }
 
+func Test_git_commit_files(t *testing.T) {
+	testFlags := []struct {
+		desc          string
+		gitOutput     string
+		gitError      error
+		expectError   bool
+		expectedFiles []string
+	}{
+		{
+			"git diff-tree command call fails",
+			"",
+			errors.New("git diff-tree error"),
+			true,
+			nil,
+		},
+		{
+			"commit with no file",
+			"",
+			nil,
+			false,
+			nil,
+		},
+		{
+			"commit with 2 files",
+			"file1.go\nsome-dir/file2.go\n",
+			nil,
+			false,
+			[]string{filepath.Join("/", "file1.go"), filepath.Join("/", "some-dir", "file2.go")},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs []string
+			g, _ := newGitImpl(inMemoryRepoInit, "", "")
+			g.runGitFunction = func(args ...string) (output []byte, err error) {
+				actualArgs = args[2:]
+				return []byte(tt.gitOutput), tt.gitError
+			}
+			files, err := g.CommitFiles("abc123")
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, []string{"diff-tree", "--no-commit-id", "--name-only", "-r", "--root", "--diff-filter=d", "abc123"}, actualArgs)
+			assert.Equal(t, tt.expectedFiles, files)
+		})
+	}
+}
+
 func Test_git_push(t *testing.T) {
 	testFlags := []struct {
 		desc                 string
@@ -546,6 +599,178 @@ func Test_git_rollback_last_commit(t *testing.T) {
 	}
 }
 
+func Test_git_shelve(t *testing.T) {
+	testFlags := []struct {
+		desc         string
+		paths        []string
+		messages     []string
+		gitError     error
+		expectError  bool
+		expectedArgs []string
+	}{
+		{
+			"git stash command call succeeds",
+			[]string{"some-path"},
+			[]string{"some message"},
+			nil,
+			false,
+			[]string{"stash", "push", "--include-untracked", "-m", "some message", "--", "some-path"},
+		},
+		{
+			"git stash command call fails",
+			[]string{"some-path"},
+			[]string{"some message"},
+			errors.New("git stash error"),
+			true,
+			[]string{"stash", "push", "--include-untracked", "-m", "some message", "--", "some-path"},
+		},
+		{
+			"multiple paths and messages",
+			[]string{"path1", "path2"},
+			[]string{"header", "body"},
+			nil,
+			false,
+			[]string{"stash", "push", "--include-untracked", "-m", "header\n\nbody", "--", "path1", "path2"},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs []string
+			g, _ := newGitImpl(inMemoryRepoInit, "", "")
+			g.traceGitFunction = func(args ...string) (err error) {
+				actualArgs = args[2:]
+				return tt.gitError
+			}
+
+			err := g.Shelve(tt.paths, tt.messages...)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedArgs, actualArgs)
+		})
+	}
+}
+
+func Test_git_list_shelved(t *testing.T) {
+	timestamp := time.Unix(1700000000, 0).UTC()
+	testFlags := []struct {
+		desc          string
+		gitOutput     string
+		gitError      error
+		filter        func(msg string) bool
+		expectError   bool
+		expectedItems vcs.ShelfItems
+	}{
+		{
+			"git stash list command call fails",
+			"",
+			errors.New("git stash error"),
+			nil,
+			true,
+			nil,
+		},
+		{
+			"no stash entry",
+			"",
+			nil,
+			nil,
+			false,
+			nil,
+		},
+		{
+			"one stash entry",
+			"stash@{0}\x1f1700000000\x1fOn main: some message\n\x1e",
+			nil,
+			nil,
+			false,
+			vcs.ShelfItems{
+				vcs.NewShelfItem("stash@{0}", timestamp, "some message\n"),
+			},
+		},
+		{
+			"multi-line stash message",
+			"stash@{0}\x1f1700000000\x1fOn main: header\n\nbody\n\x1e",
+			nil,
+			nil,
+			false,
+			vcs.ShelfItems{
+				vcs.NewShelfItem("stash@{0}", timestamp, "header\n\nbody\n"),
+			},
+		},
+		{
+			"filtered out stash entries",
+			"stash@{0}\x1f1700000000\x1fOn main: kept\n\x1e\nstash@{1}\x1f1700000000\x1fOn main: dropped\n\x1e",
+			nil,
+			func(msg string) bool { return strings.HasPrefix(msg, "kept") },
+			false,
+			vcs.ShelfItems{
+				vcs.NewShelfItem("stash@{0}", timestamp, "kept\n"),
+			},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs []string
+			g, _ := newGitImpl(inMemoryRepoInit, "", "")
+			g.runGitFunction = func(args ...string) (output []byte, err error) {
+				actualArgs = args[2:]
+				return []byte(tt.gitOutput), tt.gitError
+			}
+
+			items, err := g.ListShelved(tt.filter)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, []string{"stash", "list", "--format=%gd%x1f%ct%x1f%B%x1e"}, actualArgs)
+			assert.Equal(t, tt.expectedItems, items)
+		})
+	}
+}
+
+func Test_git_unshelve(t *testing.T) {
+	testFlags := []struct {
+		desc         string
+		gitError     error
+		expectError  bool
+		expectedArgs []string
+	}{
+		{
+			"git stash apply command call succeeds",
+			nil,
+			false,
+			[]string{"stash", "apply", "stash@{0}"},
+		},
+		{
+			"git stash apply command call fails",
+			errors.New("git stash error"),
+			true,
+			[]string{"stash", "apply", "stash@{0}"},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs []string
+			g, _ := newGitImpl(inMemoryRepoInit, "", "")
+			g.traceGitFunction = func(args ...string) (err error) {
+				actualArgs = args[2:]
+				return tt.gitError
+			}
+
+			err := g.Unshelve("stash@{0}")
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedArgs, actualArgs)
+		})
+	}
+}
+
 func Test_git_log(t *testing.T) {
 	// Note: this test may break if for any reason the TCR repository initial commit is altered
 	tcrInitialCommit := vcs.LogItem{
@@ -586,7 +811,7 @@ func Test_git_log(t *testing.T) {
 	for _, tt := range testFlags {
 		t.Run(tt.desc, func(t *testing.T) {
 			g, _ := newGitImpl(inMemoryRepoInit, ".", "")
-			items, err := g.Log(tt.filter)
+			items, err := g.Log(tt.filter, vcs.LogFilter{})
 			assert.NoError(t, err)
 			tt.asserter(t, items)
 		})
@@ -783,3 +1008,478 @@ func Test_git_trace_command_global_parameters(t *testing.T) {
 	_ = g.traceGit()
 	assert.Equal(t, []string{"-C", filepath.FromSlash("/")}, cmdParams)
 }
+
+func Test_git_supports_snapshots(t *testing.T) {
+	g, _ := newGitImpl(inMemoryRepoInit, "", "")
+	assert.True(t, g.SupportsSnapshots())
+}
+
+func Test_git_commit_snapshot(t *testing.T) {
+	testFlags := []struct {
+		desc           string
+		emptySnapshot  bool
+		failingCommand string
+		expectError    bool
+		expectedArgs   [][]string
+	}{
+		{
+			"empty snapshot",
+			true, "",
+			false,
+			nil,
+		},
+		{
+			"git add, commit, rev-parse and reset command calls succeed",
+			false, "",
+			false,
+			[][]string{
+				{"-C", "snapshot-dir", "add", "--all"},
+				{"-C", "snapshot-dir", "commit", "--no-gpg-sign", "-m", "some message"},
+				{"-C", "snapshot-dir", "rev-parse", "HEAD"},
+				{"reset", "--mixed", "--quiet", "1234"},
+			},
+		},
+		{
+			"git commit command call fails",
+			false, "commit",
+			true,
+			[][]string{
+				{"-C", "snapshot-dir", "add", "--all"},
+				{"-C", "snapshot-dir", "commit", "--no-gpg-sign", "-m", "some message"},
+			},
+		},
+		{
+			"git reset command call fails",
+			false, "reset",
+			true,
+			[][]string{
+				{"-C", "snapshot-dir", "add", "--all"},
+				{"-C", "snapshot-dir", "commit", "--no-gpg-sign", "-m", "some message"},
+				{"-C", "snapshot-dir", "rev-parse", "HEAD"},
+				{"reset", "--mixed", "--quiet", "1234"},
+			},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs [][]string
+			fakeGit := func(args []string) error {
+				// Remove the "-C <root-dir>" arguments added when running from the root directory
+				if args[1] != "snapshot-dir" {
+					args = args[2:]
+				}
+				actualArgs = append(actualArgs, args)
+				if slices.Contains(args, tt.failingCommand) {
+					return errors.New("git " + tt.failingCommand + " error")
+				}
+				return nil
+			}
+			g, _ := newGitImpl(inMemoryRepoInit, "", "")
+			g.traceGitFunction = func(args ...string) error {
+				return fakeGit(args)
+			}
+			g.runGitFunction = func(args ...string) ([]byte, error) {
+				return []byte("1234\n"), fakeGit(args)
+			}
+
+			s := vcs.NewSnapshot("", "snapshot-dir", nil)
+			if !tt.emptySnapshot {
+				s.Record("some-file")
+			}
+			err := g.CommitSnapshot(s, "some message")
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedArgs, actualArgs)
+		})
+	}
+}
+
+func Test_git_drop_snapshot(t *testing.T) {
+	testFlags := []struct {
+		desc         string
+		gitError     error
+		expectError  bool
+		expectedArgs [][]string
+	}{
+		{
+			"git worktree remove command call succeeds",
+			nil,
+			false,
+			[][]string{
+				{"worktree", "remove", "--force", "snapshot-dir"},
+			},
+		},
+		{
+			"git worktree remove command call fails",
+			errors.New("git worktree error"),
+			true,
+			[][]string{
+				{"worktree", "remove", "--force", "snapshot-dir"},
+				{"worktree", "prune"},
+			},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs [][]string
+			g, _ := newGitImpl(inMemoryRepoInit, "", "")
+			g.traceGitFunction = func(args ...string) error {
+				actualArgs = append(actualArgs, args[2:])
+				return tt.gitError
+			}
+
+			err := g.DropSnapshot(vcs.NewSnapshot("", "snapshot-dir", nil))
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedArgs, actualArgs)
+		})
+	}
+}
+
+func Test_git_snapshot_round_trip(t *testing.T) {
+	if !IsGitCommandAvailable() {
+		t.Skip("git command is not available")
+	}
+	repoDir := t.TempDir()
+	runGit := func(args ...string) {
+		_, err := runGitCommand(append([]string{"-C", repoDir}, args...)...)
+		assert.NoError(t, err)
+	}
+	runGit("init", "--quiet", "--initial-branch=main")
+	runGit("config", "user.name", "tcr-test")
+	runGit("config", "user.email", "tcr-test@example.com")
+	tracked := filepath.Join(repoDir, "tracked.txt")
+	assert.NoError(t, os.WriteFile(tracked, []byte("v1\n"), 0600))
+	runGit("add", ".")
+	runGit("commit", "--quiet", "--no-gpg-sign", "-m", "initial commit")
+
+	g, err := New(repoDir, "")
+	assert.NoError(t, err)
+
+	// Change the working tree, then take a snapshot
+	untracked := filepath.Join(repoDir, "untracked.txt")
+	assert.NoError(t, os.WriteFile(tracked, []byte("v2\n"), 0600))
+	assert.NoError(t, os.WriteFile(untracked, []byte("new\n"), 0600))
+	s, err := g.TakeSnapshot()
+	assert.NoError(t, err)
+	snapshotContents, _ := os.ReadFile(s.MapPath(tracked))
+	assert.Equal(t, "v2\n", string(snapshotContents))
+	assert.FileExists(t, s.MapPath(untracked))
+
+	// Keep on editing the working tree while the snapshot is being committed
+	assert.NoError(t, os.WriteFile(tracked, []byte("v3\n"), 0600))
+	assert.NoError(t, g.CommitSnapshot(s, "snapshot commit"))
+	assert.NoError(t, g.DropSnapshot(s))
+	assert.NoDirExists(t, s.Dir)
+
+	// The snapshot is committed, and later changes remain pending
+	diffs, err := g.Diff()
+	assert.NoError(t, err)
+	assert.Equal(t, vcs.FileDiffs{vcs.NewFileDiff(tracked, 1, 1)}, diffs)
+	headContents, _ := runGitCommand("-C", repoDir, "show", "HEAD:tracked.txt")
+	assert.Equal(t, "v2\n", string(headContents))
+}
+
+func Test_git_snapshot_includes_staged_changes(t *testing.T) {
+	if !IsGitCommandAvailable() {
+		t.Skip("git command is not available")
+	}
+	repoDir := t.TempDir()
+	runGit := func(args ...string) {
+		_, err := runGitCommand(append([]string{"-C", repoDir}, args...)...)
+		assert.NoError(t, err)
+	}
+	runGit("init", "--quiet", "--initial-branch=main")
+	runGit("config", "user.name", "tcr-test")
+	runGit("config", "user.email", "tcr-test@example.com")
+	staged := filepath.Join(repoDir, "staged.txt")
+	deleted := filepath.Join(repoDir, "deleted.txt")
+	assert.NoError(t, os.WriteFile(staged, []byte("v1\n"), 0600))
+	assert.NoError(t, os.WriteFile(deleted, []byte("v1\n"), 0600))
+	runGit("add", ".")
+	runGit("commit", "--quiet", "--no-gpg-sign", "-m", "initial commit")
+
+	g, err := New(repoDir, "")
+	assert.NoError(t, err)
+
+	// Stage changes, leaving no unstaged change in the working tree
+	assert.NoError(t, os.WriteFile(staged, []byte("v2\n"), 0600))
+	runGit("add", "staged.txt")
+	runGit("rm", "--quiet", "deleted.txt")
+	s, err := g.TakeSnapshot()
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = g.DropSnapshot(s) })
+	snapshotContents, _ := os.ReadFile(s.MapPath(staged))
+	assert.Equal(t, "v2\n", string(snapshotContents))
+	assert.NoFileExists(t, s.MapPath(deleted))
+	assert.False(t, s.IsEmpty())
+}
+
+func Test_git_operations_are_scoped_to_base_dir(t *testing.T) {
+	if !IsGitCommandAvailable() {
+		t.Skip("git command is not available")
+	}
+	repoDir := t.TempDir()
+	runGit := func(args ...string) {
+		_, err := runGitCommand(append([]string{"-C", repoDir}, args...)...)
+		assert.NoError(t, err)
+	}
+	appFile := filepath.Join(repoDir, "app", "app.txt")
+	otherFile := filepath.Join(repoDir, "other", "other.txt")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(appFile), 0750))
+	assert.NoError(t, os.MkdirAll(filepath.Dir(otherFile), 0750))
+	runGit("init", "--quiet", "--initial-branch=main")
+	runGit("config", "user.name", "tcr-test")
+	runGit("config", "user.email", "tcr-test@example.com")
+	assert.NoError(t, os.WriteFile(appFile, []byte("v1\n"), 0600))
+	assert.NoError(t, os.WriteFile(otherFile, []byte("v1\n"), 0600))
+	runGit("add", ".")
+	runGit("commit", "--quiet", "--no-gpg-sign", "-m", "initial commit")
+	assert.NoError(t, os.WriteFile(otherFile, []byte("v2\n"), 0600))
+	runGit("commit", "--quiet", "--no-gpg-sign", "-am", "other commit")
+
+	g, err := New(filepath.Join(repoDir, "app"), "")
+	assert.NoError(t, err)
+	assert.Equal(t, "git branch \"main\" (scope: app)", g.SessionSummary())
+
+	// Changes outside of the base directory are ignored
+	assert.NoError(t, os.WriteFile(appFile, []byte("v2\n"), 0600))
+	assert.NoError(t, os.WriteFile(otherFile, []byte("v3\n"), 0600))
+	diffs, err := g.Diff()
+	assert.NoError(t, err)
+	assert.Equal(t, vcs.FileDiffs{vcs.NewFileDiff(appFile, 1, 1)}, diffs)
+
+	// Changes outside of the base directory cannot be reverted
+	assert.Error(t, g.RevertLocal(otherFile))
+
+	// Changes outside of the base directory are left uncommitted
+	assert.NoError(t, g.Add())
+	assert.NoError(t, g.Commit("app commit"))
+	status, _ := runGitCommand("-C", repoDir, "status", "--porcelain")
+	assert.Equal(t, " M other/other.txt\n", string(status))
+
+	// Only commits touching the base directory are listed
+	logs, err := g.Log(nil, vcs.LogFilter{})
+	assert.NoError(t, err)
+	var messages []string
+	for _, log := range logs {
+		messages = append(messages, strings.TrimSpace(log.Message))
+	}
+	assert.ElementsMatch(t, []string{"app commit", "initial commit"}, messages)
+}
+
+func Test_git_session_summary_with_sparse_checkout(t *testing.T) {
+	if !IsGitCommandAvailable() {
+		t.Skip("git command is not available")
+	}
+	repoDir := t.TempDir()
+	runGit := func(args ...string) {
+		_, err := runGitCommand(append([]string{"-C", repoDir}, args...)...)
+		assert.NoError(t, err)
+	}
+	for _, dir := range []string{"app", "other"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(repoDir, dir), 0750))
+		assert.NoError(t, os.WriteFile(filepath.Join(repoDir, dir, "file.txt"), []byte("v1\n"), 0600))
+	}
+	runGit("init", "--quiet", "--initial-branch=main")
+	runGit("config", "user.name", "tcr-test")
+	runGit("config", "user.email", "tcr-test@example.com")
+	runGit("add", ".")
+	runGit("commit", "--quiet", "--no-gpg-sign", "-m", "initial commit")
+	// go-git does not support the worktreeConfig extension set by "git sparse-checkout",
+	// hence the sparse checkout definition is done through core.sparseCheckout
+	runGit("config", "core.sparseCheckout", "true")
+	assert.NoError(t, os.WriteFile(filepath.Join(repoDir, ".git", "info", "sparse-checkout"), []byte("/app/\n"), 0600))
+	runGit("read-tree", "-mu", "HEAD")
+	assert.NoDirExists(t, filepath.Join(repoDir, "other"))
+
+	g, err := New(filepath.Join(repoDir, "app"), "")
+	assert.NoError(t, err)
+	assert.Equal(t, "git branch \"main\" (scope: app, sparse checkout)", g.SessionSummary())
+	// Files outside of the sparse checkout definition are not seen as deleted
+	assert.NoError(t, g.Commit("nothing to commit"))
+	status, _ := runGitCommand("-C", repoDir, "log", "--oneline")
+	assert.Equal(t, 1, strings.Count(string(status), "\n"))
+}
+
+func Test_git_supports_handover(t *testing.T) {
+	g, _ := newGitImpl(inMemoryRepoInit, "", "")
+	assert.True(t, g.SupportsHandover())
+}
+
+func Test_git_handover_with_remote_disabled(t *testing.T) {
+	g, _ := newGitImpl(inMemoryRepoInit, "", "")
+	g.remoteEnabled = false
+	g.runGitFunction = func(_ ...string) ([]byte, error) {
+		return nil, errors.New("git should not be called")
+	}
+	pushed, err := g.PushHandover("handover")
+	assert.NoError(t, err)
+	assert.False(t, pushed)
+	pulled, err := g.PullHandover()
+	assert.NoError(t, err)
+	assert.False(t, pulled)
+}
+
+func Test_git_handover_round_trip(t *testing.T) {
+	if !IsGitCommandAvailable() {
+		t.Skip("git command is not available")
+	}
+	rootDir := t.TempDir()
+	runGit := func(dir string, args ...string) {
+		output, err := runGitCommand(append([]string{"-C", dir}, args...)...)
+		assert.NoError(t, err, string(output))
+	}
+	remoteDir := filepath.Join(rootDir, "remote.git")
+	runGit(rootDir, "init", "--quiet", "--bare", "--initial-branch=main", remoteDir)
+	clone := func(name string) string {
+		dir := filepath.Join(rootDir, name)
+		runGit(rootDir, "clone", "--quiet", remoteDir, dir)
+		runGit(dir, "config", "user.name", name)
+		runGit(dir, "config", "user.email", name+"@example.com")
+		return dir
+	}
+	dir1 := clone("driver1")
+	tracked1 := filepath.Join(dir1, "tracked.txt")
+	assert.NoError(t, os.WriteFile(tracked1, []byte("v1\n"), 0600))
+	runGit(dir1, "add", ".")
+	runGit(dir1, "commit", "--quiet", "--no-gpg-sign", "-m", "initial commit")
+	runGit(dir1, "push", "--quiet", "origin", "main")
+	dir2 := clone("driver2")
+
+	g1, err := New(dir1, "origin")
+	assert.NoError(t, err)
+	g2, err := New(dir2, "origin")
+	assert.NoError(t, err)
+
+	// Nothing to hand over or to restore yet
+	pushed, err := g1.PushHandover("handover")
+	assert.NoError(t, err)
+	assert.False(t, pushed)
+	pulled, err := g2.PullHandover()
+	assert.NoError(t, err)
+	assert.False(t, pulled)
+
+	// First driver hands over uncommitted changes, including untracked files
+	assert.NoError(t, os.WriteFile(tracked1, []byte("v2\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir1, "untracked.txt"), []byte("new\n"), 0600))
+	pushed, err = g1.PushHandover("handover")
+	assert.NoError(t, err)
+	assert.True(t, pushed)
+	contents, _ := os.ReadFile(tracked1)
+	assert.Equal(t, "v1\n", string(contents))
+
+	// Second driver restores them, and the handover branch is removed
+	pulled, err = g2.PullHandover()
+	assert.NoError(t, err)
+	assert.True(t, pulled)
+	contents, _ = os.ReadFile(filepath.Join(dir2, "tracked.txt"))
+	assert.Equal(t, "v2\n", string(contents))
+	assert.FileExists(t, filepath.Join(dir2, "untracked.txt"))
+	pulled, err = g2.PullHandover()
+	assert.NoError(t, err)
+	assert.False(t, pulled)
+
+	// Handed over changes conflicting with local changes are reported
+	assert.NoError(t, os.WriteFile(tracked1, []byte("v3\n"), 0600))
+	_, err = g1.PushHandover("handover")
+	assert.NoError(t, err)
+	_, err = g2.PullHandover()
+	var conflictError *vcs.HandoverConflictError
+	assert.ErrorAs(t, err, &conflictError)
+}
+
+func Test_git_log_with_filter(t *testing.T) {
+	if !IsGitCommandAvailable() {
+		t.Skip("git command is not available")
+	}
+	repoDir := t.TempDir()
+	runGit := func(env []string, args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", repoDir}, args...)...) //nolint:gosec
+		cmd.Env = append(os.Environ(), env...)
+		output, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(output))
+	}
+	commit := func(author string, date string, message string) {
+		runGit([]string{
+			"GIT_AUTHOR_NAME=" + author, "GIT_AUTHOR_EMAIL=" + author + "@example.com",
+			"GIT_COMMITTER_NAME=" + author, "GIT_COMMITTER_EMAIL=" + author + "@example.com",
+			"GIT_AUTHOR_DATE=" + date, "GIT_COMMITTER_DATE=" + date,
+		}, "commit", "--quiet", "--no-gpg-sign", "--allow-empty", "-m", message)
+	}
+	runGit(nil, "init", "--quiet", "--initial-branch=main")
+	commit("alice", "2026-10-16T10:00:00Z", "first")
+	commit("bob", "2026-10-17T10:00:00Z", "second")
+	runGit(nil, "checkout", "--quiet", "-b", "feature")
+	commit("alice", "2026-10-18T10:00:00Z", "third")
+	runGit(nil, "checkout", "--quiet", "main")
+	commit("bob", "2026-10-18T11:00:00Z", "fourth")
+
+	testFlags := []struct {
+		desc     string
+		filter   vcs.LogFilter
+		expected []string
+	}{
+		{
+			"current branch",
+			vcs.LogFilter{},
+			[]string{"fourth\n", "second\n", "first\n"},
+		},
+		{
+			"since",
+			vcs.LogFilter{Since: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+			[]string{"fourth\n", "second\n"},
+		},
+		{
+			"until",
+			vcs.LogFilter{Until: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+			[]string{"first\n"},
+		},
+		{
+			"author",
+			vcs.LogFilter{Authors: []string{"ALICE"}},
+			[]string{"first\n"},
+		},
+		{
+			"other branch",
+			vcs.LogFilter{Branches: []string{"feature"}},
+			[]string{"third\n", "second\n", "first\n"},
+		},
+		{
+			"several branches",
+			vcs.LogFilter{Branches: []string{"main", "feature"}},
+			[]string{"fourth\n", "third\n", "second\n", "first\n"},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			g, err := New(repoDir, "")
+			assert.NoError(t, err)
+			items, err := g.Log(nil, tt.filter)
+			assert.NoError(t, err)
+			var messages []string
+			for _, item := range items {
+				messages = append(messages, item.Message)
+			}
+			assert.Equal(t, tt.expected, messages)
+		})
+	}
+}
+
+func Test_git_log_with_unknown_branch(t *testing.T) {
+	if !IsGitCommandAvailable() {
+		t.Skip("git command is not available")
+	}
+	repoDir := t.TempDir()
+	_, err := runGitCommand("init", "--quiet", repoDir)
+	assert.NoError(t, err)
+	g, _ := newGitImpl(inMemoryRepoInit, repoDir, "")
+	_, err = g.Log(nil, vcs.LogFilter{Branches: []string{"no-such-branch"}})
+	assert.Error(t, err)
+}
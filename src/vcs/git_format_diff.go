@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package vcs
+
+import (
+	"bufio"
+	"bytes"
+	"path/filepath"
+	"strings"
+)
+
+// ParseGitFormatDiff parses a diff in git format (as produced by "git diff", "hg diff --git"
+// or "jj diff --git") and returns the number of added and removed lines for each file.
+// File paths in the diff are relative to rootDir
+func ParseGitFormatDiff(rootDir string, diff []byte) (diffs FileDiffs) {
+	var current *FileDiff
+	inHunk := false
+	flush := func() {
+		if current != nil {
+			diffs = append(diffs, *current)
+		}
+		current = nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(diff))
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), 16*bufio.MaxScanTokenSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			// diff --git a/<path> b/<path>
+			flush()
+			inHunk = false
+			path := strings.TrimPrefix(line, "diff --git ")
+			if i := strings.LastIndex(path, " b/"); i >= 0 {
+				path = path[i+len(" b/"):]
+			}
+			current = &FileDiff{Path: filepath.Join(rootDir, filepath.FromSlash(path))}
+		case current == nil:
+			continue
+		case strings.HasPrefix(line, "@@"):
+			inHunk = true
+		case !inHunk && strings.HasPrefix(line, "+++ b/"):
+			current.Path = filepath.Join(rootDir, filepath.FromSlash(strings.TrimPrefix(line, "+++ b/")))
+		case inHunk && strings.HasPrefix(line, "+"):
+			current.AddedLines++
+		case inHunk && strings.HasPrefix(line, "-"):
+			current.RemovedLines++
+		}
+	}
+	flush()
+	return diffs
+}
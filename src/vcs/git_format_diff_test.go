@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package vcs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parse_git_format_diff(t *testing.T) {
+	rootDir := filepath.Join("root", "dir")
+	tests := []struct {
+		desc     string
+		diff     string
+		expected FileDiffs
+	}{
+		{
+			"empty diff",
+			"",
+			nil,
+		},
+		{
+			"modified file",
+			"diff --git a/src/file.go b/src/file.go\n" +
+				"index 1234567..89abcde 100644\n" +
+				"--- a/src/file.go\n" +
+				"+++ b/src/file.go\n" +
+				"@@ -1,3 +1,4 @@\n" +
+				" unchanged\n" +
+				"-removed\n" +
+				"+added 1\n" +
+				"+added 2\n",
+			FileDiffs{NewFileDiff(filepath.Join(rootDir, "src", "file.go"), 2, 1)},
+		},
+		{
+			"added and deleted files",
+			"diff --git a/new.txt b/new.txt\n" +
+				"new file mode 100644\n" +
+				"--- /dev/null\n" +
+				"+++ b/new.txt\n" +
+				"@@ -0,0 +1,1 @@\n" +
+				"+--- not a header\n" +
+				"diff --git a/old.txt b/old.txt\n" +
+				"deleted file mode 100644\n" +
+				"--- a/old.txt\n" +
+				"+++ /dev/null\n" +
+				"@@ -1,2 +0,0 @@\n" +
+				"-line 1\n" +
+				"-+++ line 2\n",
+			FileDiffs{
+				NewFileDiff(filepath.Join(rootDir, "new.txt"), 1, 0),
+				NewFileDiff(filepath.Join(rootDir, "old.txt"), 0, 2),
+			},
+		},
+		{
+			"binary file",
+			"diff --git a/image.png b/image.png\n" +
+				"new file mode 100644\n" +
+				"Binary files /dev/null and b/image.png differ\n",
+			FileDiffs{NewFileDiff(filepath.Join(rootDir, "image.png"), 0, 0)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.Equal(t, test.expected, ParseGitFormatDiff(rootDir, []byte(test.diff)))
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package hg
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/murex/tcr/vcs/shell"
+)
+
+func init() {
+	shell.NewCommandFunc = shell.NewCommand
+}
+
+func newHgCommand(params ...string) shell.Command {
+	return shell.NewCommandFunc("hg", params...)
+}
+
+func newHgCommandImpl(params ...string) *shell.CommandImpl {
+	return shell.NewCommandImpl("hg", params...)
+}
+
+// IsHgCommandAvailable indicates if hg command is available on local machine
+func IsHgCommandAvailable() bool {
+	return newHgCommand().IsInPath()
+}
+
+// GetHgCommandPath returns the path to hg command on this machine
+func GetHgCommandPath() string {
+	return newHgCommand().GetFullPath()
+}
+
+// GetHgCommandVersion returns the version of hg command on this machine
+func GetHgCommandVersion() string {
+	hgOutput, err := runHgCommand("version", "--quiet")
+	if err != nil {
+		return "unknown"
+	}
+	// Output: "Mercurial Distributed SCM (version <version>)"
+	scanner := bufio.NewScanner(bytes.NewReader(hgOutput))
+	scanner.Scan()
+	_, version, found := strings.Cut(scanner.Text(), "(version ")
+	if !found {
+		return "unknown"
+	}
+	return strings.TrimSuffix(version, ")")
+}
+
+// GetHgUserName returns the user name retrieved from local hg configuration
+func GetHgUserName() string {
+	hgOutput, err := runHgCommand("config", "ui.username")
+	if err != nil || hgOutput == nil || len(bytes.Trim(hgOutput, "\r\n")) == 0 {
+		return "not set"
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(hgOutput))
+	scanner.Scan()
+	return scanner.Text()
+}
+
+// GetHgRootDir returns the root directory of the hg repository containing dir
+func GetHgRootDir(dir string) (string, error) {
+	hgOutput, err := runHgCommand("root", "--cwd", dir)
+	if err != nil {
+		return "", fmt.Errorf("directory %s does not belong to a mercurial repository", dir)
+	}
+	return strings.Trim(string(hgOutput), "\r\n"), nil
+}
+
+// traceHgCommand calls hg command and reports its output traces
+func traceHgCommand(params ...string) error {
+	return newHgCommand().Trace(params...)
+}
+
+// runHgCommand calls hg command in a separate process and returns its output traces
+func runHgCommand(params ...string) (output []byte, err error) {
+	return newHgCommand().Run(params...)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package hg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/murex/tcr/vcs/shell"
+	"github.com/stretchr/testify/assert"
+)
+
+func stubHgCommand(output string, err error) {
+	shell.NewCommandFunc = func(_ string, _ ...string) shell.Command {
+		stub := NewHgCommandStub()
+		stub.RunFunc = func(_ ...string) ([]byte, error) {
+			return []byte(output), err
+		}
+		stub.TraceFunc = func(_ ...string) error {
+			return err
+		}
+		return stub
+	}
+}
+
+func Test_is_hg_command_available(t *testing.T) {
+	tests := []struct {
+		desc     string
+		inPath   bool
+		expected bool
+	}{
+		{"hg command found", true, true},
+		{"hg command not found", false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer RestoreHgCommand()
+			shell.NewCommandFunc = func(_ string, _ ...string) shell.Command {
+				stub := NewHgCommandStub()
+				stub.IsInPathFunc = func() bool {
+					return test.inPath
+				}
+				return stub
+			}
+			assert.Equal(t, test.expected, IsHgCommandAvailable())
+		})
+	}
+}
+
+func Test_get_hg_command_path(t *testing.T) {
+	defer RestoreHgCommand()
+	shell.NewCommandFunc = func(_ string, _ ...string) shell.Command {
+		stub := NewHgCommandStub()
+		stub.GetFullPathFunc = func() string {
+			return "/some-path/hg"
+		}
+		return stub
+	}
+	assert.Equal(t, "/some-path/hg", GetHgCommandPath())
+}
+
+func Test_get_hg_command_version(t *testing.T) {
+	tests := []struct {
+		desc     string
+		output   string
+		err      error
+		expected string
+	}{
+		{"hg command found", "Mercurial Distributed SCM (version 6.7.2)\n", nil, "6.7.2"},
+		{"unexpected output", "something else\n", nil, "unknown"},
+		{"hg command not found", "", errors.New("hg command not found"), "unknown"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer RestoreHgCommand()
+			stubHgCommand(test.output, test.err)
+			assert.Equal(t, test.expected, GetHgCommandVersion())
+		})
+	}
+}
+
+func Test_get_hg_username(t *testing.T) {
+	tests := []struct {
+		desc     string
+		output   string
+		err      error
+		expected string
+	}{
+		{"username set", "Jane Doe <jane@example.com>\n", nil, "Jane Doe <jane@example.com>"},
+		{"username not set", "", errors.New("exit status 1"), "not set"},
+		{"username empty", "\n", nil, "not set"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer RestoreHgCommand()
+			stubHgCommand(test.output, test.err)
+			assert.Equal(t, test.expected, GetHgUserName())
+		})
+	}
+}
+
+func Test_get_hg_root_dir(t *testing.T) {
+	tests := []struct {
+		desc        string
+		output      string
+		err         error
+		expectError bool
+		expected    string
+	}{
+		{"in a hg repository", "/root-dir\n", nil, false, "/root-dir"},
+		{"not in a hg repository", "", errors.New("exit status 255"), true, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer RestoreHgCommand()
+			stubHgCommand(test.output, test.err)
+			dir, err := GetHgRootDir("/root-dir/base-dir")
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, dir)
+		})
+	}
+}
+
+func Test_run_and_trace_hg_command(t *testing.T) {
+	defer RestoreHgCommand()
+	stubHgCommand("some output", errors.New("some error"))
+	output, err := runHgCommand("status")
+	assert.Equal(t, "some output", string(output))
+	assert.Error(t, err)
+	assert.Error(t, traceHgCommand("status"))
+}
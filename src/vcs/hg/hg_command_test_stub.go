@@ -0,0 +1,39 @@
+//go:build test_helper
+
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package hg
+
+import "github.com/murex/tcr/vcs/shell"
+
+// NewHgCommandStub creates a new hg shell command stub
+func NewHgCommandStub() *shell.CommandStub {
+	return shell.NewCommandStub(*newHgCommandImpl())
+}
+
+// RestoreHgCommand puts back shell.NewCommandFunc to normal. This function should
+// be deferred in every test case using NewHgCommandStub() to prevent side effects
+// on other test cases.
+func RestoreHgCommand() {
+	shell.NewCommandFunc = shell.NewCommand
+}
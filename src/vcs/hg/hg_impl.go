@@ -0,0 +1,444 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package hg
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/murex/tcr/helpers"
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/vcs"
+)
+
+// Name provides the name for this VCS implementation
+const Name = "hg"
+
+// defaultRemoteName is the name of the path used by hg when no remote is specified
+const defaultRemoteName = "default"
+
+// defaultBranchName is the name of hg default branch
+const defaultBranchName = "default"
+
+// hgImpl provides the implementation of the Mercurial interface
+type hgImpl struct {
+	baseDir         string
+	rootDir         string
+	remoteName      string
+	remoteEnabled   bool
+	autoPushEnabled bool
+	workingBranch   string
+	runHgFunction   func(params ...string) (output []byte, err error)
+	traceHgFunction func(params ...string) (err error)
+}
+
+// New initializes the hg implementation based on the provided directory from local clone
+func New(dir string, remoteName string) (vcs.Interface, error) {
+	return newHgImpl(dir, remoteName, false)
+}
+
+func newHgImpl(dir string, remoteName string, testFlag bool) (*hgImpl, error) {
+	var h = hgImpl{
+		baseDir:         dir,
+		autoPushEnabled: vcs.DefaultAutoPushEnabled,
+		runHgFunction:   runHgCommand,
+		traceHgFunction: traceHgCommand,
+	}
+
+	if testFlag {
+		// For test purpose only: tests should run and pass without having hg installed
+		h.rootDir = dir
+		h.workingBranch = defaultBranchName
+		return &h, nil
+	}
+
+	var err error
+	h.rootDir, err = GetHgRootDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	h.workingBranch, err = h.retrieveWorkingBranch()
+	if err != nil {
+		return nil, err
+	}
+	h.remoteName, h.remoteEnabled = h.retrieveRemote(remoteName)
+	return &h, nil
+}
+
+// retrieveWorkingBranch returns the name of the hg branch of the working directory
+func (h *hgImpl) retrieveWorkingBranch() (string, error) {
+	hgOutput, err := h.runHg("branch")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(hgOutput)), nil
+}
+
+// retrieveRemote returns the name of the hg path to be used as remote. The provided
+// remoteName is used when it's defined in hg paths, otherwise hg default path is used
+func (h *hgImpl) retrieveRemote(remoteName string) (string, bool) {
+	for _, name := range []string{remoteName, defaultRemoteName} {
+		if name == "" {
+			continue
+		}
+		if _, err := h.runHg("paths", name); err == nil {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// Name returns VCS name
+func (*hgImpl) Name() string {
+	return Name
+}
+
+// SessionSummary provides a short description related to current VCS session summary
+func (h *hgImpl) SessionSummary() string {
+	return fmt.Sprintf("%s branch \"%s\"", h.Name(), h.GetWorkingBranch())
+}
+
+// GetRootDir returns the root directory path
+func (h *hgImpl) GetRootDir() string {
+	return h.rootDir
+}
+
+// GetRemoteName returns the current hg remote name
+func (h *hgImpl) GetRemoteName() string {
+	return h.remoteName
+}
+
+// IsRemoteEnabled indicates if hg remote operations are enabled
+func (h *hgImpl) IsRemoteEnabled() bool {
+	return h.remoteEnabled
+}
+
+// GetWorkingBranch returns the current hg working branch
+func (h *hgImpl) GetWorkingBranch() string {
+	return h.workingBranch
+}
+
+// IsOnRootBranch indicates if hg is currently on its default branch or not.
+func (h *hgImpl) IsOnRootBranch() bool {
+	return h.GetWorkingBranch() == defaultBranchName
+}
+
+// scopePath returns the path of the base directory relative to the root directory.
+// hg operations are restricted to this path
+func (h *hgImpl) scopePath() string {
+	rel, err := filepath.Rel(h.rootDir, h.baseDir)
+	if err != nil || h.baseDir == "" {
+		return "."
+	}
+	return rel
+}
+
+// Add adds the listed paths to hg, including new and missing files.
+func (h *hgImpl) Add(paths ...string) error {
+	hgArgs := []string{"addremove"}
+	if len(paths) == 0 {
+		hgArgs = append(hgArgs, h.scopePath())
+	} else {
+		hgArgs = append(hgArgs, paths...)
+	}
+	return h.traceHg(hgArgs...)
+}
+
+// Commit commits changes located in the base directory to hg.
+// hg fails when there is nothing to commit, hence the check done beforehand
+func (h *hgImpl) Commit(messages ...string) error {
+	if h.nothingToCommit() {
+		return nil
+	}
+	return h.traceHg("commit", "--message", strings.Join(messages, "\n\n"), h.scopePath())
+}
+
+// nothingToCommit returns true if there is nothing to commit
+func (h *hgImpl) nothingToCommit() bool {
+	hgOutput, err := h.runHg("status", "--modified", "--added", "--removed", h.scopePath())
+	return err == nil && strings.TrimSpace(string(hgOutput)) == ""
+}
+
+// RevertLocal restores to last commit for the provided path.
+func (h *hgImpl) RevertLocal(path string) error {
+	report.PostWarning("Reverting ", path)
+	return h.traceHg("revert", "--no-backup", path)
+}
+
+// RollbackLastCommit reverts the changes of the last commit in the working directory,
+// without committing them.
+func (h *hgImpl) RollbackLastCommit() error {
+	report.PostInfo("Reverting changes")
+	return h.traceHg("backout", "--no-commit", "--rev", ".")
+}
+
+// Push runs a hg push operation.
+func (h *hgImpl) Push() error {
+	if !h.IsRemoteEnabled() {
+		// There's nothing to do in this case
+		return nil
+	}
+	report.PostInfo("Pushing changes to ", h.GetRemoteName(), "/", h.GetWorkingBranch())
+	return h.traceHg("push", "--new-branch", "--rev", ".", h.GetRemoteName())
+}
+
+// Pull runs a hg pull operation, and updates the working directory.
+func (h *hgImpl) Pull() error {
+	if !h.IsRemoteEnabled() {
+		report.PostInfo("Working locally on branch ", h.GetWorkingBranch())
+		return nil
+	}
+	report.PostInfo("Pulling latest changes from ", h.GetRemoteName(), "/", h.GetWorkingBranch())
+	return h.traceHg("pull", "--update", "--branch", h.GetWorkingBranch(), h.GetRemoteName())
+}
+
+// Diff returns the list of files modified since last commit with diff info for each file
+func (h *hgImpl) Diff() (diffs vcs.FileDiffs, err error) {
+	var hgOutput []byte
+	hgOutput, err = h.runHg("diff", "--git", h.scopePath())
+	if err != nil {
+		return nil, err
+	}
+	return vcs.ParseGitFormatDiff(h.rootDir, hgOutput), nil
+}
+
+// Field and record separators used when parsing hg log output
+const (
+	logFieldSeparator  = "\x1f"
+	logRecordSeparator = "\x1e"
+)
+
+// logTemplate is the hg template used for retrieving log items: node, unix timestamp
+// and timezone offset, author, and full description
+const logTemplate = "{node}\\x1f{date|hgdate}\\x1f{author}\\x1f{desc}\\x1e"
+
+// Log returns the list of hg changesets touching the base directory compliant with the provided
+// msgFilter and filter, from the most recent. When no msgFilter is provided, returns
+// all hg changesets matching filter. When filter has no branch, only the ancestors of the
+// working directory parent are returned, leaving out other heads of the repository.
+func (h *hgImpl) Log(msgFilter func(msg string) bool, filter vcs.LogFilter) (logs vcs.LogItems, err error) {
+	hgArgs := []string{"log", "--template", logTemplate}
+	if len(filter.Branches) == 0 {
+		hgArgs = append(hgArgs, "--rev", "ancestors(.)")
+	}
+	for _, branch := range filter.Branches {
+		hgArgs = append(hgArgs, "--branch", branch)
+	}
+	var hgOutput []byte
+	hgOutput, err = h.runHg(append(hgArgs, h.scopePath())...)
+	if err != nil {
+		return nil, err
+	}
+
+	for record := range strings.SplitSeq(string(hgOutput), logRecordSeparator) {
+		fields := strings.SplitN(strings.TrimLeft(record, "\r\n"), logFieldSeparator, 4)
+		if len(fields) != 4 { //nolint:revive
+			continue
+		}
+		timestamp := parseHgDate(fields[1])
+		if !filter.IncludesTime(timestamp) || !filter.IncludesAuthor(fields[2]) {
+			continue
+		}
+		if msgFilter == nil || msgFilter(fields[3]) {
+			logs.Add(vcs.NewLogItem(fields[0], timestamp, fields[3]))
+		}
+	}
+	return logs, nil
+}
+
+// parseHgDate converts a date in hgdate format ("<unix timestamp> <timezone offset>") into a time
+func parseHgDate(hgDate string) time.Time {
+	fields := strings.Fields(hgDate)
+	if len(fields) == 0 {
+		return time.Time{}
+	}
+	seconds, _ := strconv.ParseInt(fields[0], 10, 64)
+	return time.Unix(seconds, 0).UTC()
+}
+
+// commitFilesTemplate is the hg template used for retrieving the files added or modified by a changeset
+const commitFilesTemplate = "{file_adds % '{file}\\n'}{file_mods % '{file}\\n'}"
+
+// CommitFiles returns the path of the files added or modified in the changeset
+// with the provided id, restricted to the base directory
+func (h *hgImpl) CommitFiles(id string) (files []string, err error) {
+	var hgOutput []byte
+	hgOutput, err = h.runHg("log", "--rev", id, "--template", commitFilesTemplate)
+	if err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(hgOutput))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		path := filepath.Join(h.rootDir, filepath.FromSlash(line))
+		if helpers.IsSubPathOf(path, filepath.Join(h.rootDir, h.scopePath())) {
+			files = append(files, path)
+		}
+	}
+	return files, nil
+}
+
+// shelvePrefix is the prefix of the names given to hg shelves created by TCR.
+// It's followed by the shelve creation unix timestamp
+const shelvePrefix = "tcr-"
+
+// Shelve saves local changes for the provided paths into a hg shelve
+// and reverts these paths to their last committed revision.
+func (h *hgImpl) Shelve(paths []string, messages ...string) error {
+	report.PostWarning("Shelving ", len(paths), " file(s)")
+	hgArgs := []string{"shelve", "--addremove",
+		"--name", shelvePrefix + strconv.FormatInt(time.Now().Unix(), 10),
+		"--message", strings.Join(messages, "\n\n")}
+	return h.traceHg(append(hgArgs, paths...)...)
+}
+
+// ListShelved returns the list of hg shelves compliant with the provided msgFilter.
+// When no msgFilter is provided, returns all hg shelves unfiltered.
+// Only the first line of each shelve message is available
+func (h *hgImpl) ListShelved(msgFilter func(msg string) bool) (items vcs.ShelfItems, err error) {
+	var hgOutput []byte
+	hgOutput, err = h.runHg("shelve", "--list")
+	if err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(hgOutput))
+	for scanner.Scan() {
+		// <name>   (<age>)    <message>
+		name, rest, _ := strings.Cut(scanner.Text(), " ")
+		if name == "" {
+			continue
+		}
+		_, message, _ := strings.Cut(rest, ")")
+		message = strings.TrimSpace(message)
+		var timestamp time.Time
+		if seconds, err := strconv.ParseInt(strings.TrimPrefix(name, shelvePrefix), 10, 64); err == nil {
+			timestamp = time.Unix(seconds, 0).UTC()
+		}
+		if msgFilter == nil || msgFilter(message) {
+			items.Add(vcs.NewShelfItem(name, timestamp, message))
+		}
+	}
+	return items, nil
+}
+
+// Unshelve restores the files saved in the hg shelve matching the provided id.
+// The shelve is kept so that it can be recovered again if needed.
+func (h *hgImpl) Unshelve(id string) error {
+	report.PostInfo("Unshelving ", id)
+	return h.traceHg("unshelve", "--keep", "--name", id)
+}
+
+// EnableAutoPush sets a flag allowing to turn on/off hg auto push operations
+func (h *hgImpl) EnableAutoPush(flag bool) {
+	if h.autoPushEnabled == flag {
+		return
+	}
+	h.autoPushEnabled = flag
+	autoPushStr := "off"
+	if h.autoPushEnabled {
+		autoPushStr = "on"
+	}
+	report.PostInfo(fmt.Sprintf("Mercurial auto-push is turned %v", autoPushStr))
+}
+
+// IsAutoPushEnabled indicates if hg auto-push operations are turned on
+func (h *hgImpl) IsAutoPushEnabled() bool {
+	return h.autoPushEnabled
+}
+
+// CheckRemoteAccess returns true if hg remote can be accessed. This is done through
+// retrieving the remote's tip identifier.
+func (h *hgImpl) CheckRemoteAccess() bool {
+	if !h.IsRemoteEnabled() {
+		return false
+	}
+	_, err := h.runHg("identify", "--id", h.GetRemoteName())
+	return err == nil
+}
+
+// SupportsEmojis indicates if the VCS supports emojis in commit messages (true in case of hg)
+func (*hgImpl) SupportsEmojis() bool {
+	return true
+}
+
+// SupportsSnapshots indicates if the VCS supports running TCR operations
+// in an isolated snapshot of the working tree (false in case of hg)
+func (*hgImpl) SupportsSnapshots() bool {
+	return false
+}
+
+// TakeSnapshot is not available for hg
+func (*hgImpl) TakeSnapshot() (*vcs.Snapshot, error) {
+	return nil, errors.New("VCS snapshot operation not available for hg")
+}
+
+// CommitSnapshot is not available for hg
+func (*hgImpl) CommitSnapshot(_ *vcs.Snapshot, _ ...string) error {
+	return errors.New("VCS snapshot operation not available for hg")
+}
+
+// DropSnapshot is not available for hg
+func (*hgImpl) DropSnapshot(_ *vcs.Snapshot) error {
+	return errors.New("VCS snapshot operation not available for hg")
+}
+
+// SupportsHandover indicates if the VCS supports handing over uncommitted
+// work to another workstation (false in case of hg)
+func (*hgImpl) SupportsHandover() bool {
+	return false
+}
+
+// PushHandover is not available for hg
+func (*hgImpl) PushHandover(_ ...string) (bool, error) {
+	return false, errors.New("VCS handover operation not available for hg")
+}
+
+// PullHandover is not available for hg
+func (*hgImpl) PullHandover() (bool, error) {
+	return false, errors.New("VCS handover operation not available for hg")
+}
+
+// traceHg runs a hg command and traces its output.
+// The command is launched from the hg root directory
+func (h *hgImpl) traceHg(args ...string) error {
+	return h.traceHgFunction(h.buildHgArgs(args...)...)
+}
+
+// runHg calls hg command in a separate process and returns its output traces
+// The command is launched from the hg root directory
+func (h *hgImpl) runHg(args ...string) (output []byte, err error) {
+	return h.runHgFunction(h.buildHgArgs(args...)...)
+}
+
+func (h *hgImpl) buildHgArgs(args ...string) []string {
+	return append([]string{"--cwd", h.GetRootDir(), "--noninteractive"}, args...)
+}
@@ -0,0 +1,407 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package hg
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/vcs"
+	"github.com/stretchr/testify/assert"
+)
+
+// hgCall records the arguments of a hg command call, without the ones
+// added by buildHgArgs()
+type hgCall []string
+
+// newHgImplStub creates a hgImpl instance for the provided base directory. Calls to hg
+// are recorded in calls, and answered through the provided outputs map (keyed by
+// the space-separated list of hg command arguments)
+func newHgImplStub(t *testing.T, dir string, outputs map[string]string, errs map[string]error,
+) (*hgImpl, *[]hgCall) {
+	t.Helper()
+	h, err := newHgImpl(dir, "", true)
+	assert.NoError(t, err)
+	var calls []hgCall
+	h.runHgFunction = func(params ...string) ([]byte, error) {
+		args := params[3:]
+		calls = append(calls, args)
+		key := strings.Join(args, " ")
+		return []byte(outputs[key]), errs[key]
+	}
+	h.traceHgFunction = func(params ...string) error {
+		args := params[3:]
+		calls = append(calls, args)
+		return errs[strings.Join(args, " ")]
+	}
+	return h, &calls
+}
+
+func Test_get_vcs_name(t *testing.T) {
+	h, _ := newHgImpl("", "", true)
+	assert.Equal(t, "hg", h.Name())
+}
+
+func Test_get_vcs_session_summary(t *testing.T) {
+	h, _ := newHgImpl("", "", true)
+	assert.Equal(t, "hg branch \"default\"", h.SessionSummary())
+}
+
+func Test_hg_is_on_root_branch(t *testing.T) {
+	tests := []struct {
+		branch   string
+		expected bool
+	}{
+		{"default", true},
+		{"feature", false},
+	}
+	for _, test := range tests {
+		t.Run(test.branch, func(t *testing.T) {
+			h, _ := newHgImpl("", "", true)
+			h.workingBranch = test.branch
+			assert.Equal(t, test.expected, h.IsOnRootBranch())
+		})
+	}
+}
+
+func Test_hg_build_args(t *testing.T) {
+	h, _ := newHgImpl("/repo", "", true)
+	assert.Equal(t, []string{"--cwd", "/repo", "--noninteractive", "status"}, h.buildHgArgs("status"))
+}
+
+func Test_hg_retrieve_remote(t *testing.T) {
+	tests := []struct {
+		desc            string
+		remoteName      string
+		pathsErrs       map[string]error
+		expectedName    string
+		expectedEnabled bool
+	}{
+		{
+			"provided remote exists",
+			"upstream",
+			nil,
+			"upstream", true,
+		},
+		{
+			"provided remote does not exist",
+			"origin",
+			map[string]error{"paths origin": errors.New("not found!")},
+			"default", true,
+		},
+		{
+			"no remote path",
+			"origin",
+			map[string]error{
+				"paths origin":  errors.New("not found!"),
+				"paths default": errors.New("not found!"),
+			},
+			"", false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			h, _ := newHgImplStub(t, "", nil, test.pathsErrs)
+			name, enabled := h.retrieveRemote(test.remoteName)
+			assert.Equal(t, test.expectedName, name)
+			assert.Equal(t, test.expectedEnabled, enabled)
+		})
+	}
+}
+
+func Test_hg_add(t *testing.T) {
+	tests := []struct {
+		desc     string
+		paths    []string
+		expected []hgCall
+	}{
+		{"no path", nil, []hgCall{{"addremove", "."}}},
+		{"some paths", []string{"a.txt", "b.txt"}, []hgCall{{"addremove", "a.txt", "b.txt"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			h, calls := newHgImplStub(t, "", nil, nil)
+			assert.NoError(t, h.Add(test.paths...))
+			assert.Equal(t, test.expected, *calls)
+		})
+	}
+}
+
+func Test_hg_commands_are_scoped_to_base_dir(t *testing.T) {
+	h, calls := newHgImplStub(t, filepath.Join("repo", "app"), nil, nil)
+	h.rootDir = "repo"
+	assert.NoError(t, h.Add())
+	assert.Equal(t, []hgCall{{"addremove", "app"}}, *calls)
+}
+
+func Test_hg_commit(t *testing.T) {
+	tests := []struct {
+		desc     string
+		status   string
+		expected []hgCall
+	}{
+		{
+			"nothing to commit",
+			"",
+			[]hgCall{{"status", "--modified", "--added", "--removed", "."}},
+		},
+		{
+			"changes to commit",
+			"M file.txt\n",
+			[]hgCall{
+				{"status", "--modified", "--added", "--removed", "."},
+				{"commit", "--message", "main message\n\nadditional message", "."},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			h, calls := newHgImplStub(t, "",
+				map[string]string{"status --modified --added --removed .": test.status}, nil)
+			assert.NoError(t, h.Commit("main message", "additional message"))
+			assert.Equal(t, test.expected, *calls)
+		})
+	}
+}
+
+func Test_hg_revert_local(t *testing.T) {
+	h, calls := newHgImplStub(t, "", nil, nil)
+	assert.NoError(t, h.RevertLocal("some-path"))
+	assert.Equal(t, []hgCall{{"revert", "--no-backup", "some-path"}}, *calls)
+}
+
+func Test_hg_rollback_last_commit(t *testing.T) {
+	h, calls := newHgImplStub(t, "", nil, nil)
+	assert.NoError(t, h.RollbackLastCommit())
+	assert.Equal(t, []hgCall{{"backout", "--no-commit", "--rev", "."}}, *calls)
+}
+
+func Test_hg_push_and_pull(t *testing.T) {
+	tests := []struct {
+		desc          string
+		remoteEnabled bool
+		expected      []hgCall
+	}{
+		{"remote disabled", false, nil},
+		{
+			"remote enabled", true,
+			[]hgCall{
+				{"push", "--new-branch", "--rev", ".", "default"},
+				{"pull", "--update", "--branch", "default", "default"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			h, calls := newHgImplStub(t, "", nil, nil)
+			h.remoteName, h.remoteEnabled = "default", test.remoteEnabled
+			assert.NoError(t, h.Push())
+			assert.NoError(t, h.Pull())
+			assert.Equal(t, test.expected, *calls)
+		})
+	}
+}
+
+func Test_hg_diff(t *testing.T) {
+	h, _ := newHgImplStub(t, "repo", map[string]string{
+		"diff --git .": "diff --git a/file.txt b/file.txt\n" +
+			"--- a/file.txt\n" +
+			"+++ b/file.txt\n" +
+			"@@ -1,1 +1,2 @@\n" +
+			"-old\n" +
+			"+new 1\n" +
+			"+new 2\n",
+	}, nil)
+	diffs, err := h.Diff()
+	assert.NoError(t, err)
+	assert.Equal(t, vcs.FileDiffs{vcs.NewFileDiff(filepath.Join("repo", "file.txt"), 2, 1)}, diffs)
+}
+
+func Test_hg_diff_error(t *testing.T) {
+	h, _ := newHgImplStub(t, "", nil, map[string]error{"diff --git .": errors.New("hg diff error")})
+	_, err := h.Diff()
+	assert.Error(t, err)
+}
+
+func Test_hg_log(t *testing.T) {
+	output := "abc123\x1f1700000000 -3600\x1fJane <jane@example.com>\x1f✅ TCR - tests passing\n\nmore\x1e" +
+		"\ndef456\x1f1600000000 0\x1fJohn <john@example.com>\x1fsome other message\x1e\n"
+	tests := []struct {
+		desc      string
+		msgFilter func(msg string) bool
+		filter    vcs.LogFilter
+		expected  vcs.LogItems
+	}{
+		{
+			"no filter",
+			nil,
+			vcs.LogFilter{},
+			vcs.LogItems{
+				vcs.NewLogItem("abc123", time.Unix(1700000000, 0).UTC(), "✅ TCR - tests passing\n\nmore"),
+				vcs.NewLogItem("def456", time.Unix(1600000000, 0).UTC(), "some other message"),
+			},
+		},
+		{
+			"message filter",
+			func(msg string) bool { return strings.Contains(msg, "TCR") },
+			vcs.LogFilter{},
+			vcs.LogItems{
+				vcs.NewLogItem("abc123", time.Unix(1700000000, 0).UTC(), "✅ TCR - tests passing\n\nmore"),
+			},
+		},
+		{
+			"author filter",
+			nil,
+			vcs.LogFilter{Authors: []string{"john"}},
+			vcs.LogItems{
+				vcs.NewLogItem("def456", time.Unix(1600000000, 0).UTC(), "some other message"),
+			},
+		},
+		{
+			"time filter",
+			nil,
+			vcs.LogFilter{Since: time.Unix(1650000000, 0)},
+			vcs.LogItems{
+				vcs.NewLogItem("abc123", time.Unix(1700000000, 0).UTC(), "✅ TCR - tests passing\n\nmore"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			h, _ := newHgImplStub(t, "", map[string]string{
+				"log --template " + logTemplate + " --rev ancestors(.) .": output,
+			}, nil)
+			logs, err := h.Log(test.msgFilter, test.filter)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, logs)
+		})
+	}
+}
+
+func Test_hg_log_ignores_other_heads(t *testing.T) {
+	current := "abc123\x1f1700000000 0\x1fJane <jane@example.com>\x1f✅ TCR - tests passing\x1e\n"
+	other := "def456\x1f1700000100 0\x1fJohn <john@example.com>\x1f✅ TCR - tests passing\x1e\n"
+	h, _ := newHgImplStub(t, "", map[string]string{
+		"log --template " + logTemplate + " --rev ancestors(.) .": current,
+		"log --template " + logTemplate + " .":                    other + current,
+	}, nil)
+	logs, err := h.Log(nil, vcs.LogFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, vcs.LogItems{
+		vcs.NewLogItem("abc123", time.Unix(1700000000, 0).UTC(), "✅ TCR - tests passing"),
+	}, logs)
+}
+
+func Test_hg_log_with_branch_filter(t *testing.T) {
+	h, calls := newHgImplStub(t, "", nil, nil)
+	_, err := h.Log(nil, vcs.LogFilter{Branches: []string{"b1", "b2"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []hgCall{{"log", "--template", logTemplate, "--branch", "b1", "--branch", "b2", "."}}, *calls)
+}
+
+func Test_hg_commit_files(t *testing.T) {
+	h, _ := newHgImplStub(t, filepath.Join("repo", "app"), map[string]string{
+		"log --rev abc123 --template " + commitFilesTemplate: "app/new.txt\nother/file.txt\napp/file.txt\n",
+	}, nil)
+	h.rootDir = "repo"
+	files, err := h.CommitFiles("abc123")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join("repo", "app", "new.txt"),
+		filepath.Join("repo", "app", "file.txt"),
+	}, files)
+}
+
+func Test_hg_shelve(t *testing.T) {
+	h, calls := newHgImplStub(t, "", nil, nil)
+	assert.NoError(t, h.Shelve([]string{"a.txt"}, "main message", "additional message"))
+	assert.Len(t, *calls, 1)
+	call := (*calls)[0]
+	assert.Equal(t, []string{"shelve", "--addremove", "--name"}, []string(call[:3]))
+	assert.True(t, strings.HasPrefix(call[3], shelvePrefix))
+	assert.Equal(t, []string{"--message", "main message\n\nadditional message", "a.txt"}, []string(call[4:]))
+}
+
+func Test_hg_list_shelved(t *testing.T) {
+	h, _ := newHgImplStub(t, "", map[string]string{
+		"shelve --list": "tcr-1700000000  (2m ago)    🧊 TCR - shelved changes\n" +
+			"manual          (3d ago)    some work in progress\n",
+	}, nil)
+	items, err := h.ListShelved(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, vcs.ShelfItems{
+		vcs.NewShelfItem("tcr-1700000000", time.Unix(1700000000, 0).UTC(), "🧊 TCR - shelved changes"),
+		vcs.NewShelfItem("manual", time.Time{}, "some work in progress"),
+	}, items)
+}
+
+func Test_hg_unshelve(t *testing.T) {
+	h, calls := newHgImplStub(t, "", nil, nil)
+	assert.NoError(t, h.Unshelve("tcr-1700000000"))
+	assert.Equal(t, []hgCall{{"unshelve", "--keep", "--name", "tcr-1700000000"}}, *calls)
+}
+
+func Test_hg_enable_auto_push(t *testing.T) {
+	h, _ := newHgImpl("", "", true)
+	assert.False(t, h.IsAutoPushEnabled())
+	h.EnableAutoPush(true)
+	assert.True(t, h.IsAutoPushEnabled())
+}
+
+func Test_hg_check_remote_access(t *testing.T) {
+	tests := []struct {
+		desc          string
+		remoteEnabled bool
+		identifyErr   error
+		expected      bool
+	}{
+		{"remote disabled", false, nil, false},
+		{"remote accessible", true, nil, true},
+		{"remote not accessible", true, errors.New("abort"), false},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			h, _ := newHgImplStub(t, "", nil, map[string]error{"identify --id default": test.identifyErr})
+			h.remoteName, h.remoteEnabled = "default", test.remoteEnabled
+			assert.Equal(t, test.expected, h.CheckRemoteAccess())
+		})
+	}
+}
+
+func Test_hg_unsupported_operations(t *testing.T) {
+	h, _ := newHgImpl("", "", true)
+	assert.True(t, h.SupportsEmojis())
+	assert.False(t, h.SupportsSnapshots())
+	assert.False(t, h.SupportsHandover())
+	_, err := h.TakeSnapshot()
+	assert.Error(t, err)
+	assert.Error(t, h.CommitSnapshot(nil))
+	assert.Error(t, h.DropSnapshot(nil))
+	_, err = h.PushHandover()
+	assert.Error(t, err)
+	_, err = h.PullHandover()
+	assert.Error(t, err)
+}
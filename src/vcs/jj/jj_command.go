@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package jj
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/murex/tcr/vcs/shell"
+)
+
+func init() {
+	shell.NewCommandFunc = shell.NewCommand
+}
+
+func newJjCommand(params ...string) shell.Command {
+	return shell.NewCommandFunc("jj", params...)
+}
+
+func newJjCommandImpl(params ...string) *shell.CommandImpl {
+	return shell.NewCommandImpl("jj", params...)
+}
+
+// IsJjCommandAvailable indicates if jj command is available on local machine
+func IsJjCommandAvailable() bool {
+	return newJjCommand().IsInPath()
+}
+
+// GetJjCommandPath returns the path to jj command on this machine
+func GetJjCommandPath() string {
+	return newJjCommand().GetFullPath()
+}
+
+// GetJjCommandVersion returns the version of jj command on this machine
+func GetJjCommandVersion() string {
+	jjOutput, err := runJjCommand("--version")
+	if err != nil {
+		return "unknown"
+	}
+	// Output: "jj <version>"
+	scanner := bufio.NewScanner(bytes.NewReader(jjOutput))
+	scanner.Scan()
+	version, found := strings.CutPrefix(scanner.Text(), "jj ")
+	if !found {
+		return "unknown"
+	}
+	return version
+}
+
+// GetJjUserName returns the user name retrieved from local jj configuration
+func GetJjUserName() string {
+	jjOutput, err := runJjCommand("config", "get", "user.name")
+	if err != nil || jjOutput == nil || len(bytes.Trim(jjOutput, "\r\n")) == 0 {
+		return "not set"
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(jjOutput))
+	scanner.Scan()
+	return scanner.Text()
+}
+
+// GetJjRootDir returns the root directory of the jj workspace containing dir.
+// jj root is run from dir, as jj only searches parent directories for the workspace
+// root when the workspace is not provided through --repository
+func GetJjRootDir(dir string) (string, error) {
+	jjOutput, err := runJjCommandInDir(dir, "root")
+	if err != nil {
+		return "", fmt.Errorf("directory %s does not belong to a jujutsu workspace", dir)
+	}
+	return strings.Trim(string(jjOutput), "\r\n"), nil
+}
+
+// traceJjCommand calls jj command and reports its output traces
+func traceJjCommand(params ...string) error {
+	return newJjCommand().Trace(params...)
+}
+
+// runJjCommand calls jj command in a separate process and returns its output traces
+func runJjCommand(params ...string) (output []byte, err error) {
+	return newJjCommand().Run(params...)
+}
+
+// runJjCommandInDir calls jj command in a separate process started from dir and returns its output traces
+func runJjCommandInDir(dir string, params ...string) (output []byte, err error) {
+	return newJjCommand().RunInDir(dir, params...)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package jj
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/murex/tcr/vcs/shell"
+	"github.com/stretchr/testify/assert"
+)
+
+func stubJjCommand(output string, err error) {
+	shell.NewCommandFunc = func(_ string, _ ...string) shell.Command {
+		stub := NewJjCommandStub()
+		stub.RunFunc = func(_ ...string) ([]byte, error) {
+			return []byte(output), err
+		}
+		stub.RunInDirFunc = func(_ string, _ ...string) ([]byte, error) {
+			return []byte(output), err
+		}
+		stub.TraceFunc = func(_ ...string) error {
+			return err
+		}
+		return stub
+	}
+}
+
+func Test_is_jj_command_available(t *testing.T) {
+	tests := []struct {
+		desc     string
+		inPath   bool
+		expected bool
+	}{
+		{"jj command found", true, true},
+		{"jj command not found", false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer RestoreJjCommand()
+			shell.NewCommandFunc = func(_ string, _ ...string) shell.Command {
+				stub := NewJjCommandStub()
+				stub.IsInPathFunc = func() bool {
+					return test.inPath
+				}
+				return stub
+			}
+			assert.Equal(t, test.expected, IsJjCommandAvailable())
+		})
+	}
+}
+
+func Test_get_jj_command_path(t *testing.T) {
+	defer RestoreJjCommand()
+	shell.NewCommandFunc = func(_ string, _ ...string) shell.Command {
+		stub := NewJjCommandStub()
+		stub.GetFullPathFunc = func() string {
+			return "/some-path/jj"
+		}
+		return stub
+	}
+	assert.Equal(t, "/some-path/jj", GetJjCommandPath())
+}
+
+func Test_get_jj_command_version(t *testing.T) {
+	tests := []struct {
+		desc     string
+		output   string
+		err      error
+		expected string
+	}{
+		{"jj command found", "jj 0.34.0\n", nil, "0.34.0"},
+		{"unexpected output", "something else\n", nil, "unknown"},
+		{"jj command not found", "", errors.New("jj command not found"), "unknown"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer RestoreJjCommand()
+			stubJjCommand(test.output, test.err)
+			assert.Equal(t, test.expected, GetJjCommandVersion())
+		})
+	}
+}
+
+func Test_get_jj_username(t *testing.T) {
+	tests := []struct {
+		desc     string
+		output   string
+		err      error
+		expected string
+	}{
+		{"username set", "Jane Doe\n", nil, "Jane Doe"},
+		{"username not set", "", errors.New("exit status 1"), "not set"},
+		{"username empty", "\n", nil, "not set"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer RestoreJjCommand()
+			stubJjCommand(test.output, test.err)
+			assert.Equal(t, test.expected, GetJjUserName())
+		})
+	}
+}
+
+func Test_get_jj_root_dir(t *testing.T) {
+	tests := []struct {
+		desc        string
+		output      string
+		err         error
+		expectError bool
+		expected    string
+	}{
+		{"in a jj workspace", "/root-dir\n", nil, false, "/root-dir"},
+		{"not in a jj workspace", "", errors.New("exit status 255"), true, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			defer RestoreJjCommand()
+			stubJjCommand(test.output, test.err)
+			dir, err := GetJjRootDir("/root-dir/base-dir")
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, dir)
+		})
+	}
+}
+
+func Test_get_jj_root_dir_from_a_sub_directory(t *testing.T) {
+	defer RestoreJjCommand()
+	var runDir string
+	var runParams []string
+	shell.NewCommandFunc = func(_ string, _ ...string) shell.Command {
+		stub := NewJjCommandStub()
+		stub.RunInDirFunc = func(dir string, params ...string) ([]byte, error) {
+			runDir, runParams = dir, params
+			return []byte("/root-dir\n"), nil
+		}
+		return stub
+	}
+	dir, err := GetJjRootDir("/root-dir/base-dir/sub-dir")
+	assert.NoError(t, err)
+	assert.Equal(t, "/root-dir", dir)
+	assert.Equal(t, "/root-dir/base-dir/sub-dir", runDir)
+	assert.Equal(t, []string{"root"}, runParams)
+}
+
+func Test_run_and_trace_jj_command(t *testing.T) {
+	defer RestoreJjCommand()
+	stubJjCommand("some output", errors.New("some error"))
+	output, err := runJjCommand("status")
+	assert.Equal(t, "some output", string(output))
+	assert.Error(t, err)
+	output, err = runJjCommandInDir("/some-dir", "status")
+	assert.Equal(t, "some output", string(output))
+	assert.Error(t, err)
+	assert.Error(t, traceJjCommand("status"))
+}
@@ -0,0 +1,433 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package jj
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/murex/tcr/report"
+	"github.com/murex/tcr/vcs"
+)
+
+// Name provides the name for this VCS implementation
+const Name = "jj"
+
+// jjImpl provides the implementation of the Jujutsu interface.
+//
+// jj has no index: the working copy is itself a commit (@), which jj automatically
+// snapshots every time a jj command is run. Committing is done through "jj commit",
+// which describes @ and starts a new empty working copy commit on top of it.
+// The working branch is the closest jj bookmark in @ ancestors. It is moved forward
+// on every commit
+type jjImpl struct {
+	baseDir         string
+	rootDir         string
+	remoteName      string
+	remoteEnabled   bool
+	autoPushEnabled bool
+	workingBookmark string
+	runJjFunction   func(dir string, params ...string) (output []byte, err error)
+	traceJjFunction func(params ...string) (err error)
+}
+
+// New initializes the jj implementation based on the provided directory from local workspace
+func New(dir string, remoteName string) (vcs.Interface, error) {
+	return newJjImpl(dir, remoteName, false)
+}
+
+func newJjImpl(dir string, remoteName string, testFlag bool) (*jjImpl, error) {
+	var j = jjImpl{
+		baseDir:         dir,
+		autoPushEnabled: vcs.DefaultAutoPushEnabled,
+		runJjFunction:   runJjCommandInDir,
+		traceJjFunction: traceJjCommand,
+	}
+
+	if testFlag {
+		// For test purpose only: tests should run and pass without having jj installed
+		j.rootDir = dir
+		j.workingBookmark = "main"
+		return &j, nil
+	}
+
+	var err error
+	j.rootDir, err = GetJjRootDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	j.workingBookmark, err = j.retrieveWorkingBookmark()
+	if err != nil {
+		return nil, err
+	}
+	j.remoteName, j.remoteEnabled = j.retrieveRemote(remoteName)
+	return &j, nil
+}
+
+// retrieveWorkingBookmark returns the name of the closest jj bookmark in working copy ancestors,
+// or an empty string if there is none
+func (j *jjImpl) retrieveWorkingBookmark() (string, error) {
+	jjOutput, err := j.runJj("log", "--no-graph",
+		"--revisions", "latest(::@- & bookmarks())",
+		"--template", `local_bookmarks.map(|b| b.name()).join("\n")`)
+	if err != nil {
+		return "", err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(jjOutput))
+	scanner.Scan()
+	return strings.TrimSpace(scanner.Text()), nil
+}
+
+// retrieveRemote returns the provided remoteName and true if it's one of jj git remotes.
+// Remote operations are disabled when there is no working bookmark
+func (j *jjImpl) retrieveRemote(remoteName string) (string, bool) {
+	if remoteName == "" || j.workingBookmark == "" {
+		return "", false
+	}
+	jjOutput, err := j.runJj("git", "remote", "list")
+	if err != nil {
+		return "", false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(jjOutput))
+	for scanner.Scan() {
+		// <remote name> <remote url>
+		if name, _, _ := strings.Cut(scanner.Text(), " "); name == remoteName {
+			return remoteName, true
+		}
+	}
+	return "", false
+}
+
+// Name returns VCS name
+func (*jjImpl) Name() string {
+	return Name
+}
+
+// SessionSummary provides a short description related to current VCS session summary
+func (j *jjImpl) SessionSummary() string {
+	if j.workingBookmark == "" {
+		return fmt.Sprintf("%s working copy (no bookmark)", j.Name())
+	}
+	return fmt.Sprintf("%s bookmark \"%s\"", j.Name(), j.workingBookmark)
+}
+
+// GetRootDir returns the root directory path
+func (j *jjImpl) GetRootDir() string {
+	return j.rootDir
+}
+
+// GetRemoteName returns the current jj remote name
+func (j *jjImpl) GetRemoteName() string {
+	return j.remoteName
+}
+
+// IsRemoteEnabled indicates if jj remote operations are enabled
+func (j *jjImpl) IsRemoteEnabled() bool {
+	return j.remoteEnabled
+}
+
+// GetWorkingBranch returns the current jj working bookmark
+func (j *jjImpl) GetWorkingBranch() string {
+	return j.workingBookmark
+}
+
+// IsOnRootBranch indicates if jj working bookmark is a root branch or not.
+func (j *jjImpl) IsOnRootBranch() bool {
+	return slices.Contains([]string{"main", "master"}, j.GetWorkingBranch())
+}
+
+// fileset returns the jj fileset expression matching the provided path
+func (j *jjImpl) fileset(path string) string {
+	rel, err := filepath.Rel(j.rootDir, path)
+	if err != nil {
+		rel = path
+	}
+	return "root:" + strconv.Quote(filepath.ToSlash(rel))
+}
+
+// scope returns the jj fileset arguments restricting a jj command to the base directory.
+// Returns nil when the base directory is the workspace root directory
+func (j *jjImpl) scope() []string {
+	if j.baseDir == "" || filepath.Clean(j.baseDir) == filepath.Clean(j.rootDir) {
+		return nil
+	}
+	return []string{j.fileset(j.baseDir)}
+}
+
+// Add starts tracking the listed paths. As jj automatically tracks new files
+// when snapshotting the working copy, there is nothing to do when no path is provided.
+func (j *jjImpl) Add(paths ...string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	jjArgs := []string{"file", "track"}
+	for _, path := range paths {
+		jjArgs = append(jjArgs, j.fileset(path))
+	}
+	return j.traceJj(jjArgs...)
+}
+
+// Commit commits working copy changes located in the base directory, and moves
+// the working bookmark to the new commit.
+// jj allows empty commits, hence the check done beforehand
+func (j *jjImpl) Commit(messages ...string) error {
+	if j.nothingToCommit() {
+		return nil
+	}
+	err := j.traceJj(append([]string{"commit", "--message", strings.Join(messages, "\n\n")}, j.scope()...)...)
+	if err != nil || j.workingBookmark == "" {
+		return err
+	}
+	return j.traceJj("bookmark", "set", j.workingBookmark, "--revision", "@-")
+}
+
+// nothingToCommit returns true if there is nothing to commit
+func (j *jjImpl) nothingToCommit() bool {
+	jjOutput, err := j.runJj(append([]string{"diff", "--summary"}, j.scope()...)...)
+	return err == nil && strings.TrimSpace(string(jjOutput)) == ""
+}
+
+// RevertLocal restores the provided path to its content in the parent of the working copy.
+func (j *jjImpl) RevertLocal(path string) error {
+	report.PostWarning("Reverting ", path)
+	return j.traceJj("restore", j.fileset(path))
+}
+
+// RollbackLastCommit reverts the changes of the last commit in the working copy.
+// The working copy is expected to be empty, as it's called right after a commit
+func (j *jjImpl) RollbackLastCommit() error {
+	report.PostInfo("Reverting changes")
+	return j.traceJj(append([]string{"restore", "--from", "@--", "--into", "@"}, j.scope()...)...)
+}
+
+// Push runs a jj git push operation on the working bookmark.
+func (j *jjImpl) Push() error {
+	if !j.IsRemoteEnabled() {
+		// There's nothing to do in this case
+		return nil
+	}
+	report.PostInfo("Pushing changes to ", j.GetRemoteName(), "/", j.GetWorkingBranch())
+	return j.traceJj("git", "push", "--remote", j.GetRemoteName(), "--bookmark", j.GetWorkingBranch())
+}
+
+// Pull runs a jj git fetch operation, then rebases the working copy on top of the working bookmark.
+func (j *jjImpl) Pull() error {
+	if !j.IsRemoteEnabled() {
+		report.PostInfo("Working locally on bookmark ", j.GetWorkingBranch())
+		return nil
+	}
+	report.PostInfo("Pulling latest changes from ", j.GetRemoteName(), "/", j.GetWorkingBranch())
+	err := j.traceJj("git", "fetch", "--remote", j.GetRemoteName())
+	if err != nil {
+		return err
+	}
+	return j.traceJj("rebase", "--destination", j.GetWorkingBranch())
+}
+
+// Diff returns the list of files modified in the working copy with diff info for each file
+func (j *jjImpl) Diff() (diffs vcs.FileDiffs, err error) {
+	var jjOutput []byte
+	jjOutput, err = j.runJj(append([]string{"diff", "--git"}, j.scope()...)...)
+	if err != nil {
+		return nil, err
+	}
+	return vcs.ParseGitFormatDiff(j.rootDir, jjOutput), nil
+}
+
+// logFieldSeparator is the field separator used when parsing jj log output. Each log
+// item starts with a separator, so that descriptions spanning several lines can be retrieved
+const logFieldSeparator = "\x00"
+
+// logTemplate is the jj template used for retrieving log items: commit id, unix timestamp,
+// author and full description
+const logTemplate = `"\0" ++ commit_id ++ "\0" ++ author.timestamp().format("%s") ++ "\0" ++ ` +
+	`author.name() ++ " <" ++ author.email() ++ ">" ++ "\0" ++ description`
+
+// Log returns the list of jj commits touching the base directory compliant with the provided
+// msgFilter and filter, from the most recent. When no msgFilter is provided, returns
+// all jj commits matching filter. The working copy commit is not included.
+func (j *jjImpl) Log(msgFilter func(msg string) bool, filter vcs.LogFilter) (logs vcs.LogItems, err error) {
+	var jjOutput []byte
+	jjOutput, err = j.runJj(append([]string{"log", "--no-graph",
+		"--revisions", logRevisions(filter.Branches),
+		"--template", logTemplate}, j.scope()...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	const fieldsPerItem = 4
+	fields := strings.Split(string(jjOutput), logFieldSeparator)
+	for i := 1; i+fieldsPerItem <= len(fields); i += fieldsPerItem {
+		seconds, _ := strconv.ParseInt(fields[i+1], 10, 64)
+		timestamp := time.Unix(seconds, 0).UTC()
+		if !filter.IncludesTime(timestamp) || !filter.IncludesAuthor(fields[i+2]) {
+			continue
+		}
+		message := strings.TrimRight(fields[i+3], "\r\n")
+		if msgFilter == nil || msgFilter(message) {
+			logs.Add(vcs.NewLogItem(fields[i], timestamp, message))
+		}
+	}
+	return logs, nil
+}
+
+// logRevisions returns the jj revset containing the ancestors of the provided bookmarks,
+// or the ancestors of the working copy parent when no bookmark is provided
+func logRevisions(bookmarks []string) string {
+	if len(bookmarks) == 0 {
+		return "::@-"
+	}
+	quoted := make([]string, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		quoted[i] = strconv.Quote(bookmark)
+	}
+	return "::(" + strings.Join(quoted, " | ") + ")"
+}
+
+// CommitFiles returns the path of the files added or modified in the commit
+// with the provided id, restricted to the base directory
+func (j *jjImpl) CommitFiles(id string) (files []string, err error) {
+	var jjOutput []byte
+	jjOutput, err = j.runJj(append([]string{"diff", "--summary", "--revisions", id}, j.scope()...)...)
+	if err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(jjOutput))
+	for scanner.Scan() {
+		// <status> <path>, with path relative to the root directory jj is run from
+		status, path, found := strings.Cut(strings.TrimSpace(scanner.Text()), " ")
+		if !found || status == "D" {
+			continue
+		}
+		files = append(files, filepath.Join(j.rootDir, filepath.FromSlash(path)))
+	}
+	return files, nil
+}
+
+// Shelve is not available for jj
+func (*jjImpl) Shelve(_ []string, _ ...string) error {
+	return errors.New("VCS shelve operation not available for jj")
+}
+
+// ListShelved is not available for jj
+func (*jjImpl) ListShelved(_ func(msg string) bool) (vcs.ShelfItems, error) {
+	return nil, errors.New("VCS shelve operation not available for jj")
+}
+
+// Unshelve is not available for jj
+func (*jjImpl) Unshelve(_ string) error {
+	return errors.New("VCS shelve operation not available for jj")
+}
+
+// EnableAutoPush sets a flag allowing to turn on/off jj auto push operations
+func (j *jjImpl) EnableAutoPush(flag bool) {
+	if j.autoPushEnabled == flag {
+		return
+	}
+	j.autoPushEnabled = flag
+	autoPushStr := "off"
+	if j.autoPushEnabled {
+		autoPushStr = "on"
+	}
+	report.PostInfo(fmt.Sprintf("Jujutsu auto-push is turned %v", autoPushStr))
+}
+
+// IsAutoPushEnabled indicates if jj auto-push operations are turned on
+func (j *jjImpl) IsAutoPushEnabled() bool {
+	return j.autoPushEnabled
+}
+
+// CheckRemoteAccess returns true if jj remote can be accessed. This is done through
+// checking the return value of "jj git push --dry-run".
+func (j *jjImpl) CheckRemoteAccess() bool {
+	if !j.IsRemoteEnabled() {
+		return false
+	}
+	_, err := j.runJj("git", "push", "--dry-run", "--remote", j.GetRemoteName(), "--bookmark", j.GetWorkingBranch())
+	return err == nil
+}
+
+// SupportsEmojis indicates if the VCS supports emojis in commit messages (true in case of jj)
+func (*jjImpl) SupportsEmojis() bool {
+	return true
+}
+
+// SupportsSnapshots indicates if the VCS supports running TCR operations
+// in an isolated snapshot of the working tree (false in case of jj)
+func (*jjImpl) SupportsSnapshots() bool {
+	return false
+}
+
+// TakeSnapshot is not available for jj
+func (*jjImpl) TakeSnapshot() (*vcs.Snapshot, error) {
+	return nil, errors.New("VCS snapshot operation not available for jj")
+}
+
+// CommitSnapshot is not available for jj
+func (*jjImpl) CommitSnapshot(_ *vcs.Snapshot, _ ...string) error {
+	return errors.New("VCS snapshot operation not available for jj")
+}
+
+// DropSnapshot is not available for jj
+func (*jjImpl) DropSnapshot(_ *vcs.Snapshot) error {
+	return errors.New("VCS snapshot operation not available for jj")
+}
+
+// SupportsHandover indicates if the VCS supports handing over uncommitted
+// work to another workstation (false in case of jj)
+func (*jjImpl) SupportsHandover() bool {
+	return false
+}
+
+// PushHandover is not available for jj
+func (*jjImpl) PushHandover(_ ...string) (bool, error) {
+	return false, errors.New("VCS handover operation not available for jj")
+}
+
+// PullHandover is not available for jj
+func (*jjImpl) PullHandover() (bool, error) {
+	return false, errors.New("VCS handover operation not available for jj")
+}
+
+// traceJj runs a jj command on the workspace and traces its output.
+func (j *jjImpl) traceJj(args ...string) error {
+	return j.traceJjFunction(j.buildJjArgs(args...)...)
+}
+
+// runJj calls jj command on the workspace in a separate process and returns its output traces.
+// The command is run from the workspace root directory so that the paths it reports
+// are relative to the root directory
+func (j *jjImpl) runJj(args ...string) (output []byte, err error) {
+	return j.runJjFunction(j.rootDir, j.buildJjArgs(args...)...)
+}
+
+func (j *jjImpl) buildJjArgs(args ...string) []string {
+	return append([]string{"--repository", j.GetRootDir(), "--color", "never"}, args...)
+}
@@ -0,0 +1,409 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package jj
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/murex/tcr/vcs"
+	"github.com/stretchr/testify/assert"
+)
+
+// jjCall records the arguments of a jj command call, without the ones
+// added by buildJjArgs()
+type jjCall []string
+
+// newJjImplStub creates a jjImpl instance for the provided base directory. Calls to jj
+// are recorded in calls, and answered through the provided outputs map (keyed by
+// the space-separated list of jj command arguments)
+func newJjImplStub(t *testing.T, dir string, outputs map[string]string, errs map[string]error,
+) (*jjImpl, *[]jjCall) {
+	t.Helper()
+	j, err := newJjImpl(dir, "", true)
+	assert.NoError(t, err)
+	var calls []jjCall
+	j.runJjFunction = func(_ string, params ...string) ([]byte, error) {
+		args := params[4:]
+		calls = append(calls, args)
+		key := strings.Join(args, " ")
+		return []byte(outputs[key]), errs[key]
+	}
+	j.traceJjFunction = func(params ...string) error {
+		args := params[4:]
+		calls = append(calls, args)
+		return errs[strings.Join(args, " ")]
+	}
+	return j, &calls
+}
+
+func Test_get_vcs_name(t *testing.T) {
+	j, _ := newJjImpl("", "", true)
+	assert.Equal(t, "jj", j.Name())
+}
+
+func Test_get_vcs_session_summary(t *testing.T) {
+	tests := []struct {
+		bookmark string
+		expected string
+	}{
+		{"main", "jj bookmark \"main\""},
+		{"", "jj working copy (no bookmark)"},
+	}
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			j, _ := newJjImpl("", "", true)
+			j.workingBookmark = test.bookmark
+			assert.Equal(t, test.expected, j.SessionSummary())
+		})
+	}
+}
+
+func Test_jj_is_on_root_branch(t *testing.T) {
+	tests := []struct {
+		bookmark string
+		expected bool
+	}{
+		{"main", true},
+		{"master", true},
+		{"feature", false},
+	}
+	for _, test := range tests {
+		t.Run(test.bookmark, func(t *testing.T) {
+			j, _ := newJjImpl("", "", true)
+			j.workingBookmark = test.bookmark
+			assert.Equal(t, test.expected, j.IsOnRootBranch())
+		})
+	}
+}
+
+func Test_jj_build_args(t *testing.T) {
+	j, _ := newJjImpl("/repo", "", true)
+	assert.Equal(t, []string{"--repository", "/repo", "--color", "never", "status"}, j.buildJjArgs("status"))
+}
+
+func Test_jj_retrieve_working_bookmark(t *testing.T) {
+	j, calls := newJjImplStub(t, "", map[string]string{
+		"log --no-graph --revisions latest(::@- & bookmarks()) --template " +
+			`local_bookmarks.map(|b| b.name()).join("\n")`: "feature\nother\n",
+	}, nil)
+	bookmark, err := j.retrieveWorkingBookmark()
+	assert.NoError(t, err)
+	assert.Equal(t, "feature", bookmark)
+	assert.Len(t, *calls, 1)
+}
+
+func Test_jj_retrieve_remote(t *testing.T) {
+	tests := []struct {
+		desc            string
+		remoteName      string
+		bookmark        string
+		expectedName    string
+		expectedEnabled bool
+	}{
+		{"remote exists", "origin", "main", "origin", true},
+		{"remote does not exist", "upstream", "main", "", false},
+		{"no working bookmark", "origin", "", "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			j, _ := newJjImplStub(t, "", map[string]string{
+				"git remote list": "origin https://example.com/repo.git\n",
+			}, nil)
+			j.workingBookmark = test.bookmark
+			name, enabled := j.retrieveRemote(test.remoteName)
+			assert.Equal(t, test.expectedName, name)
+			assert.Equal(t, test.expectedEnabled, enabled)
+		})
+	}
+}
+
+func Test_jj_add(t *testing.T) {
+	tests := []struct {
+		desc     string
+		paths    []string
+		expected []jjCall
+	}{
+		{"no path", nil, nil},
+		{
+			"some paths",
+			[]string{filepath.Join("repo", "a.txt"), filepath.Join("repo", "dir", "b.txt")},
+			[]jjCall{{"file", "track", `root:"a.txt"`, `root:"dir/b.txt"`}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			j, calls := newJjImplStub(t, "repo", nil, nil)
+			assert.NoError(t, j.Add(test.paths...))
+			assert.Equal(t, test.expected, *calls)
+		})
+	}
+}
+
+func Test_jj_commit(t *testing.T) {
+	tests := []struct {
+		desc     string
+		bookmark string
+		summary  string
+		expected []jjCall
+	}{
+		{
+			"nothing to commit",
+			"main",
+			"",
+			[]jjCall{{"diff", "--summary"}},
+		},
+		{
+			"changes to commit with a working bookmark",
+			"main",
+			"M file.txt\n",
+			[]jjCall{
+				{"diff", "--summary"},
+				{"commit", "--message", "main message\n\nadditional message"},
+				{"bookmark", "set", "main", "--revision", "@-"},
+			},
+		},
+		{
+			"changes to commit without working bookmark",
+			"",
+			"M file.txt\n",
+			[]jjCall{
+				{"diff", "--summary"},
+				{"commit", "--message", "main message\n\nadditional message"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			j, calls := newJjImplStub(t, "", map[string]string{"diff --summary": test.summary}, nil)
+			j.workingBookmark = test.bookmark
+			assert.NoError(t, j.Commit("main message", "additional message"))
+			assert.Equal(t, test.expected, *calls)
+		})
+	}
+}
+
+func Test_jj_commands_are_scoped_to_base_dir(t *testing.T) {
+	j, calls := newJjImplStub(t, filepath.Join("repo", "app"),
+		map[string]string{`diff --summary root:"app"`: "M app/file.txt\n"}, nil)
+	j.rootDir = "repo"
+	j.workingBookmark = ""
+	assert.NoError(t, j.Commit("message"))
+	assert.NoError(t, j.RollbackLastCommit())
+	assert.Equal(t, []jjCall{
+		{"diff", "--summary", `root:"app"`},
+		{"commit", "--message", "message", `root:"app"`},
+		{"restore", "--from", "@--", "--into", "@", `root:"app"`},
+	}, *calls)
+}
+
+func Test_jj_revert_local(t *testing.T) {
+	j, calls := newJjImplStub(t, "repo", nil, nil)
+	assert.NoError(t, j.RevertLocal(filepath.Join("repo", "some-path")))
+	assert.Equal(t, []jjCall{{"restore", `root:"some-path"`}}, *calls)
+}
+
+func Test_jj_rollback_last_commit(t *testing.T) {
+	j, calls := newJjImplStub(t, "", nil, nil)
+	assert.NoError(t, j.RollbackLastCommit())
+	assert.Equal(t, []jjCall{{"restore", "--from", "@--", "--into", "@"}}, *calls)
+}
+
+func Test_jj_push_and_pull(t *testing.T) {
+	tests := []struct {
+		desc          string
+		remoteEnabled bool
+		expected      []jjCall
+	}{
+		{"remote disabled", false, nil},
+		{
+			"remote enabled", true,
+			[]jjCall{
+				{"git", "push", "--remote", "origin", "--bookmark", "main"},
+				{"git", "fetch", "--remote", "origin"},
+				{"rebase", "--destination", "main"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			j, calls := newJjImplStub(t, "", nil, nil)
+			j.remoteName, j.remoteEnabled = "origin", test.remoteEnabled
+			assert.NoError(t, j.Push())
+			assert.NoError(t, j.Pull())
+			assert.Equal(t, test.expected, *calls)
+		})
+	}
+}
+
+func Test_jj_pull_fetch_error(t *testing.T) {
+	j, calls := newJjImplStub(t, "", nil, map[string]error{"git fetch --remote origin": errors.New("fetch error")})
+	j.remoteName, j.remoteEnabled = "origin", true
+	assert.Error(t, j.Pull())
+	assert.Len(t, *calls, 1)
+}
+
+func Test_jj_diff(t *testing.T) {
+	j, _ := newJjImplStub(t, "repo", map[string]string{
+		"diff --git": "diff --git a/file.txt b/file.txt\n" +
+			"--- a/file.txt\n" +
+			"+++ b/file.txt\n" +
+			"@@ -1,1 +1,2 @@\n" +
+			"-old\n" +
+			"+new 1\n" +
+			"+new 2\n",
+	}, nil)
+	diffs, err := j.Diff()
+	assert.NoError(t, err)
+	assert.Equal(t, vcs.FileDiffs{vcs.NewFileDiff(filepath.Join("repo", "file.txt"), 2, 1)}, diffs)
+}
+
+func Test_jj_diff_error(t *testing.T) {
+	j, _ := newJjImplStub(t, "", nil, map[string]error{"diff --git": errors.New("jj diff error")})
+	_, err := j.Diff()
+	assert.Error(t, err)
+}
+
+func Test_jj_log(t *testing.T) {
+	output := "\x00abc123\x001700000000\x00Jane <jane@example.com>\x00✅ TCR - tests passing\n\nmore\n" +
+		"\x00def456\x001600000000\x00John <john@example.com>\x00some other message\n"
+	tests := []struct {
+		desc      string
+		msgFilter func(msg string) bool
+		filter    vcs.LogFilter
+		expected  vcs.LogItems
+	}{
+		{
+			"no filter",
+			nil,
+			vcs.LogFilter{},
+			vcs.LogItems{
+				vcs.NewLogItem("abc123", time.Unix(1700000000, 0).UTC(), "✅ TCR - tests passing\n\nmore"),
+				vcs.NewLogItem("def456", time.Unix(1600000000, 0).UTC(), "some other message"),
+			},
+		},
+		{
+			"message filter",
+			func(msg string) bool { return strings.Contains(msg, "TCR") },
+			vcs.LogFilter{},
+			vcs.LogItems{
+				vcs.NewLogItem("abc123", time.Unix(1700000000, 0).UTC(), "✅ TCR - tests passing\n\nmore"),
+			},
+		},
+		{
+			"author filter",
+			nil,
+			vcs.LogFilter{Authors: []string{"john"}},
+			vcs.LogItems{
+				vcs.NewLogItem("def456", time.Unix(1600000000, 0).UTC(), "some other message"),
+			},
+		},
+		{
+			"time filter",
+			nil,
+			vcs.LogFilter{Until: time.Unix(1650000000, 0)},
+			vcs.LogItems{
+				vcs.NewLogItem("def456", time.Unix(1600000000, 0).UTC(), "some other message"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			j, _ := newJjImplStub(t, "", map[string]string{
+				"log --no-graph --revisions ::@- --template " + logTemplate: output,
+			}, nil)
+			logs, err := j.Log(test.msgFilter, test.filter)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, logs)
+		})
+	}
+}
+
+func Test_jj_log_revisions(t *testing.T) {
+	assert.Equal(t, "::@-", logRevisions(nil))
+	assert.Equal(t, `::("b1" | "b2")`, logRevisions([]string{"b1", "b2"}))
+}
+
+func Test_jj_commit_files(t *testing.T) {
+	rootDir := filepath.Join("/", "root-dir")
+	j, _ := newJjImplStub(t, rootDir, map[string]string{
+		"diff --summary --revisions abc123": "A new.txt\nM dir/file.txt\nD deleted.txt\n",
+	}, nil)
+	files, err := j.CommitFiles("abc123")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(rootDir, "new.txt"),
+		filepath.Join(rootDir, "dir", "file.txt"),
+	}, files)
+}
+
+func Test_jj_enable_auto_push(t *testing.T) {
+	j, _ := newJjImpl("", "", true)
+	assert.False(t, j.IsAutoPushEnabled())
+	j.EnableAutoPush(true)
+	assert.True(t, j.IsAutoPushEnabled())
+}
+
+func Test_jj_check_remote_access(t *testing.T) {
+	tests := []struct {
+		desc          string
+		remoteEnabled bool
+		pushErr       error
+		expected      bool
+	}{
+		{"remote disabled", false, nil, false},
+		{"remote accessible", true, nil, true},
+		{"remote not accessible", true, errors.New("error"), false},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			j, _ := newJjImplStub(t, "", nil, map[string]error{
+				"git push --dry-run --remote origin --bookmark main": test.pushErr,
+			})
+			j.remoteName, j.remoteEnabled = "origin", test.remoteEnabled
+			assert.Equal(t, test.expected, j.CheckRemoteAccess())
+		})
+	}
+}
+
+func Test_jj_unsupported_operations(t *testing.T) {
+	j, _ := newJjImpl("", "", true)
+	assert.True(t, j.SupportsEmojis())
+	assert.False(t, j.SupportsSnapshots())
+	assert.False(t, j.SupportsHandover())
+	assert.Error(t, j.Shelve(nil))
+	_, err := j.ListShelved(nil)
+	assert.Error(t, err)
+	assert.Error(t, j.Unshelve("id"))
+	_, err = j.TakeSnapshot()
+	assert.Error(t, err)
+	assert.Error(t, j.CommitSnapshot(nil))
+	assert.Error(t, j.DropSnapshot(nil))
+	_, err = j.PushHandover()
+	assert.Error(t, err)
+	_, err = j.PullHandover()
+	assert.Error(t, err)
+}
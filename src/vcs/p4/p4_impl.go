@@ -30,6 +30,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/murex/tcr/helpers"
 	"github.com/murex/tcr/report"
@@ -95,7 +96,19 @@ func (*p4Impl) Name() string {
 
 // SessionSummary provides a short description related to current VCS session summary
 func (p *p4Impl) SessionSummary() string {
-	return fmt.Sprintf("%s client \"%s\"", p.Name(), p.clientName)
+	summary := fmt.Sprintf("%s client \"%s\"", p.Name(), p.clientName)
+	if p.isScoped() {
+		if scope, err := p.toP4ClientPath(p.baseDir); err == nil {
+			summary += fmt.Sprintf(" (scope: %s)", scope)
+		}
+	}
+	return summary
+}
+
+// isScoped indicates if p4 operations are restricted to a subset of the client view,
+// which is the case when the base directory is below the client root directory
+func (p *p4Impl) isScoped() bool {
+	return p.baseDir != "" && filepath.Clean(p.baseDir) != filepath.Clean(p.rootDir)
 }
 
 // plainOpen is the regular function used to open a p4 depot
@@ -154,7 +167,11 @@ type changeList struct {
 // Commit commits changes to p4 index.
 // With current implementation, "amend" parameter is ignored.
 func (p *p4Impl) Commit(messages ...string) error {
-	cl, err := p.createChangeList(messages...)
+	var paths []string
+	if p.isScoped() {
+		paths = []string{filepath.Join(p.baseDir, "/...")}
+	}
+	cl, err := p.createChangeList(paths, messages...)
 	if err != nil {
 		report.PostError(err)
 		return err
@@ -164,6 +181,9 @@ func (p *p4Impl) Commit(messages ...string) error {
 
 // RevertLocal restores to last commit for the provided path.
 func (p *p4Impl) RevertLocal(path string) error {
+	if p.isScoped() && !helpers.IsSubPathOf(filepath.Clean(path), filepath.Clean(p.baseDir)) {
+		return fmt.Errorf("cannot revert %s: file is outside of %s", path, p.baseDir)
+	}
 	// in order to work, p4 revert requires that the file be reconciled beforehand
 	err := p.reconcile(path)
 	if err != nil {
@@ -233,11 +253,224 @@ func (p *p4Impl) Diff() (diffs vcs.FileDiffs, err error) {
 	return diffs, nil
 }
 
-// Log returns the list of p4 log items compliant with the provided msgFilter.
-// When no msgFilter is provided, returns all p4 log items unfiltered.
-// TODO: VCS Log - p4 changes ./...
-func (*p4Impl) Log(_ func(msg string) bool) (logs vcs.LogItems, err error) {
-	return nil, errors.New("VCS log operation not yet available for p4")
+// Log returns the list of p4 submitted changelists for the base directory compliant with
+// the provided msgFilter and filter, from the most recent. When no msgFilter is provided,
+// returns all p4 submitted changelists matching filter.
+// Log item hash is the changelist number, and its message is the changelist description.
+// Branches cannot be used in filter, as p4 has no working branch
+func (p *p4Impl) Log(msgFilter func(msg string) bool, filter vcs.LogFilter) (logs vcs.LogItems, err error) {
+	if len(filter.Branches) > 0 {
+		return nil, errors.New("VCS log branch filter not available for p4")
+	}
+	var path string
+	path, err = p.toP4ClientPath(p.baseDir)
+	if err != nil {
+		return nil, err
+	}
+	var p4Output []byte
+	// Command: p4 changes -s submitted -l -t <path>[@<since>,@<until>]
+	p4Output, err = p.runP4("changes", "-s", "submitted", "-l", "-t", path+p4RevisionRange(filter))
+	if err != nil {
+		return nil, err
+	}
+	var changes []p4Change
+	changes, err = parseP4Changes(p4Output)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range changes {
+		if !filter.IncludesTime(c.timestamp) || !filter.IncludesAuthor(c.user) {
+			continue
+		}
+		if msgFilter == nil || msgFilter(c.description) {
+			logs.Add(vcs.NewLogItem(c.number, c.timestamp, c.description))
+		}
+	}
+	return logs, nil
+}
+
+// CommitFiles returns the local path of the files in the submitted changelist
+// with the provided number, restricted to the base directory
+func (p *p4Impl) CommitFiles(id string) (files []string, err error) {
+	var path string
+	path, err = p.toP4ClientPath(p.baseDir)
+	if err != nil {
+		return nil, err
+	}
+	var p4Output []byte
+	// Command: p4 -ztag -F %clientFile% fstat <path>@=<changelist>
+	p4Output, err = p.runP4("-ztag", "-F", "%clientFile%", "fstat", path+"@="+id)
+	if err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(p4Output))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			files = append(files, filepath.Clean(line))
+		}
+	}
+	return files, nil
+}
+
+// p4DateLayout is the date layout used in p4 revision ranges
+const p4DateLayout = "2006/01/02:15:04:05"
+
+// p4RevisionRange returns the p4 revision range matching filter time range.
+// Returns an empty string when filter has no time range
+func p4RevisionRange(filter vcs.LogFilter) string {
+	switch {
+	case filter.Since.IsZero() && filter.Until.IsZero():
+		return ""
+	case filter.Since.IsZero():
+		return "@" + filter.Until.Local().Format(p4DateLayout)
+	case filter.Until.IsZero():
+		return "@" + filter.Since.Local().Format(p4DateLayout) + ",@now"
+	default:
+		return "@" + filter.Since.Local().Format(p4DateLayout) + ",@" + filter.Until.Local().Format(p4DateLayout)
+	}
+}
+
+// Shelve saves local changes for the provided paths into a p4 shelved changelist
+// and reverts these paths to their last submitted revision. Other opened files,
+// such as test files, are left untouched in the workspace.
+func (p *p4Impl) Shelve(paths []string, messages ...string) error {
+	report.PostWarning("Shelving ", len(paths), " file(s)")
+	if len(paths) == 0 {
+		paths = []string{filepath.Join(p.baseDir, "/...")}
+	}
+	err := p.reconcile(paths...)
+	if err != nil {
+		return err
+	}
+	cl, err := p.createChangeList(paths, messages...)
+	if err != nil {
+		return err
+	}
+	// Command: p4 shelve -c <changelist> <path>...
+	err = p.traceP4(append([]string{"shelve", "-c", cl.number}, paths...)...)
+	if err != nil {
+		return err
+	}
+	// Command: p4 revert -w -c <changelist> <path>...
+	// (-w flag also removes local files that were opened for add)
+	return p.traceP4(append([]string{"revert", "-w", "-c", cl.number}, paths...)...)
+}
+
+// p4TimestampLayout is the timestamp layout used by p4 changes command when called with -t option
+const p4TimestampLayout = "2006/01/02 15:04:05"
+
+// ListShelved returns the list of p4 shelved changelists compliant with the provided msgFilter.
+// When no msgFilter is provided, returns all p4 shelved changelists unfiltered.
+func (p *p4Impl) ListShelved(msgFilter func(msg string) bool) (items vcs.ShelfItems, err error) {
+	var p4Output []byte
+	// Command: p4 changes -s shelved -l -t -c <client>
+	p4Output, err = p.runP4("changes", "-s", "shelved", "-l", "-t", "-c", p.clientName)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []p4Change
+	changes, err = parseP4Changes(p4Output)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range changes {
+		if msgFilter == nil || msgFilter(c.description) {
+			items.Add(vcs.NewShelfItem(c.number, c.timestamp, c.description))
+		}
+	}
+	return items, nil
+}
+
+// p4Change contains the information of a changelist retrieved with p4 changes command
+type p4Change struct {
+	number      string
+	timestamp   time.Time
+	user        string
+	description string
+}
+
+// parseP4Changes parses the output of p4 changes command called with -l and -t options
+func parseP4Changes(p4Output []byte) (changes []p4Change, err error) {
+	var current *p4Change
+	var description strings.Builder
+	flush := func() {
+		if current != nil {
+			current.description = strings.TrimLeft(description.String(), "\n")
+			changes = append(changes, *current)
+		}
+		description.Reset()
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(p4Output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "Change "):
+			// Change <number> on <date> <time> by <user>@<client> [*pending*]
+			flush()
+			fields := strings.Split(line, " ")
+			if len(fields) < 5 { //nolint:revive
+				return nil, fmt.Errorf("unrecognized p4 changes output: %s", line)
+			}
+			timestamp, _ := time.ParseInLocation(p4TimestampLayout, fields[3]+" "+fields[4], time.Local)
+			current = &p4Change{number: fields[1], timestamp: timestamp.UTC()}
+			if len(fields) > 6 && fields[5] == "by" { //nolint:revive
+				current.user = fields[6]
+			}
+		case current != nil:
+			// Description lines are indented with a tab character
+			_, _ = description.WriteString(strings.TrimPrefix(line, "\t"))
+			_, _ = description.WriteRune('\n')
+		}
+	}
+	flush()
+	return changes, nil
+}
+
+// Unshelve restores the files saved in the p4 shelved changelist matching the provided id.
+// The shelved changelist is kept so that it can be recovered again if needed.
+func (p *p4Impl) Unshelve(id string) error {
+	report.PostInfo("Unshelving changelist ", id)
+	// Command: p4 unshelve -s <changelist>
+	return p.traceP4("unshelve", "-s", id)
+}
+
+// SupportsSnapshots indicates if the VCS supports running TCR operations
+// in an isolated snapshot of the working tree (false in case of Perforce)
+func (*p4Impl) SupportsSnapshots() bool {
+	return false
+}
+
+// TakeSnapshot is not available for p4
+func (*p4Impl) TakeSnapshot() (*vcs.Snapshot, error) {
+	return nil, errors.New("VCS snapshot operation not available for p4")
+}
+
+// CommitSnapshot is not available for p4
+func (*p4Impl) CommitSnapshot(_ *vcs.Snapshot, _ ...string) error {
+	return errors.New("VCS snapshot operation not available for p4")
+}
+
+// DropSnapshot is not available for p4
+func (*p4Impl) DropSnapshot(_ *vcs.Snapshot) error {
+	return errors.New("VCS snapshot operation not available for p4")
+}
+
+// SupportsHandover indicates if the VCS supports handing over uncommitted
+// work to another workstation (false in case of Perforce)
+func (*p4Impl) SupportsHandover() bool {
+	return false
+}
+
+// PushHandover is not available for p4
+func (*p4Impl) PushHandover(_ ...string) (bool, error) {
+	return false, errors.New("VCS handover operation not available for p4")
+}
+
+// PullHandover is not available for p4
+func (*p4Impl) PullHandover() (bool, error) {
+	return false, errors.New("VCS handover operation not available for p4")
 }
 
 // EnableAutoPush sets a flag allowing to turn on/off p4 auto-push operations.
@@ -282,15 +515,20 @@ func (p *p4Impl) buildP4Args(args ...string) []string {
 	return append([]string{"-d", p.GetRootDir(), "-c", p.clientName}, args...)
 }
 
-func (p *p4Impl) createChangeList(messages ...string) (*changeList, error) {
+// createChangeList creates a changelist holding the opened files matching the provided paths,
+// or all the files opened in the default changelist when no path is provided
+func (p *p4Impl) createChangeList(paths []string, messages ...string) (*changeList, error) {
 	// Command: p4 --field "Description=<message>" change -o | p4 change -i
 	//   `change -o` outputs a "changelist spec" to stdout
 	//   `change -i` then reads it and creates a real changelist from it
 	//   `change -o` takes all the changed files from the Default changelist and adds them to this new changelist
+	// When paths are provided, the changelist is created empty and only files matching them are moved into it
+	p4Args := []string{"-Q", "utf8", "--field", buildDescriptionField(shell.GetAttributes(), messages...)}
+	if len(paths) > 0 {
+		p4Args = append(p4Args, "--field", "Files=")
+	}
 	out, err := p.runPipedP4(newP4Command(p.buildP4Args("change", "-i")...),
-		"-Q", "utf8",
-		"--field", buildDescriptionField(shell.GetAttributes(), messages...),
-		"change", "-o")
+		append(p4Args, "change", "-o")...)
 	if err != nil {
 		return nil, err
 	}
@@ -300,13 +538,26 @@ func (p *p4Impl) createChangeList(messages ...string) (*changeList, error) {
 		return nil, fmt.Errorf("unexpected p4 change trace: %s", out)
 	}
 	clNumber := strings.Split(string(out), " ")[1]
+	if len(paths) > 0 {
+		// Command: p4 reopen -c <changelist> <path>...
+		err = p.traceP4(append([]string{"reopen", "-c", clNumber}, paths...)...)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &changeList{clNumber}, err
 }
 
+// buildDescriptionField builds the changelist description from the provided messages.
+// Messages are separated by a blank line, the same way git does with commit messages,
+// so that TCR event data can be retrieved from the description
 func buildDescriptionField(attr shell.Attributes, messages ...string) string {
 	var builder strings.Builder
 	_, _ = builder.WriteString("Description=")
-	for _, message := range messages {
+	for i, message := range messages {
+		if i > 0 {
+			_, _ = builder.WriteString(attr.EOL)
+		}
 		_, _ = builder.WriteString(message)
 		_, _ = builder.WriteString(attr.EOL)
 	}
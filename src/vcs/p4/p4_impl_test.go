@@ -25,7 +25,9 @@ package p4
 import (
 	"errors"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/murex/tcr/vcs"
 	"github.com/murex/tcr/vcs/shell"
@@ -48,6 +50,13 @@ func Test_get_vcs_session_summary(t *testing.T) {
 	assert.Equal(t, "p4 client \""+p4TestClientName+"\"", p.SessionSummary())
 }
 
+func Test_get_vcs_session_summary_with_scope(t *testing.T) {
+	p, _ := newP4Impl(inMemoryDepotInit, filepath.Join("depot", "app"), true)
+	p.rootDir = "depot"
+	assert.Equal(t, "p4 client \""+p4TestClientName+"\" (scope: //"+p4TestClientName+"/app/...)",
+		p.SessionSummary())
+}
+
 func Test_p4_auto_push_is_always_enabled(t *testing.T) {
 	p, _ := newP4Impl(inMemoryDepotInit, "", true)
 	assert.True(t, p.IsAutoPushEnabled())
@@ -58,6 +67,16 @@ func Test_p4_does_not_support_emojis(t *testing.T) {
 	assert.False(t, p.SupportsEmojis())
 }
 
+func Test_p4_does_not_support_snapshots(t *testing.T) {
+	p, _ := newP4Impl(inMemoryDepotInit, "", true)
+	assert.False(t, p.SupportsSnapshots())
+	s, err := p.TakeSnapshot()
+	assert.Nil(t, s)
+	assert.Error(t, err)
+	assert.Error(t, p.CommitSnapshot(vcs.NewSnapshot("", "", nil)))
+	assert.Error(t, p.DropSnapshot(vcs.NewSnapshot("", "", nil)))
+}
+
 func Test_p4_enable_disable_push_has_no_effect(t *testing.T) {
 	p, _ := newP4Impl(inMemoryDepotInit, "", true)
 	p.EnableAutoPush(true)
@@ -444,6 +463,39 @@ func Test_p4_commit(t *testing.T) {
 	}
 }
 
+func Test_p4_commit_with_scope(t *testing.T) {
+	p, _ := newP4Impl(inMemoryDepotInit, filepath.Join("depot", "app"), true)
+	p.rootDir = "depot"
+	var changeArgs []string
+	p.runPipedP4Function = func(_ shell.Command, args ...string) (output []byte, err error) {
+		changeArgs = args
+		return []byte("change 1234567 created ..."), nil
+	}
+	var tracedArgs [][]string
+	p.traceP4Function = func(args ...string) (err error) {
+		tracedArgs = append(tracedArgs, args[4:])
+		return nil
+	}
+
+	assert.NoError(t, p.Commit("some message"))
+	assert.Contains(t, changeArgs, "Files=")
+	assert.Equal(t, [][]string{
+		{"reopen", "-c", "1234567", filepath.Join("depot", "app", "...")},
+		{"submit", "-c", "1234567"},
+	}, tracedArgs)
+}
+
+func Test_p4_revert_local_outside_of_scope(t *testing.T) {
+	p, _ := newP4Impl(inMemoryDepotInit, filepath.Join("depot", "app"), true)
+	p.rootDir = "depot"
+	p.traceP4Function = func(_ ...string) (err error) {
+		t.Fatal("p4 should not be called")
+		return nil
+	}
+
+	assert.Error(t, p.RevertLocal(filepath.Join("depot", "other", "file.txt")))
+}
+
 func Test_p4_submit(t *testing.T) {
 	testFlags := []struct {
 		desc                 string
@@ -595,6 +647,417 @@ func Test_p4_rollback_last_commit(t *testing.T) {
 	}
 }
 
+func Test_p4_shelve(t *testing.T) {
+	testFlags := []struct {
+		desc           string
+		p4ChangeError  error
+		p4ChangeOutput string
+		failingCommand string
+		expectedArgs   [][]string
+		expectError    bool
+	}{
+		{
+			"p4 reconcile, change, shelve and revert command calls succeed",
+			nil, "change 1234567 created ...",
+			"",
+			[][]string{
+				{"reconcile", "-a", "-e", "-d", "some-path"},
+				{"reopen", "-c", "1234567", "some-path"},
+				{"shelve", "-c", "1234567", "some-path"},
+				{"revert", "-w", "-c", "1234567", "some-path"},
+			},
+			false,
+		},
+		{
+			"p4 reconcile command call fails",
+			nil, "change 1234567 created ...",
+			"reconcile",
+			[][]string{
+				{"reconcile", "-a", "-e", "-d", "some-path"},
+			},
+			true,
+		},
+		{
+			"p4 change command call fails",
+			errors.New("p4 change error"), "",
+			"",
+			[][]string{
+				{"reconcile", "-a", "-e", "-d", "some-path"},
+			},
+			true,
+		},
+		{
+			"p4 reopen command call fails",
+			nil, "change 1234567 created ...",
+			"reopen",
+			[][]string{
+				{"reconcile", "-a", "-e", "-d", "some-path"},
+				{"reopen", "-c", "1234567", "some-path"},
+			},
+			true,
+		},
+		{
+			"p4 shelve command call fails",
+			nil, "change 1234567 created ...",
+			"shelve",
+			[][]string{
+				{"reconcile", "-a", "-e", "-d", "some-path"},
+				{"reopen", "-c", "1234567", "some-path"},
+				{"shelve", "-c", "1234567", "some-path"},
+			},
+			true,
+		},
+		{
+			"p4 revert command call fails",
+			nil, "change 1234567 created ...",
+			"revert",
+			[][]string{
+				{"reconcile", "-a", "-e", "-d", "some-path"},
+				{"reopen", "-c", "1234567", "some-path"},
+				{"shelve", "-c", "1234567", "some-path"},
+				{"revert", "-w", "-c", "1234567", "some-path"},
+			},
+			true,
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs [][]string
+			p, _ := newP4Impl(inMemoryDepotInit, "", true)
+			p.runPipedP4Function = func(_ shell.Command, _ ...string) (output []byte, err error) {
+				// Stub for the call to "p4 change ... -o | p4 change -i"
+				return []byte(tt.p4ChangeOutput), tt.p4ChangeError
+			}
+			p.traceP4Function = func(args ...string) (err error) {
+				actualArgs = append(actualArgs, args[4:])
+				if args[4] == tt.failingCommand {
+					return errors.New("p4 " + tt.failingCommand + " error")
+				}
+				return nil
+			}
+
+			err := p.Shelve([]string{"some-path"}, "some message")
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedArgs, actualArgs)
+		})
+	}
+}
+
+func Test_p4_shelve_leaves_other_opened_files_untouched(t *testing.T) {
+	p, _ := newP4Impl(inMemoryDepotInit, "", true)
+	srcFile := filepath.Join("depot", "src", "file.go")
+	testFile := filepath.Join("depot", "test", "file_test.go")
+	var changeArgs []string
+	p.runPipedP4Function = func(_ shell.Command, args ...string) (output []byte, err error) {
+		changeArgs = args
+		return []byte("change 1234567 created ..."), nil
+	}
+	var tracedArgs [][]string
+	p.traceP4Function = func(args ...string) (err error) {
+		tracedArgs = append(tracedArgs, args[4:])
+		return nil
+	}
+
+	// testFile is opened in the default changelist, but is not part of the shelved files
+	assert.NoError(t, p.Shelve([]string{srcFile}, "some message"))
+	assert.Contains(t, changeArgs, "Files=")
+	assert.Equal(t, [][]string{
+		{"reconcile", "-a", "-e", "-d", srcFile},
+		{"reopen", "-c", "1234567", srcFile},
+		{"shelve", "-c", "1234567", srcFile},
+		{"revert", "-w", "-c", "1234567", srcFile},
+	}, tracedArgs)
+	for _, args := range tracedArgs {
+		assert.NotContains(t, args, testFile)
+	}
+}
+
+func Test_p4_list_shelved(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 10, 11, 12, 0, time.Local).UTC()
+	testFlags := []struct {
+		desc          string
+		p4Output      string
+		p4Error       error
+		filter        func(msg string) bool
+		expectError   bool
+		expectedItems vcs.ShelfItems
+	}{
+		{
+			"p4 changes command call fails",
+			"",
+			errors.New("p4 changes error"),
+			nil,
+			true,
+			nil,
+		},
+		{
+			"no shelved changelist",
+			"",
+			nil,
+			nil,
+			false,
+			nil,
+		},
+		{
+			"one shelved changelist",
+			"Change 1234 on 2024/01/02 10:11:12 by user@test *pending*\n" +
+				"\n" +
+				"\theader\n" +
+				"\t\n" +
+				"\tbody\n" +
+				"\n",
+			nil,
+			nil,
+			false,
+			vcs.ShelfItems{
+				vcs.NewShelfItem("1234", timestamp, "header\n\nbody\n\n"),
+			},
+		},
+		{
+			"filtered out shelved changelists",
+			"Change 1234 on 2024/01/02 10:11:12 by user@test *pending*\n" +
+				"\n" +
+				"\tkept\n" +
+				"Change 5678 on 2024/01/02 10:11:12 by user@test *pending*\n" +
+				"\n" +
+				"\tdropped\n",
+			nil,
+			func(msg string) bool { return strings.HasPrefix(msg, "kept") },
+			false,
+			vcs.ShelfItems{
+				vcs.NewShelfItem("1234", timestamp, "kept\n"),
+			},
+		},
+		{
+			"unrecognized p4 changes output",
+			"Change 1234\n",
+			nil,
+			nil,
+			true,
+			nil,
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs []string
+			p, _ := newP4Impl(inMemoryDepotInit, "", true)
+			p.runP4Function = func(args ...string) (output []byte, err error) {
+				actualArgs = args[4:]
+				return []byte(tt.p4Output), tt.p4Error
+			}
+
+			items, err := p.ListShelved(tt.filter)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, []string{"changes", "-s", "shelved", "-l", "-t", "-c", p4TestClientName}, actualArgs)
+			assert.Equal(t, tt.expectedItems, items)
+		})
+	}
+}
+
+func Test_p4_log(t *testing.T) {
+	t1 := time.Date(2024, 1, 2, 10, 11, 12, 0, time.Local).UTC()
+	t2 := time.Date(2024, 1, 3, 10, 11, 12, 0, time.Local).UTC()
+	p4Output := "Change 1235 on 2024/01/03 10:11:12 by bob@test\n" +
+		"\n" +
+		"\t[TCR - PASSED] tests passing\n" +
+		"\t\n" +
+		"\tchanged-lines:\n" +
+		"\t    src: 1\n" +
+		"\n" +
+		"Change 1234 on 2024/01/02 10:11:12 by alice@test\n" +
+		"\n" +
+		"\tother change\n"
+	testFlags := []struct {
+		desc          string
+		p4Output      string
+		p4Error       error
+		msgFilter     func(msg string) bool
+		filter        vcs.LogFilter
+		expectedRange string
+		expectError   bool
+		expectedItems vcs.LogItems
+	}{
+		{
+			desc:        "p4 changes command call fails",
+			p4Error:     errors.New("p4 changes error"),
+			expectError: true,
+		},
+		{
+			desc:     "all submitted changelists",
+			p4Output: p4Output,
+			expectedItems: vcs.LogItems{
+				vcs.NewLogItem("1235", t2, "[TCR - PASSED] tests passing\n\nchanged-lines:\n    src: 1\n\n"),
+				vcs.NewLogItem("1234", t1, "other change\n"),
+			},
+		},
+		{
+			desc:      "with message filter",
+			p4Output:  p4Output,
+			msgFilter: func(msg string) bool { return strings.HasPrefix(msg, "other") },
+			expectedItems: vcs.LogItems{
+				vcs.NewLogItem("1234", t1, "other change\n"),
+			},
+		},
+		{
+			desc:     "with author filter",
+			p4Output: p4Output,
+			filter:   vcs.LogFilter{Authors: []string{"alice"}},
+			expectedItems: vcs.LogItems{
+				vcs.NewLogItem("1234", t1, "other change\n"),
+			},
+		},
+		{
+			desc:          "with time range",
+			p4Output:      p4Output,
+			filter:        vcs.LogFilter{Since: t2, Until: t2.Add(time.Hour)},
+			expectedRange: "@" + t2.Local().Format(p4DateLayout) + ",@" + t2.Add(time.Hour).Local().Format(p4DateLayout),
+			expectedItems: vcs.LogItems{
+				vcs.NewLogItem("1235", t2, "[TCR - PASSED] tests passing\n\nchanged-lines:\n    src: 1\n\n"),
+			},
+		},
+		{
+			desc:        "unrecognized p4 changes output",
+			p4Output:    "Change 1234\n",
+			expectError: true,
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs []string
+			dir := filepath.FromSlash("/depot")
+			p, _ := newP4Impl(inMemoryDepotInit, dir, true)
+			p.runP4Function = func(args ...string) (output []byte, err error) {
+				actualArgs = args[4:]
+				return []byte(tt.p4Output), tt.p4Error
+			}
+
+			items, err := p.Log(tt.msgFilter, tt.filter)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, []string{"changes", "-s", "submitted", "-l", "-t", "//test/..." + tt.expectedRange}, actualArgs)
+			assert.Equal(t, tt.expectedItems, items)
+		})
+	}
+}
+
+func Test_p4_commit_files(t *testing.T) {
+	testFlags := []struct {
+		desc          string
+		p4Output      string
+		p4Error       error
+		expectError   bool
+		expectedFiles []string
+	}{
+		{
+			desc:        "p4 fstat command call fails",
+			p4Error:     errors.New("p4 fstat error"),
+			expectError: true,
+		},
+		{
+			desc:     "changelist with 2 files",
+			p4Output: filepath.FromSlash("/depot/src/file1.go") + "\n" + filepath.FromSlash("/depot/src/file2.go") + "\n",
+			expectedFiles: []string{
+				filepath.FromSlash("/depot/src/file1.go"),
+				filepath.FromSlash("/depot/src/file2.go"),
+			},
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs []string
+			dir := filepath.FromSlash("/depot")
+			p, _ := newP4Impl(inMemoryDepotInit, dir, true)
+			p.runP4Function = func(args ...string) (output []byte, err error) {
+				actualArgs = args[4:]
+				return []byte(tt.p4Output), tt.p4Error
+			}
+
+			files, err := p.CommitFiles("1234")
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, []string{"-ztag", "-F", "%clientFile%", "fstat", "//test/...@=1234"}, actualArgs)
+			assert.Equal(t, tt.expectedFiles, files)
+		})
+	}
+}
+
+func Test_p4_log_with_branch_filter(t *testing.T) {
+	p, _ := newP4Impl(inMemoryDepotInit, "", true)
+	_, err := p.Log(nil, vcs.LogFilter{Branches: []string{"main"}})
+	assert.Error(t, err)
+}
+
+func Test_p4_revision_range(t *testing.T) {
+	since := time.Date(2024, 1, 2, 10, 11, 12, 0, time.Local)
+	until := time.Date(2024, 1, 3, 8, 0, 0, 0, time.Local)
+	assert.Equal(t, "", p4RevisionRange(vcs.LogFilter{}))
+	assert.Equal(t, "@2024/01/02:10:11:12,@now", p4RevisionRange(vcs.LogFilter{Since: since}))
+	assert.Equal(t, "@2024/01/03:08:00:00", p4RevisionRange(vcs.LogFilter{Until: until}))
+	assert.Equal(t, "@2024/01/02:10:11:12,@2024/01/03:08:00:00",
+		p4RevisionRange(vcs.LogFilter{Since: since, Until: until}))
+}
+
+func Test_build_description_field(t *testing.T) {
+	attr := shell.Attributes{EOL: "\n"}
+	assert.Equal(t, "Description=header\n", buildDescriptionField(attr, "header"))
+	assert.Equal(t, "Description=header\n\nsrc: 1\n\n\n\nsuffix\n",
+		buildDescriptionField(attr, "header", "src: 1\n", "\nsuffix"))
+}
+
+func Test_p4_unshelve(t *testing.T) {
+	testFlags := []struct {
+		desc         string
+		p4Error      error
+		expectedArgs []string
+		expectError  bool
+	}{
+		{
+			"p4 unshelve command call succeeds",
+			nil,
+			[]string{"unshelve", "-s", "1234"},
+			false,
+		},
+		{
+			"p4 unshelve command call fails",
+			errors.New("p4 unshelve error"),
+			[]string{"unshelve", "-s", "1234"},
+			true,
+		},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			var actualArgs []string
+			p, _ := newP4Impl(inMemoryDepotInit, "", true)
+			p.traceP4Function = func(args ...string) (err error) {
+				actualArgs = args[4:]
+				return tt.p4Error
+			}
+
+			err := p.Unshelve("1234")
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedArgs, actualArgs)
+		})
+	}
+}
+
 func Test_p4_get_latest_changelist(t *testing.T) {
 	testFlags := []struct {
 		desc               string
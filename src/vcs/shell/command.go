@@ -29,6 +29,7 @@ type Command interface {
 	IsInPath() bool
 	GetFullPath() string
 	Run(params ...string) (output []byte, err error)
+	RunInDir(dir string, params ...string) (output []byte, err error)
 	Trace(params ...string) error
 	RunAndPipe(toCmd Command, params ...string) (output []byte, err error)
 	TraceAndPipe(toCmd Command, params ...string) error
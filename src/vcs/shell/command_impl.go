@@ -107,6 +107,13 @@ func (c *CommandImpl) Run(params ...string) (output []byte, err error) {
 	return sh.Command(c.name, c.allParams(params...)).CombinedOutput()
 }
 
+// RunInDir calls the command with the provided parameters in a separate process started from
+// the provided working directory, and returns its output traces combined
+func (c *CommandImpl) RunInDir(dir string, params ...string) (output []byte, err error) {
+	c.traceCall(params...)
+	return sh.Command(c.name, c.allParams(params...), sh.Dir(dir)).CombinedOutput()
+}
+
 // Trace calls the command with the provided parameters and reports its output traces
 func (c *CommandImpl) Trace(params ...string) error {
 	output, err := c.Run(params...)
@@ -28,6 +28,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/murex/tcr/helpers"
 	"github.com/murex/tcr/report"
 	"github.com/murex/tcr/vcs"
 	"github.com/stretchr/testify/assert"
@@ -70,6 +71,15 @@ func Test_run_invalid_command(t *testing.T) {
 	assert.Zero(t, output)
 }
 
+func Test_run_valid_command_in_dir(t *testing.T) {
+	helpers.SkipOnWindows(t)
+	dir := t.TempDir()
+	output, err := NewCommandFunc("pwd").RunInDir(dir, "-P")
+	assert.NoError(t, err)
+	expected, _ := filepath.EvalSymlinks(dir)
+	assert.Equal(t, expected, string(bytes.TrimRight(output, "\r\n")))
+}
+
 func Test_trace_valid_command_with_initial_parameters(t *testing.T) {
 	report.TestWithIsolatedReporter(func(reporter *report.Reporter, sniffer *report.Sniffer) {
 		err := NewCommandFunc("echo", "hello world!").Trace()
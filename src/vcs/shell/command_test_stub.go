@@ -30,6 +30,7 @@ type CommandStub struct {
 	IsInPathFunc     func() bool
 	GetFullPathFunc  func() string
 	RunFunc          func(params ...string) (output []byte, err error)
+	RunInDirFunc     func(dir string, params ...string) (output []byte, err error)
 	TraceFunc        func(params ...string) error
 	RunAndPipeFunc   func(toCmd Command, params ...string) (output []byte, err error)
 	TraceAndPipeFunc func(toCmd Command, params ...string) error
@@ -44,6 +45,7 @@ func NewCommandStub(impl CommandImpl) *CommandStub {
 		IsInPathFunc:     impl.IsInPath,
 		GetFullPathFunc:  impl.GetFullPath,
 		RunFunc:          impl.Run,
+		RunInDirFunc:     impl.RunInDir,
 		TraceFunc:        impl.Trace,
 		RunAndPipeFunc:   impl.RunAndPipe,
 		TraceAndPipeFunc: impl.TraceAndPipe,
@@ -80,6 +82,12 @@ func (stub *CommandStub) Run(params ...string) (output []byte, err error) {
 	return stub.RunFunc(params...)
 }
 
+// RunInDir calls the command with the provided parameters in a separate process started from
+// the provided working directory, and returns its output traces combined
+func (stub *CommandStub) RunInDir(dir string, params ...string) (output []byte, err error) {
+	return stub.RunInDirFunc(dir, params...)
+}
+
 // Trace calls the command with the provided parameters and reports its output traces
 func (stub *CommandStub) Trace(params ...string) error {
 	return stub.TraceFunc(params...)
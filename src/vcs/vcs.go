@@ -62,10 +62,21 @@ type Interface interface {
 	Push() error
 	Pull() error
 	Diff() (diffs FileDiffs, err error)
-	Log(msgFilter func(msg string) bool) (logs LogItems, err error)
+	Log(msgFilter func(msg string) bool, filter LogFilter) (logs LogItems, err error)
+	CommitFiles(id string) (files []string, err error)
+	Shelve(paths []string, messages ...string) error
+	ListShelved(msgFilter func(msg string) bool) (items ShelfItems, err error)
+	Unshelve(id string) error
 	EnableAutoPush(flag bool)
 	IsAutoPushEnabled() bool
 	IsRemoteEnabled() bool
 	CheckRemoteAccess() bool
 	SupportsEmojis() bool
+	SupportsSnapshots() bool
+	TakeSnapshot() (*Snapshot, error)
+	CommitSnapshot(s *Snapshot, messages ...string) error
+	DropSnapshot(s *Snapshot) error
+	SupportsHandover() bool
+	PushHandover(messages ...string) (bool, error)
+	PullHandover() (bool, error)
 }
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package vcs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// handoverRefPrefix is the prefix of the remote branches used for handing over
+// uncommitted work from one mob workstation to another
+const handoverRefPrefix = "tcr-handover/"
+
+// HandoverBranch returns the name of the remote branch used for handing over
+// uncommitted work done on the provided working branch
+func HandoverBranch(workingBranch string) string {
+	return handoverRefPrefix + workingBranch
+}
+
+// HandoverConflictError is returned when work handed over by another
+// workstation cannot be restored without conflicting with local changes
+type HandoverConflictError struct {
+	Files []string
+	Err   error
+}
+
+// Error returns the error description
+func (e *HandoverConflictError) Error() string {
+	if len(e.Files) == 0 {
+		return fmt.Sprintf("handed over changes conflict with local changes: %v", e.Err)
+	}
+	return "handed over changes conflict with local changes in: " + strings.Join(e.Files, ", ")
+}
+
+// Unwrap returns the underlying error
+func (e *HandoverConflictError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package vcs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LogFilter restricts the VCS history returned by Log operation.
+// Zero values mean no restriction
+type LogFilter struct {
+	// Since excludes commits made before this time
+	Since time.Time
+	// Until excludes commits made after this time
+	Until time.Time
+	// Authors keeps only commits from one of these authors
+	Authors []string
+	// Branches is the list of branches to retrieve history from.
+	// When empty, the history is retrieved from the current working branch
+	Branches []string
+}
+
+// IncludesTime indicates if the provided time is within the filter time range
+func (f LogFilter) IncludesTime(t time.Time) bool {
+	if !f.Since.IsZero() && t.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && t.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+// IncludesAuthor indicates if the provided author matches one of the filter authors.
+// An author matches when it contains one of the filter authors, regardless of case,
+// so that filtering can be done on a name, an e-mail address or a user ID
+func (f LogFilter) IncludesAuthor(author string) bool {
+	if len(f.Authors) == 0 {
+		return true
+	}
+	for _, a := range f.Authors {
+		if strings.Contains(strings.ToLower(author), strings.ToLower(a)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Layouts accepted by ParseLogTime for absolute times, in local time
+var logTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// ParseLogTime converts the provided value into a point in time for filtering VCS history.
+// Accepted values are "today", "yesterday", a duration relative to now (ex: "2h"),
+// a date (ex: "2006-01-02") or a date and time (ex: "2006-01-02T15:04").
+// An empty value returns the zero time
+func ParseLogTime(value string, now time.Time) (time.Time, error) {
+	return parseLogTime(value, now, false)
+}
+
+// ParseLogUntilTime works the same way as ParseLogTime, except that values
+// designating a whole day ("today", "yesterday" or a date) are converted to
+// the end of this day, so that they can be used as an upper bound
+func ParseLogUntilTime(value string, now time.Time) (time.Time, error) {
+	return parseLogTime(value, now, true)
+}
+
+func parseLogTime(value string, now time.Time, endOfDay bool) (time.Time, error) {
+	day := func(t time.Time) time.Time {
+		if endOfDay {
+			return t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		return t
+	}
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		return time.Time{}, nil
+	case "today":
+		return day(startOfDay), nil
+	case "yesterday":
+		return day(startOfDay.AddDate(0, 0, -1)), nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	for _, layout := range logTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return day(t), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time value: \"%s\"", value)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package vcs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_log_filter_includes_time(t *testing.T) {
+	t1 := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	testFlags := []struct {
+		desc     string
+		filter   LogFilter
+		t        time.Time
+		expected bool
+	}{
+		{"no time range", LogFilter{}, t1, true},
+		{"before since", LogFilter{Since: t2}, t1, false},
+		{"at since", LogFilter{Since: t1}, t1, true},
+		{"after until", LogFilter{Until: t1}, t2, false},
+		{"within range", LogFilter{Since: t1, Until: t2}, t1.Add(time.Minute), true},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.IncludesTime(tt.t))
+		})
+	}
+}
+
+func Test_log_filter_includes_author(t *testing.T) {
+	author := "John Doe <john.doe@example.com>"
+	testFlags := []struct {
+		desc     string
+		authors  []string
+		expected bool
+	}{
+		{"no author", nil, true},
+		{"matching name", []string{"john doe"}, true},
+		{"matching e-mail", []string{"john.doe@example.com"}, true},
+		{"one of several authors", []string{"jane", "JOHN"}, true},
+		{"other author", []string{"jane"}, false},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, LogFilter{Authors: tt.authors}.IncludesAuthor(author))
+		})
+	}
+}
+
+func Test_parse_log_time(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC)
+	testFlags := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"", time.Time{}},
+		{"today", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"Yesterday", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"2h", time.Date(2026, 10, 18, 13, 30, 0, 0, time.UTC)},
+		{"2026-10-01", time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"2026-10-01T09:15", time.Date(2026, 10, 1, 9, 15, 0, 0, time.UTC)},
+		{"2026-10-01 09:15:30", time.Date(2026, 10, 1, 9, 15, 30, 0, time.UTC)},
+		{"2026-10-01T09:15:00+02:00", time.Date(2026, 10, 1, 7, 15, 0, 0, time.UTC)},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.value, func(t *testing.T) {
+			result, err := ParseLogTime(tt.value, now)
+			assert.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+}
+
+func Test_parse_log_until_time(t *testing.T) {
+	now := time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC)
+	testFlags := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"", time.Time{}},
+		{"today", time.Date(2026, 10, 18, 23, 59, 59, 999999999, time.UTC)},
+		{"yesterday", time.Date(2026, 10, 17, 23, 59, 59, 999999999, time.UTC)},
+		{"2h", time.Date(2026, 10, 18, 13, 30, 0, 0, time.UTC)},
+		{"2026-10-17", time.Date(2026, 10, 17, 23, 59, 59, 999999999, time.UTC)},
+		{"2026-10-17T09:15", time.Date(2026, 10, 17, 9, 15, 0, 0, time.UTC)},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.value, func(t *testing.T) {
+			result, err := ParseLogUntilTime(tt.value, now)
+			assert.NoError(t, err)
+			assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+		})
+	}
+}
+
+func Test_log_filter_until_date_includes_the_whole_day(t *testing.T) {
+	until, err := ParseLogUntilTime("2026-10-17", time.Now().In(time.UTC))
+	assert.NoError(t, err)
+	filter := LogFilter{Until: until}
+	assert.True(t, filter.IncludesTime(time.Date(2026, 10, 17, 18, 0, 0, 0, until.Location())))
+	assert.False(t, filter.IncludesTime(time.Date(2026, 10, 18, 0, 0, 0, 0, until.Location())))
+}
+
+func Test_parse_invalid_log_time(t *testing.T) {
+	_, err := ParseLogTime("last week", time.Now())
+	assert.EqualError(t, err, "invalid time value: \"last week\"")
+}
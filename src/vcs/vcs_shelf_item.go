@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package vcs
+
+import (
+	"time"
+)
+
+type (
+	// ShelfItem contains VCS information for a set of changes saved
+	// into the VCS recovery area (git stash entry, p4 shelved changelist, etc.)
+	ShelfItem struct {
+		ID        string
+		Timestamp time.Time
+		Message   string
+	}
+
+	// ShelfItems contains a set of shelf items in a slice
+	ShelfItems []ShelfItem
+)
+
+// NewShelfItem creates a new VCS shelf item instance
+func NewShelfItem(id string, timestamp time.Time, message string) ShelfItem {
+	return ShelfItem{id, timestamp, message}
+}
+
+// Add adds a ShelfItem to the ShelfItems collection
+func (items *ShelfItems) Add(d ShelfItem) {
+	*items = append(*items, d)
+}
+
+// Len returns the length of the items array
+func (items *ShelfItems) Len() int {
+	return len(*items)
+}
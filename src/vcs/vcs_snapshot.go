@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package vcs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Snapshot contains the information related to a copy of the working tree taken
+// at a given point in time, into which TCR operations can run in isolation
+// while the working tree keeps on being edited
+type Snapshot struct {
+	// RootDir is the VCS root directory of the working tree the snapshot was taken from
+	RootDir string
+	// Dir is the root directory of the snapshot copy
+	Dir string
+	// Timestamp is the time when the snapshot was taken
+	Timestamp time.Time
+	// Diffs contains the file diffs of the working tree at the time the snapshot was taken
+	Diffs FileDiffs
+	// Files contains the fingerprint of all files changed in the working tree at the time
+	// the snapshot was taken, indexed by their path in the working tree.
+	// Files deleted from the working tree have an empty fingerprint
+	Files map[string]string
+}
+
+// NewSnapshot creates a new snapshot instance
+func NewSnapshot(rootDir string, dir string, diffs FileDiffs) *Snapshot {
+	return &Snapshot{
+		RootDir:   rootDir,
+		Dir:       dir,
+		Timestamp: time.Now(),
+		Diffs:     diffs,
+		Files:     make(map[string]string),
+	}
+}
+
+// MapPath returns the path in the snapshot corresponding to the provided
+// path in the working tree. Paths outside the working tree are returned unchanged
+func (s *Snapshot) MapPath(path string) string {
+	rel, err := filepath.Rel(s.RootDir, path)
+	if err != nil || !filepath.IsLocal(rel) {
+		return path
+	}
+	return filepath.Join(s.Dir, rel)
+}
+
+// Record records the current fingerprint of the provided working tree file
+func (s *Snapshot) Record(path string) {
+	s.Files[path] = Fingerprint(path)
+}
+
+// RecordContents records the fingerprint of the provided contents for the provided
+// working tree file. A nil contents stands for a deleted file
+func (s *Snapshot) RecordContents(path string, contents []byte) {
+	if contents == nil {
+		s.Files[path] = ""
+		return
+	}
+	s.Files[path] = contentsFingerprint(contents)
+}
+
+// IsUnchanged indicates if the provided working tree file is still the same
+// as when the snapshot was taken
+func (s *Snapshot) IsUnchanged(path string) bool {
+	fingerprint, ok := s.Files[path]
+	return ok && fingerprint == Fingerprint(path)
+}
+
+// IsEmpty indicates if the working tree had no change when the snapshot was taken
+func (s *Snapshot) IsEmpty() bool {
+	return len(s.Files) == 0
+}
+
+// Fingerprint returns a fingerprint of the provided file contents.
+// Returns an empty string if the file does not exist or cannot be read
+func Fingerprint(path string) string {
+	f, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = f.Close() }()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// contentsFingerprint returns the fingerprint of the provided contents, the same way
+// Fingerprint does for a file
+func contentsFingerprint(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
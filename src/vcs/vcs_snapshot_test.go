@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package vcs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_snapshot_map_path(t *testing.T) {
+	root := filepath.Join("/", "root")
+	dir := filepath.Join("/", "snapshot")
+	testFlags := []struct {
+		desc     string
+		path     string
+		expected string
+	}{
+		{"root directory", root, dir},
+		{"file in root directory", filepath.Join(root, "file"), filepath.Join(dir, "file")},
+		{"file in sub-directory", filepath.Join(root, "sub", "file"), filepath.Join(dir, "sub", "file")},
+		{"file outside root directory", filepath.Join("/", "other", "file"), filepath.Join("/", "other", "file")},
+	}
+	for _, tt := range testFlags {
+		t.Run(tt.desc, func(t *testing.T) {
+			s := NewSnapshot(root, dir, nil)
+			assert.Equal(t, tt.expected, s.MapPath(tt.path))
+		})
+	}
+}
+
+func Test_snapshot_detects_file_changes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	assert.NoError(t, os.WriteFile(path, []byte("before"), 0600))
+
+	s := NewSnapshot(filepath.Dir(path), "", nil)
+	s.Record(path)
+	assert.True(t, s.IsUnchanged(path))
+
+	assert.NoError(t, os.WriteFile(path, []byte("after"), 0600))
+	assert.False(t, s.IsUnchanged(path))
+}
+
+func Test_snapshot_detects_deleted_file_recreation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+
+	s := NewSnapshot(filepath.Dir(path), "", nil)
+	s.Record(path)
+	assert.True(t, s.IsUnchanged(path))
+
+	assert.NoError(t, os.WriteFile(path, []byte("recreated"), 0600))
+	assert.False(t, s.IsUnchanged(path))
+}
+
+func Test_snapshot_records_copied_contents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	assert.NoError(t, os.WriteFile(path, []byte("copied"), 0600))
+
+	s := NewSnapshot(filepath.Dir(path), "", nil)
+	s.RecordContents(path, []byte("copied"))
+	assert.True(t, s.IsUnchanged(path))
+
+	s.RecordContents(path, []byte("saved after copy"))
+	assert.False(t, s.IsUnchanged(path))
+
+	s.RecordContents(path, nil)
+	assert.False(t, s.IsUnchanged(path))
+	assert.NoError(t, os.Remove(path))
+	assert.True(t, s.IsUnchanged(path))
+}
+
+func Test_snapshot_does_not_know_unrecorded_files(t *testing.T) {
+	s := NewSnapshot("", "", nil)
+	assert.True(t, s.IsEmpty())
+	assert.False(t, s.IsUnchanged("some-file"))
+}
@@ -40,6 +40,7 @@ import {
 import { TcrRolesComponent } from "../tcr-roles/tcr-roles.component";
 import { TcrTraceComponent } from "../tcr-trace/tcr-trace.component";
 import { TcrControlsComponent } from "../tcr-controls/tcr-controls.component";
+import { TcrRosterComponent } from "../tcr-roster/tcr-roster.component";
 import {
   bgDarkGray,
   cyan,
@@ -65,6 +66,13 @@ import { provideHttpClient } from "@angular/common/http";
 })
 class MockTcrRolesComponent {}
 
+@Component({
+  selector: "app-tcr-roster",
+  template: '<div class="mock-roster"></div>',
+  standalone: true,
+})
+class MockTcrRosterComponent {}
+
 @Component({
   selector: "app-tcr-trace",
   template: '<div class="mock-trace"></div>',
@@ -145,11 +153,17 @@ describe("TcrConsoleComponent", () => {
     })
       .overrideComponent(TcrConsoleComponent, {
         remove: {
-          imports: [TcrRolesComponent, TcrTraceComponent, TcrControlsComponent],
+          imports: [
+            TcrRolesComponent,
+            TcrRosterComponent,
+            TcrTraceComponent,
+            TcrControlsComponent,
+          ],
         },
         add: {
           imports: [
             MockTcrRolesComponent,
+            MockTcrRosterComponent,
             MockTcrTraceComponent,
             MockTcrControlsComponent,
           ],
@@ -200,6 +214,8 @@ describe("TcrConsoleComponent", () => {
                   <div class="text-wrap vcenter">
                     <app-tcr-roles></app-tcr-roles>
                     <br>
+                    <app-tcr-roster></app-tcr-roster>
+                    <br>
                     <app-tcr-trace></app-tcr-trace>
                     <br>
                     <app-tcr-controls></app-tcr-controls>
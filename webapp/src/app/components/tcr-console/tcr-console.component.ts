@@ -36,10 +36,16 @@ import {
 import { TcrTraceComponent } from "../tcr-trace/tcr-trace.component";
 import { Observable, Subject, Subscription } from "rxjs";
 import { TcrControlsComponent } from "../tcr-controls/tcr-controls.component";
+import { TcrRosterComponent } from "../tcr-roster/tcr-roster.component";
 
 @Component({
   selector: "app-tcr-console",
-  imports: [TcrRolesComponent, TcrTraceComponent, TcrControlsComponent],
+  imports: [
+    TcrRolesComponent,
+    TcrRosterComponent,
+    TcrTraceComponent,
+    TcrControlsComponent,
+  ],
   templateUrl: "./tcr-console.component.html",
   styleUrl: "./tcr-console.component.css",
 })
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+import {
+  ChangeDetectorRef,
+  Component,
+  effect,
+  OnInit,
+  Signal,
+} from "@angular/core";
+import { NgClass } from "@angular/common";
+import { toSignal } from "@angular/core/rxjs-interop";
+import { TcrMember } from "../../interfaces/tcr-roster";
+import { TcrMessage } from "../../interfaces/tcr-message";
+import { TcrRosterService } from "../../services/tcr-roster.service";
+
+@Component({
+  selector: "app-tcr-roster",
+  imports: [NgClass],
+  templateUrl: "./tcr-roster.component.html",
+  styleUrl: "./tcr-roster.component.css",
+})
+export class TcrRosterComponent implements OnInit {
+  members: TcrMember[] = [];
+  rosterMessage: Signal<TcrMessage | undefined>;
+
+  constructor(
+    private rosterService: TcrRosterService,
+    private cdr: ChangeDetectorRef,
+  ) {
+    this.rosterMessage = toSignal(this.rosterService.message$);
+
+    effect(() => {
+      // When receiving a role or timer message from the server
+      // trigger a refresh query to ensure that we keep in sync
+      this.refresh(this.rosterMessage()!);
+    });
+  }
+
+  ngOnInit(): void {
+    this.getRoster();
+  }
+
+  refresh(message: TcrMessage): void {
+    if (message) this.getRoster();
+  }
+
+  getRoster(): void {
+    this.rosterService.getRoster().subscribe((m) => this.update(m));
+  }
+
+  moveUp(index: number): void {
+    if (index <= 0 || index >= this.members.length) return;
+    const names = this.members.map((m) => m.name);
+    [names[index - 1], names[index]] = [names[index], names[index - 1]];
+    this.rosterService.reorder(names).subscribe((m) => this.update(m));
+  }
+
+  setDriver(member: TcrMember): void {
+    this.rosterService.setDriver(member.name).subscribe((m) => this.update(m));
+  }
+
+  toggleSkip(member: TcrMember): void {
+    this.rosterService
+      .skip(member.name, !member.skipped)
+      .subscribe((m) => this.update(m));
+  }
+
+  private update(members: TcrMember[] | undefined): void {
+    if (members) {
+      this.members = members;
+      this.cdr.markForCheck();
+    }
+  }
+}
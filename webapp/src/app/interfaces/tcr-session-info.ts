@@ -54,4 +54,12 @@ export const tcrVariants: { [key: string]: TcrVariant } = {
     description: "The Introspective",
     statechartImageFile: "variant-introspective.png",
   },
+  "shelve": {
+    description: "The Shelve",
+    statechartImageFile: "variant-shelve.png",
+  },
+  "red-green-refactor": {
+    description: "The Red-Green-Refactor",
+    statechartImageFile: "variant-red-green-refactor.png",
+  },
 };
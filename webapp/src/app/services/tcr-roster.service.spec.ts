@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2026 Murex
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+import { TestBed } from "@angular/core/testing";
+import { HttpTestingController } from "@angular/common/http/testing";
+import {
+  configureServiceTestingModule,
+  cleanupAngularTest,
+  createServiceInInjectionContext,
+} from "../../test-helpers/angular-test-helpers";
+import { TcrRosterService } from "./tcr-roster.service";
+import { TcrMember } from "../interfaces/tcr-roster";
+import { WebsocketService } from "./websocket.service";
+import { Subject } from "rxjs";
+import { TcrMessage, TcrMessageType } from "../interfaces/tcr-message";
+import { DestroyRef } from "@angular/core";
+
+class FakeWebsocketService {
+  webSocket$: Subject<TcrMessage> = new Subject<TcrMessage>();
+}
+
+// Mock DestroyRef for takeUntilDestroyed
+class MockDestroyRef {
+  onDestroy(_fn: () => void) {
+    // Mock implementation - does nothing for tests
+  }
+}
+
+describe("TcrRosterService", () => {
+  let service: TcrRosterService;
+  let httpMock: HttpTestingController;
+  let wsServiceFake: WebsocketService;
+
+  const sample: TcrMember[] = [
+    { name: "alice", email: "", skipped: false, driver: true, next: false },
+    { name: "bob", email: "", skipped: false, driver: false, next: true },
+  ];
+
+  beforeEach(() => {
+    configureServiceTestingModule(TcrRosterService, [
+      { provide: WebsocketService, useClass: FakeWebsocketService },
+      { provide: DestroyRef, useClass: MockDestroyRef },
+    ]);
+
+    // Create service using injection context helper to handle takeUntilDestroyed
+    service = createServiceInInjectionContext<TcrRosterService>(
+      TcrRosterService,
+      [{ provide: WebsocketService, useClass: FakeWebsocketService }],
+    );
+
+    httpMock = TestBed.inject(HttpTestingController);
+    wsServiceFake = TestBed.inject(WebsocketService);
+  });
+
+  afterEach(() => {
+    cleanupAngularTest(httpMock);
+  });
+
+  describe("service instance", () => {
+    it("should be created", () => {
+      expect(service).toBeTruthy();
+    });
+  });
+
+  describe("getRoster() function", () => {
+    it("should return roster members when called", () => {
+      let actual: TcrMember[] | undefined;
+      service.getRoster().subscribe((other) => {
+        actual = other;
+      });
+
+      const req = httpMock.expectOne(`/api/roster`);
+      expect(req.request.method).toBe("GET");
+      expect(req.request.responseType).toEqual("json");
+      req.flush(sample);
+      expect(actual).toBe(sample);
+    });
+
+    it("should return undefined when receiving an error response", () => {
+      let actual: TcrMember[] | undefined;
+      service.getRoster().subscribe((other) => {
+        actual = other;
+      });
+
+      const req = httpMock.expectOne(`/api/roster`);
+      req.flush(
+        { message: "Some network error" },
+        {
+          status: 500,
+          statusText: "Server Error",
+        },
+      );
+      expect(actual).toBeUndefined();
+    });
+  });
+
+  describe("reorder() function", () => {
+    it("should send the member names in their new order", () => {
+      service.reorder(["bob", "alice"]).subscribe();
+
+      const req = httpMock.expectOne(`/api/roster`);
+      expect(req.request.method).toBe("POST");
+      expect(req.request.body).toEqual(["bob", "alice"]);
+      req.flush(sample);
+    });
+  });
+
+  describe("member actions", () => {
+    [
+      { desc: "setDriver", call: () => service.setDriver("bob"), action: "drive" },
+      { desc: "skip", call: () => service.skip("bob", true), action: "skip" },
+      { desc: "unskip", call: () => service.skip("bob", false), action: "unskip" },
+    ].forEach((testCase) => {
+      it(`${testCase.desc} should send an HTTP POST ${testCase.action} request`, () => {
+        testCase.call().subscribe();
+
+        const req = httpMock.expectOne(`/api/roster/bob/${testCase.action}`);
+        expect(req.request.method).toBe("POST");
+        req.flush(sample);
+      });
+    });
+  });
+
+  describe("websocket message handler", () => {
+    [TcrMessageType.ROLE, TcrMessageType.TIMER].forEach((type) => {
+      it(`should forward ${type} messages`, async () => {
+        const infoMessage = { type: TcrMessageType.INFO } as TcrMessage;
+        const sampleMessage = { type: type } as TcrMessage;
+
+        const messagePromise = new Promise<TcrMessage>((resolve) => {
+          service.message$.subscribe((msg) => {
+            resolve(msg);
+          });
+        });
+
+        // Send info message first (should be filtered out)
+        wsServiceFake.webSocket$.next(infoMessage);
+        wsServiceFake.webSocket$.next(sampleMessage);
+
+        const receivedMessage = await messagePromise;
+        expect(receivedMessage.type).toEqual(type);
+      });
+    });
+  });
+});